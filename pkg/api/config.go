@@ -1,5 +1,7 @@
 package api
 
+import "time"
+
 const (
 	ClusterProviderKubeConfig = "kubeconfig"
 	ClusterProviderInCluster  = "in-cluster"
@@ -9,6 +11,12 @@ const (
 type AuthProvider interface {
 	// IsRequireOAuth indicates whether OAuth authentication is required.
 	IsRequireOAuth() bool
+	// IsClientCredentialsIgnored indicates whether the server's own credentials are used to access the clusters
+	// regardless of the credentials provided by the MCP clients.
+	IsClientCredentialsIgnored() bool
+	// GetAuthHeaderPropagationAllowedHosts returns the hosts, in addition to the cluster API server,
+	// that the propagated Authorization header may be sent to.
+	GetAuthHeaderPropagationAllowedHosts() []string
 }
 
 type ClusterProvider interface {
@@ -16,6 +24,16 @@ type ClusterProvider interface {
 	GetClusterProviderStrategy() string
 	// GetKubeConfigPath returns the path to the kubeconfig file (if configured).
 	GetKubeConfigPath() string
+	// GetKubeConfigPaths returns the paths to additional kubeconfig files whose contexts are exposed as targets (if configured).
+	GetKubeConfigPaths() []string
+	// GetDefaultNamespace returns the namespace used by the in-cluster provider when none is provided (if configured).
+	GetDefaultNamespace() string
+	// GetProxyURL returns the URL of the proxy the requests to the clusters are routed through (if configured).
+	GetProxyURL() string
+	// GetMultiClusterConcurrency returns the maximum number of targets operated on concurrently (if configured).
+	GetMultiClusterConcurrency() int
+	// GetExcludedContexts returns the names or glob patterns of the kubeconfig contexts that aren't exposed as targets (if configured).
+	GetExcludedContexts() []string
 }
 
 // ExtendedConfig is the interface that all configuration extensions must implement.
@@ -45,9 +63,64 @@ type DeniedResourcesProvider interface {
 	GetDeniedResources() []GroupVersionKind
 }
 
+type DiscoveryCacheProvider interface {
+	// GetDiscoveryCacheTTL returns how long the discovery results are reused before being invalidated.
+	// A zero value means the results are reused until explicitly invalidated.
+	GetDiscoveryCacheTTL() time.Duration
+}
+
+type PreferredVersionsProvider interface {
+	// GetPreferredVersions returns the API versions (keyed by API group) to use instead of the discovery-preferred ones.
+	GetPreferredVersions() map[string]string
+}
+
+type MetricsProvider interface {
+	// GetMetricsGroupVersion returns the group/version of the metrics API to query (e.g. metrics.k8s.io/v1beta1).
+	// Returns an empty string to use the discovery-preferred version.
+	GetMetricsGroupVersion() string
+}
+
+type ListProvider interface {
+	// GetMaxListItems returns the maximum number of items merged from the pages of a paginated list.
+	// Returns zero to use the default maximum.
+	GetMaxListItems() int
+}
+
+type ToolsConfigProvider interface {
+	// GetMetricsUnavailableBehavior returns the behavior of the metrics tools when the metrics API is not available.
+	GetMetricsUnavailableBehavior() string
+	// GetDefaultLogTailLines returns the number of log lines to retrieve when the tool call doesn't provide an explicit value.
+	// Returns zero if not configured.
+	GetDefaultLogTailLines() int64
+	// GetDefaultAllNamespaces returns the value of the all_namespaces argument when the tool call doesn't provide an explicit value.
+	GetDefaultAllNamespaces() bool
+	// GetPodsTopConcurrency returns the maximum number of concurrent per-namespace requests of pods_top for all namespaces.
+	// Returns zero for a single request.
+	GetPodsTopConcurrency() int
+	// GetMaskedMetadataKeys returns the annotation and label keys whose values are masked in the printed objects.
+	GetMaskedMetadataKeys() []string
+	// IsRuntimeLogLevelAllowed indicates whether the MCP clients are allowed to change the log level at runtime.
+	IsRuntimeLogLevelAllowed() bool
+	// IsConfigExposed indicates whether the MCP clients are allowed to retrieve the effective configuration of the server.
+	IsConfigExposed() bool
+	// GetEffectiveConfig returns the TOML representation of the configuration the server is running with, with the secrets redacted.
+	GetEffectiveConfig() (string, error)
+}
+
+type NamespaceFilterProvider interface {
+	// GetExcludedNamespacePrefixes returns the name prefixes of the namespaces excluded from the all-namespaces listings.
+	// Returns nil if no namespace is excluded.
+	GetExcludedNamespacePrefixes() []string
+}
+
 type BaseConfig interface {
 	AuthProvider
 	ClusterProvider
 	DeniedResourcesProvider
+	DiscoveryCacheProvider
 	ExtendedConfigProvider
+	ListProvider
+	MetricsProvider
+	NamespaceFilterProvider
+	PreferredVersionsProvider
 }
@@ -10,7 +10,6 @@ import (
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
-	metricsv1beta1 "k8s.io/metrics/pkg/client/clientset/versioned/typed/metrics/v1beta1"
 )
 
 // Openshift provides OpenShift-specific detection capabilities.
@@ -31,6 +30,9 @@ type PodsTopOptions struct {
 	AllNamespaces bool
 	Namespace     string
 	Name          string
+	// Concurrency is the maximum number of concurrent per-namespace requests when listing the metrics of all namespaces.
+	// If zero, a single cluster-wide request is performed.
+	Concurrency int
 }
 
 // NodesTopOptions contains options for getting node metrics.
@@ -47,6 +49,8 @@ type KubernetesClient interface {
 	kubernetes.Interface
 	// NamespaceOrDefault returns the provided namespace or the default configured namespace if empty
 	NamespaceOrDefault(namespace string) string
+	// IsNamespaceExcluded returns true if the resources in the provided namespace are excluded from the all-namespaces listings
+	IsNamespaceExcluded(namespace string) bool
 	// RESTConfig returns the REST config used to create clients
 	RESTConfig() *rest.Config
 	// RESTMapper returns the REST mapper used to map GVK to GVR
@@ -55,6 +59,8 @@ type KubernetesClient interface {
 	DiscoveryClient() discovery.CachedDiscoveryInterface
 	// DynamicClient returns the dynamic client
 	DynamicClient() dynamic.Interface
-	// MetricsV1beta1Client returns the metrics v1beta1 client
-	MetricsV1beta1Client() *metricsv1beta1.MetricsV1beta1Client
+	// MetricsGroupVersion returns the configured group/version of the metrics API, empty to use the discovery-preferred one
+	MetricsGroupVersion() string
+	// MaxListItems returns the maximum number of items merged from the pages of a paginated list
+	MaxListItems() int
 }
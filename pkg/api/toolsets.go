@@ -13,6 +13,10 @@ type ServerTool struct {
 	Handler            ToolHandlerFunc
 	ClusterAware       *bool
 	TargetListProvider *bool
+	OpenShiftOnly      *bool
+	// Enabled reports whether the tool is registered for the provided configuration (e.g. tools that must be explicitly allowed).
+	// The tool is registered (subject to the common tool filters) if not set.
+	Enabled func(config ToolsConfigProvider) bool
 }
 
 // IsClusterAware indicates whether the tool can accept a "cluster" or "context" parameter
@@ -34,6 +38,24 @@ func (s *ServerTool) IsTargetListProvider() bool {
 	return false
 }
 
+// IsOpenShiftOnly indicates whether the tool is specific to OpenShift (only registered for OpenShift clusters)
+// Defaults to false if not explicitly set
+func (s *ServerTool) IsOpenShiftOnly() bool {
+	if s.OpenShiftOnly != nil {
+		return *s.OpenShiftOnly
+	}
+	return false
+}
+
+// IsEnabled indicates whether the tool is registered for the provided configuration.
+// Defaults to true if Enabled is not set
+func (s *ServerTool) IsEnabled(config ToolsConfigProvider) bool {
+	if s.Enabled != nil {
+		return s.Enabled(config)
+	}
+	return true
+}
+
 type Toolset interface {
 	// GetName returns the name of the toolset.
 	// Used to identify the toolset in configuration, logs, and command-line arguments.
@@ -46,6 +68,10 @@ type Toolset interface {
 	// GetPrompts returns the prompts provided by this toolset.
 	// Returns nil if the toolset doesn't provide any prompts.
 	GetPrompts() []ServerPrompt
+	// GetDependencies returns the names of the toolsets required by this toolset.
+	// Required toolsets are automatically enabled even if they are not explicitly configured.
+	// Returns nil if the toolset doesn't depend on any other toolset.
+	GetDependencies() []string
 }
 
 type ToolCallRequest interface {
@@ -66,9 +92,21 @@ func NewToolCallResult(content string, err error) *ToolCallResult {
 	}
 }
 
+const (
+	// MetricsUnavailableBehaviorError makes the metrics tools fail when the metrics API is not available (default)
+	MetricsUnavailableBehaviorError = "error"
+	// MetricsUnavailableBehaviorEmpty makes the metrics tools return an empty result with an explanatory note when the metrics API is not available
+	MetricsUnavailableBehaviorEmpty = "empty"
+	// ToolConflictPolicyLastWins makes the tool registered by the last enabled toolset take precedence when several toolsets register the same tool name (default)
+	ToolConflictPolicyLastWins = "last-wins"
+	// ToolConflictPolicyError makes the server fail to load the toolsets when several toolsets register the same tool name
+	ToolConflictPolicyError = "error"
+)
+
 type ToolHandlerParams struct {
 	context.Context
 	ExtendedConfigProvider
+	ToolsConfigProvider
 	KubernetesClient
 	ToolCallRequest
 	ListOutput output.Output
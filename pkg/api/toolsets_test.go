@@ -40,6 +40,18 @@ func (s *ToolsetsSuite) TestServerTool() {
 			s.True(tool.IsTargetListProvider(), "Expected IsTargetListProvider to be true when set to true")
 		})
 	})
+	s.Run("IsEnabled", func() {
+		s.Run("defaults to true", func() {
+			tool := &ServerTool{}
+			s.True(tool.IsEnabled(nil), "Expected IsEnabled to be true by default")
+		})
+		s.Run("delegates to Enabled", func() {
+			for _, enabled := range []bool{true, false} {
+				tool := &ServerTool{Enabled: func(ToolsConfigProvider) bool { return enabled }}
+				s.Equalf(enabled, tool.IsEnabled(nil), "Expected IsEnabled to be %t", enabled)
+			}
+		})
+	})
 }
 
 func TestToolsets(t *testing.T) {
@@ -3,31 +3,107 @@ package config
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/BurntSushi/toml"
 	"github.com/containers/kubernetes-mcp-server/pkg/api"
 	"k8s.io/klog/v2"
 )
 
+// DefaultSystemNamespacePrefixes are the default name prefixes of the system namespaces excluded from the
+// all-namespaces listings when ExcludeSystemNamespaces is enabled.
+var DefaultSystemNamespacePrefixes = []string{"kube-", "openshift-"}
+
 const (
 	DefaultDropInConfigDir = "conf.d"
+	// RedactedValue replaces the secrets in the effective configuration returned by GetEffectiveConfig
+	RedactedValue = "[REDACTED]"
+	// DefaultTokenExchangeCooldown is the default period the token exchange is skipped for a failing target
+	DefaultTokenExchangeCooldown = 30 * time.Second
+	// DefaultShutdownTimeout is the default maximum time the HTTP server waits for the in-flight requests on shutdown
+	DefaultShutdownTimeout = 10 * time.Second
+	// ValidateDefaultTargetWarn logs a warning at startup if the default cluster target is unreachable
+	ValidateDefaultTargetWarn = "warn"
+	// ValidateDefaultTargetFail fails the startup if the default cluster target is unreachable
+	ValidateDefaultTargetFail = "fail"
 )
 
 // StaticConfig is the configuration for the server.
 // It allows to configure server specific settings and tools to be enabled or disabled.
 type StaticConfig struct {
 	DeniedResources []api.GroupVersionKind `toml:"denied_resources"`
+	// DeniedResourcesFile is the path to a standalone TOML file with additional denied_resources entries.
+	// The entries are merged with the inline denied_resources, relative paths are resolved against the config directory.
+	DeniedResourcesFile string `toml:"denied_resources_file,omitempty"`
 
 	LogLevel   int    `toml:"log_level,omitzero"`
 	Port       string `toml:"port,omitempty"`
 	SSEBaseURL string `toml:"sse_base_url,omitempty"`
-	KubeConfig string `toml:"kubeconfig,omitempty"`
-	ListOutput string `toml:"list_output,omitempty"`
+	// RequireHTTPTransport refuses to start the server with the stdio transport (no port configured).
+	// Useful for deployments (e.g. containers) where the server is expected to be reachable over HTTP only.
+	RequireHTTPTransport bool `toml:"require_http_transport,omitempty"`
+	// AllowRuntimeLogLevel exposes the set_log_level tool, which allows the MCP clients to change the log level at runtime.
+	// Disabled by default since the tool changes the verbosity of the whole server process.
+	AllowRuntimeLogLevel bool `toml:"allow_runtime_log_level,omitempty"`
+	// ExposeConfig exposes the server_config tool, which returns the effective configuration (with the secrets redacted)
+	// the server is running with. Useful to debug the precedence of the config file, drop-in files, and flags.
+	// Disabled by default since the configuration reveals details of the deployment (e.g. hosts and file paths).
+	ExposeConfig bool `toml:"expose_config,omitempty"`
+	// SSEKeepAliveSeconds is the interval (in seconds) at which keep-alive comments are sent on the SSE event streams.
+	// This prevents idle connections from being closed by proxies. Zero (default) disables the keep-alive comments.
+	SSEKeepAliveSeconds int `toml:"sse_keep_alive_seconds,omitzero"`
+	// ShutdownTimeoutSeconds is the maximum number of seconds the HTTP server waits on shutdown for the in-flight
+	// requests and tool calls to complete before closing the Kubernetes clients. Defaults to 10 seconds if not set.
+	ShutdownTimeoutSeconds int `toml:"shutdown_timeout_seconds,omitzero"`
+	// TLSCertFile is the path to the PEM encoded certificate (chain) the HTTP server uses to serve TLS.
+	// Requires TLSKeyFile. If empty (default), the HTTP server listens in plain text.
+	TLSCertFile string `toml:"tls_cert_file,omitempty"`
+	// TLSKeyFile is the path to the PEM encoded private key matching TLSCertFile.
+	TLSKeyFile string `toml:"tls_key_file,omitempty"`
+	// TLSMinVersion is the minimum TLS version (e.g. "1.2", "1.3") accepted by the HTTP server when serving TLS.
+	// Requires TLSCertFile and TLSKeyFile. If empty (default), the Go crypto/tls default is used.
+	TLSMinVersion string `toml:"tls_min_version,omitempty"`
+	// TLSCipherSuites are the names (e.g. TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256) of the cipher suites accepted by the HTTP
+	// server for TLS 1.0-1.2 connections. TLS 1.3 cipher suites aren't configurable. Requires TLSCertFile and TLSKeyFile.
+	// If empty (default), the Go crypto/tls default suites are used.
+	TLSCipherSuites []string `toml:"tls_cipher_suites,omitempty"`
+	// MaxBatchSize is the maximum number of calls of a JSON-RPC batch request accepted by the streamable HTTP transport.
+	// Larger batches are rejected with a 400 Bad Request to prevent resource exhaustion. Disabled if zero (default).
+	// Only applies to the clients negotiating the 2025-03-26 protocol version, batching was removed in 2025-06-18.
+	MaxBatchSize int `toml:"max_batch_size,omitzero"`
+	// EnableCompression enables the gzip compression of the HTTP responses for the clients that support it (Accept-Encoding).
+	// The streamable HTTP event streams are flushed event by event, the legacy SSE endpoint is never compressed.
+	EnableCompression bool   `toml:"enable_compression,omitempty"`
+	KubeConfig        string `toml:"kubeconfig,omitempty"`
+	// KubeConfigs are additional kubeconfig files, the kubeconfig cluster provider exposes the union of their contexts as targets.
+	// Each file is loaded on its own (contexts only use the clusters and users of their own file).
+	// Context names that collide with a context from a previous file are prefixed with the file name (e.g. "cluster-b:admin").
+	// The configuration tools (e.g. configuration_contexts_list) show a single kubeconfig with a context for each target.
+	KubeConfigs []string `toml:"kubeconfigs,omitempty"`
+	// DefaultNamespace overrides the namespace used by the in-cluster provider when no namespace is provided to a tool.
+	// Useful to set the working namespace of the server without changing its RBAC. If empty (default), "default" is used.
+	DefaultNamespace string `toml:"default_namespace,omitempty"`
+	// WarmTargetsOnStartup initializes the clients and discovery cache of all the cluster targets concurrently at startup
+	// so that the first tool call to each target doesn't pay the setup cost. Failures are logged and don't prevent startup.
+	WarmTargetsOnStartup bool   `toml:"warm_targets_on_startup,omitempty"`
+	ListOutput           string `toml:"list_output,omitempty"`
+	// ListSummaryThreshold is the maximum number of items of a list printed in full by the list tools when ListOutput is yaml.
+	// Larger lists are printed as a compact summary (item count, names, and namespaces) instead. Disabled if zero (default).
+	ListSummaryThreshold int `toml:"list_summary_threshold,omitzero"`
+	// MaxListItems is the maximum number of items retrieved by the list tools. The lists are requested in pages (of up to 500 items)
+	// and the continue tokens are followed automatically until this number of items is reached. Defaults to 10000 if not set.
+	// Truncated lists are printed with a notice including the number of items and the continue token of the remaining items.
+	MaxListItems int `toml:"max_list_items,omitzero"`
+	// MaskedMetadataKeys are the annotation and label keys whose values are replaced with "[masked]" in the tool output
+	// (e.g. kubectl.kubernetes.io/last-applied-configuration, which bloats the output and may contain sensitive data).
+	MaskedMetadataKeys []string `toml:"masked_metadata_keys,omitempty"`
 	// Stateless configures the MCP server to operate in stateless mode.
 	// When true, the server will not send notifications to clients (e.g., tools/list_changed, prompts/list_changed).
 	// This is useful for container deployments, load balancing, and serverless environments where
@@ -38,25 +114,88 @@ type StaticConfig struct {
 	// When true, expose only tools annotated with readOnlyHint=true
 	ReadOnly bool `toml:"read_only,omitempty"`
 	// When true, disable tools annotated with destructiveHint=true
-	DisableDestructive bool     `toml:"disable_destructive,omitempty"`
-	Toolsets           []string `toml:"toolsets,omitempty"`
+	DisableDestructive bool `toml:"disable_destructive,omitempty"`
+	// Destructive tools that remain enabled even when DisableDestructive is true
+	AllowedDestructiveTools []string `toml:"allowed_destructive_tools,omitempty"`
+	Toolsets                []string `toml:"toolsets,omitempty"`
+	// MetricsUnavailableBehavior is the behavior of the metrics tools (pods_top, nodes_top) when the metrics API is not available.
+	// Valid values are "error" (default), fail with an error, and "empty", return an empty table with an explanatory note.
+	MetricsUnavailableBehavior string `toml:"metrics_unavailable_behavior,omitempty"`
+	// DefaultLogTailLines is the number of lines retrieved from the end of the logs by the log tools (pods_log, nodes_log)
+	// when no explicit tail argument is provided. An explicit tail argument (including -1 for all logs) always takes precedence.
+	// If zero (default), each tool applies its own default.
+	DefaultLogTailLines int64 `toml:"default_log_tail_lines,omitzero"`
+	// DefaultAllNamespaces is the default value of the all_namespaces argument of the tools that support it (e.g. pods_top).
+	// If false, these tools default to the provided or current namespace unless all_namespaces=true is passed.
+	// If not set (default), these tools default to all namespaces.
+	DefaultAllNamespaces *bool `toml:"default_all_namespaces,omitempty"`
+	// PodsTopConcurrency is the maximum number of per-namespace Pod metrics requests performed concurrently by pods_top
+	// when listing the metrics of all namespaces. If zero (default), a single cluster-wide request is performed instead.
+	PodsTopConcurrency int `toml:"pods_top_concurrency,omitzero"`
+	// ToolConflictPolicy is the behavior when several enabled toolsets register a tool with the same name.
+	// Valid values are "last-wins" (default), the tool from the last toolset takes precedence, and "error", fail to load the toolsets.
+	ToolConflictPolicy string `toml:"tool_conflict_policy,omitempty"`
+	// OpenShiftToolPrefix is prepended to the names of the OpenShift-specific tools (e.g. "openshift_" for
+	// openshift_projects_list), to make their origin clear to clients federating OpenShift and Kubernetes clusters.
+	// The prefixed names must be used to refer to these tools in the rest of the configuration (e.g. enabled_tools).
+	OpenShiftToolPrefix string `toml:"openshift_tool_prefix,omitempty"`
 	// Tool configuration
 	EnabledTools  []string `toml:"enabled_tools,omitempty"`
 	DisabledTools []string `toml:"disabled_tools,omitempty"`
+	// DeprecatedTools maps the names of the tools to deprecate to their deprecation message.
+	// The message is appended to the tool description and included in the _meta of the tool and its call results.
+	DeprecatedTools map[string]string `toml:"deprecated_tools,omitempty"`
+	// ToolDefaults maps the names of the tools to the default values of their arguments ([tool_defaults.<tool_name>]).
+	// The defaults are only applied to the arguments absent from the tool call, explicit arguments always win.
+	ToolDefaults map[string]map[string]any `toml:"tool_defaults,omitempty"`
 	// Prompt configuration
 	Prompts []api.Prompt `toml:"prompts,omitempty"`
 
+	// AuditLogPath is the path of the file where an append-only JSON lines audit log of every tool call is written.
+	// The file is reopened on configuration reload (SIGHUP) to support external log rotation.
+	// If empty, the audit log is disabled.
+	AuditLogPath string `toml:"audit_log_path,omitempty"`
+	// AuditLogRedactedKeys are additional tool argument keys whose values are redacted in the audit log.
+	AuditLogRedactedKeys []string `toml:"audit_log_redacted_keys,omitempty"`
+	// RedactedToolArgs are additional tool argument keys whose values are redacted in the tool call logs (in addition to
+	// the password, secret, token, and authorization keys which are always redacted).
+	RedactedToolArgs []string `toml:"redacted_tool_args,omitempty"`
+
 	// Authorization-related fields
 	// RequireOAuth indicates whether the server requires OAuth for authentication.
 	RequireOAuth bool `toml:"require_oauth,omitempty"`
 	// OAuthAudience is the valid audience for the OAuth tokens, used for offline JWT claim validation.
 	OAuthAudience string `toml:"oauth_audience,omitempty"`
+	// MaxTokenAgeSeconds is the maximum age (based on the iat claim) of the accepted OAuth tokens.
+	// Tokens issued longer ago are rejected regardless of their expiration. Disabled if zero.
+	MaxTokenAgeSeconds int `toml:"max_token_age_seconds,omitzero"`
+	// RequiredTokenClaims are the claims (claim name -> expected value) the OAuth tokens must carry to be accepted.
+	// If the expected value is empty, the claim only needs to be present. For array claims (e.g. groups), the expected
+	// value must be one of the elements.
+	RequiredTokenClaims map[string]string `toml:"required_token_claims,omitempty"`
+	// TenantClaim is the claim of the validated OAuth tokens identifying the tenant of the request (e.g. "tenant", or
+	// "groups[0]" for the first element of an array claim), included in the HTTP access logs for per-tenant observability.
+	TenantClaim string `toml:"tenant_claim,omitempty"`
+	// AuthHeaderPropagationAllowedHosts are the hosts (host or host:port), in addition to the cluster API server,
+	// that the propagated Authorization header may be sent to. The header is removed from requests to any other host.
+	AuthHeaderPropagationAllowedHosts []string `toml:"auth_header_propagation_allowed_hosts,omitempty"`
+	// IgnoreClientCredentials makes the server ignore the Authorization (and kubernetes-authorization) headers of the
+	// MCP client requests, the server's own credentials (kubeconfig or in-cluster) are always used to access the clusters.
+	// Useful for deployments where the clients authenticate to the MCP server (e.g. require_oauth) but shouldn't act with their own identity.
+	IgnoreClientCredentials bool `toml:"ignore_client_credentials,omitempty"`
 	// AuthorizationURL is the URL of the OIDC authorization server.
 	// It is used for token validation and for STS token exchange.
 	AuthorizationURL string `toml:"authorization_url,omitempty"`
 	// DisableDynamicClientRegistration indicates whether dynamic client registration is disabled.
 	// If true, the .well-known endpoints will not expose the registration endpoint.
 	DisableDynamicClientRegistration bool `toml:"disable_dynamic_client_registration,omitempty"`
+	// WellKnownProxyTimeoutSeconds is the maximum number of seconds to wait for the authorization server when proxying
+	// the .well-known endpoints. A 504 Gateway Timeout is returned if exceeded. If zero (default), there is no timeout.
+	WellKnownProxyTimeoutSeconds int `toml:"well_known_proxy_timeout_seconds,omitzero"`
+	// WellKnownStrictAccept enables the strict negotiation of the Accept header of the requests to the .well-known endpoints.
+	// If true, a 406 Not Acceptable is returned to the requests whose Accept header doesn't allow a JSON response.
+	// If false (default), the JSON metadata is returned regardless of the Accept header.
+	WellKnownStrictAccept bool `toml:"well_known_strict_accept,omitempty"`
 	// OAuthScopes are the supported **client** scopes requested during the **client/frontend** OAuth flow.
 	OAuthScopes []string `toml:"oauth_scopes,omitempty"`
 	// StsClientId is the OAuth client ID used for backend token exchange
@@ -66,18 +205,75 @@ type StaticConfig struct {
 	// StsAudience is the audience for the STS token exchange.
 	StsAudience string `toml:"sts_audience,omitempty"`
 	// StsScopes is the scopes for the STS token exchange.
-	StsScopes            []string `toml:"sts_scopes,omitempty"`
-	CertificateAuthority string   `toml:"certificate_authority,omitempty"`
-	ServerURL            string   `toml:"server_url,omitempty"`
+	StsScopes []string `toml:"sts_scopes,omitempty"`
+	// TokenExchangeFailureThreshold is the number of consecutive token exchange failures for a target after which the
+	// exchange is skipped (the original token is used as-is) for the TokenExchangeCooldownSeconds period.
+	// Once the period expires, a single exchange is attempted to probe whether the identity provider recovered.
+	// Disabled if zero (default), the exchange is attempted on every call.
+	TokenExchangeFailureThreshold int `toml:"token_exchange_failure_threshold,omitzero"`
+	// TokenExchangeCooldownSeconds is the number of seconds the token exchange is skipped for a target once the
+	// TokenExchangeFailureThreshold is reached. Defaults to 30 seconds if not set.
+	TokenExchangeCooldownSeconds int `toml:"token_exchange_cooldown_seconds,omitzero"`
+
+	CertificateAuthority string `toml:"certificate_authority,omitempty"`
+	ServerURL            string `toml:"server_url,omitempty"`
+	// ProxyURL is the URL of the proxy (e.g. http://proxy.example.com:3128) the requests to the Kubernetes API servers
+	// and to the authorization server (OIDC) are routed through.
+	// If empty, the proxy configured in the kubeconfig or in the environment (HTTP_PROXY, HTTPS_PROXY, NO_PROXY) is used.
+	ProxyURL string `toml:"proxy_url,omitempty"`
 	// ClusterProviderStrategy is how the server finds clusters.
 	// If set to "kubeconfig", the clusters will be loaded from those in the kubeconfig.
 	// If set to "in-cluster", the server will use the in cluster config
 	ClusterProviderStrategy string `toml:"cluster_provider_strategy,omitempty"`
+	// MaxTargetEnumSize is the maximum number of targets (e.g. kubeconfig contexts) listed as enum values of the
+	// target parameter of the multi-cluster tools. Above it, a free-form string parameter is used instead to keep the
+	// tool schemas small. Defaults to 5 if not set.
+	MaxTargetEnumSize int `toml:"max_target_enum_size,omitzero"`
+	// TargetParameterName overrides the name of the target parameter of the multi-cluster tools (e.g. "cluster"
+	// instead of "context" for the kubeconfig strategy), for clients that expect a different name. It must not clash
+	// with the name of any tool argument (e.g. "namespace"). Has no effect for single-cluster strategies.
+	TargetParameterName string `toml:"target_parameter_name,omitempty"`
+	// MultiClusterConcurrency is the maximum number of cluster targets operations spanning multiple targets
+	// (e.g. warm_targets_on_startup) run against concurrently. Defaults to 10 if not set.
+	MultiClusterConcurrency int `toml:"multi_cluster_concurrency,omitzero"`
+	// ExcludedContexts are the names or glob patterns (e.g. "prod-*") of the kubeconfig contexts that are not exposed
+	// as targets of the multi-cluster tools nor shown by the configuration tools (e.g. configuration_contexts_list,
+	// configuration_view). The current (default) context can't be excluded.
+	ExcludedContexts []string `toml:"excluded_contexts,omitempty"`
+	// ValidateDefaultTargetOnStartup checks at startup that the default cluster target (e.g. the kubeconfig current-context)
+	// is reachable by performing a discovery request. If unreachable, "warn" logs a warning and "fail" aborts the startup.
+	// Disabled if empty (default).
+	ValidateDefaultTargetOnStartup string `toml:"validate_default_target_on_startup,omitempty"`
+	// ToolsetLoadRetries is the number of times the initial load of the toolsets is retried (with an exponential backoff)
+	// when it fails at startup (e.g. the API server is briefly unreachable), so that the server can start once the
+	// cluster is reachable. Disabled if zero (default), the startup fails immediately.
+	ToolsetLoadRetries int `toml:"toolset_load_retries,omitzero"`
 
 	// ClusterProvider-specific configurations
 	// This map holds raw TOML primitives that will be parsed by registered provider parsers
 	ClusterProviderConfigs map[string]toml.Primitive `toml:"cluster_provider_configs,omitempty"`
 
+	// DiscoveryCacheTTLSeconds is the number of seconds the API discovery results are reused before being invalidated.
+	// Explicit invalidation requests (e.g. from the cluster state watcher) are always honored.
+	// If zero (default), the discovery results are reused until explicitly invalidated.
+	DiscoveryCacheTTLSeconds int `toml:"discovery_cache_ttl_seconds,omitzero"`
+	// PreferredVersions pins the API version (value) used for the resources of an API group (key).
+	// When set for a group, the configured version is used instead of the discovery-preferred one when no version is
+	// requested. The resources_get and resources_list tools use the configured version instead of the requested one,
+	// other requests for a different version of the group fail with an error.
+	PreferredVersions map[string]string `toml:"preferred_versions,omitempty"`
+	// MetricsGroupVersion pins the group/version of the metrics API (e.g. metrics.k8s.io/v1beta1) queried by the
+	// pods_top and nodes_top tools, for clusters serving it through an aggregated API at a nonstandard location.
+	// If empty (default), the discovery-preferred version of the metrics.k8s.io group is used.
+	MetricsGroupVersion string `toml:"metrics_group_version,omitempty"`
+	// ExcludeSystemNamespaces filters out the resources in system namespaces (see SystemNamespacePrefixes)
+	// from the list results when listing resources across all namespaces.
+	// Listing the resources of a specific system namespace is not affected.
+	ExcludeSystemNamespaces bool `toml:"exclude_system_namespaces,omitempty"`
+	// SystemNamespacePrefixes are the name prefixes of the namespaces considered system namespaces when
+	// ExcludeSystemNamespaces is enabled. Defaults to DefaultSystemNamespacePrefixes if not set.
+	SystemNamespacePrefixes []string `toml:"system_namespace_prefixes,omitempty"`
+
 	// Toolset-specific configurations
 	// This map holds raw TOML primitives that will be parsed by registered toolset parsers
 	ToolsetConfigs map[string]toml.Primitive `toml:"toolset_configs,omitempty"`
@@ -85,6 +281,10 @@ type StaticConfig struct {
 	// Server instructions to be provided by the MCP server to the MCP client
 	// This can be used to provide specific instructions on how the client should use the server
 	ServerInstructions string `toml:"server_instructions,omitempty"`
+	// ServerName overrides the name advertised by the MCP server in the initialize result (defaults to the binary name)
+	ServerName string `toml:"server_name,omitempty"`
+	// ServerTitle overrides the title advertised by the MCP server in the initialize result (defaults to the server name)
+	ServerTitle string `toml:"server_title,omitempty"`
 
 	// Internal: parsed provider configs (not exposed to TOML package)
 	parsedClusterProviderConfigs map[string]api.ExtendedConfig
@@ -280,6 +480,10 @@ func ReadToml(configData []byte, opts ...ReadConfigOpt) (*StaticConfig, error) {
 		opt(config)
 	}
 
+	if err = config.loadDeniedResourcesFile(); err != nil {
+		return nil, err
+	}
+
 	ctx := withConfigDirPath(context.Background(), config.configDirPath)
 
 	config.parsedClusterProviderConfigs, err = providerConfigRegistry.parse(ctx, md, config.ClusterProviderConfigs)
@@ -295,6 +499,30 @@ func ReadToml(configData []byte, opts ...ReadConfigOpt) (*StaticConfig, error) {
 	return config, nil
 }
 
+// loadDeniedResourcesFile reads the denied_resources entries from the DeniedResourcesFile (if provided)
+// and appends them to the inline DeniedResources.
+func (c *StaticConfig) loadDeniedResourcesFile() error {
+	if c.DeniedResourcesFile == "" {
+		return nil
+	}
+	path := c.DeniedResourcesFile
+	if c.configDirPath != "" && !filepath.IsAbs(path) {
+		path = filepath.Join(c.configDirPath, path)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read denied resources file %s: %w", path, err)
+	}
+	var deniedResources struct {
+		DeniedResources []api.GroupVersionKind `toml:"denied_resources"`
+	}
+	if err = toml.Unmarshal(data, &deniedResources); err != nil {
+		return fmt.Errorf("failed to parse denied resources file %s: %w", path, err)
+	}
+	c.DeniedResources = append(c.DeniedResources, deniedResources.DeniedResources...)
+	return nil
+}
+
 func (c *StaticConfig) GetClusterProviderStrategy() string {
 	return c.ClusterProviderStrategy
 }
@@ -303,10 +531,148 @@ func (c *StaticConfig) GetDeniedResources() []api.GroupVersionKind {
 	return c.DeniedResources
 }
 
+func (c *StaticConfig) GetDiscoveryCacheTTL() time.Duration {
+	return time.Duration(c.DiscoveryCacheTTLSeconds) * time.Second
+}
+
+// GetTokenExchangeCooldown returns the period the token exchange is skipped for a failing target.
+func (c *StaticConfig) GetTokenExchangeCooldown() time.Duration {
+	if c.TokenExchangeCooldownSeconds <= 0 {
+		return DefaultTokenExchangeCooldown
+	}
+	return time.Duration(c.TokenExchangeCooldownSeconds) * time.Second
+}
+
+// GetShutdownTimeout returns the maximum time the HTTP server waits for the in-flight requests and tool calls on shutdown.
+func (c *StaticConfig) GetShutdownTimeout() time.Duration {
+	if c.ShutdownTimeoutSeconds <= 0 {
+		return DefaultShutdownTimeout
+	}
+	return time.Duration(c.ShutdownTimeoutSeconds) * time.Second
+}
+
+// GetTLSConfig returns the TLS configuration of the HTTP server built from TLSMinVersion and TLSCipherSuites,
+// nil if none of them is set.
+func (c *StaticConfig) GetTLSConfig() (*tls.Config, error) {
+	if c.TLSMinVersion == "" && len(c.TLSCipherSuites) == 0 {
+		return nil, nil
+	}
+	tlsConfig := &tls.Config{}
+	if c.TLSMinVersion != "" {
+		minVersion, ok := tlsVersions[c.TLSMinVersion]
+		if !ok {
+			return nil, fmt.Errorf("invalid tls_min_version: %s, valid values are: 1.0, 1.1, 1.2, 1.3", c.TLSMinVersion)
+		}
+		tlsConfig.MinVersion = minVersion
+	}
+	for _, name := range c.TLSCipherSuites {
+		id, ok := tlsCipherSuites()[name]
+		if !ok {
+			return nil, fmt.Errorf("invalid tls_cipher_suites: %s is not a supported cipher suite", name)
+		}
+		tlsConfig.CipherSuites = append(tlsConfig.CipherSuites, id)
+	}
+	return tlsConfig, nil
+}
+
+var tlsVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// tlsCipherSuites returns the IDs of the cipher suites implemented by crypto/tls (including the insecure ones) by name
+func tlsCipherSuites() map[string]uint16 {
+	suites := make(map[string]uint16)
+	for _, suite := range append(tls.CipherSuites(), tls.InsecureCipherSuites()...) {
+		suites[suite.Name] = suite.ID
+	}
+	return suites
+}
+
+// GetMaxTokenAge returns the maximum age of the accepted OAuth tokens, zero if disabled.
+func (c *StaticConfig) GetMaxTokenAge() time.Duration {
+	return time.Duration(c.MaxTokenAgeSeconds) * time.Second
+}
+
+func (c *StaticConfig) GetPreferredVersions() map[string]string {
+	return c.PreferredVersions
+}
+
+func (c *StaticConfig) GetMetricsGroupVersion() string {
+	return c.MetricsGroupVersion
+}
+
+func (c *StaticConfig) GetExcludedNamespacePrefixes() []string {
+	if !c.ExcludeSystemNamespaces {
+		return nil
+	}
+	if len(c.SystemNamespacePrefixes) == 0 {
+		return DefaultSystemNamespacePrefixes
+	}
+	return c.SystemNamespacePrefixes
+}
+
 func (c *StaticConfig) GetKubeConfigPath() string {
 	return c.KubeConfig
 }
 
+func (c *StaticConfig) GetKubeConfigPaths() []string {
+	return c.KubeConfigs
+}
+
+func (c *StaticConfig) GetDefaultNamespace() string {
+	return c.DefaultNamespace
+}
+
+func (c *StaticConfig) GetProxyURL() string {
+	return c.ProxyURL
+}
+
+func (c *StaticConfig) GetMultiClusterConcurrency() int {
+	return c.MultiClusterConcurrency
+}
+
+func (c *StaticConfig) GetExcludedContexts() []string {
+	return c.ExcludedContexts
+}
+
+func (c *StaticConfig) GetMetricsUnavailableBehavior() string {
+	return c.MetricsUnavailableBehavior
+}
+
+func (c *StaticConfig) GetDefaultLogTailLines() int64 {
+	return c.DefaultLogTailLines
+}
+
+func (c *StaticConfig) GetDefaultAllNamespaces() bool {
+	if c.DefaultAllNamespaces == nil {
+		return true
+	}
+	return *c.DefaultAllNamespaces
+}
+
+func (c *StaticConfig) GetPodsTopConcurrency() int {
+	return c.PodsTopConcurrency
+}
+
+func (c *StaticConfig) GetMaskedMetadataKeys() []string {
+	return c.MaskedMetadataKeys
+}
+
+func (c *StaticConfig) IsRuntimeLogLevelAllowed() bool {
+	return c.AllowRuntimeLogLevel
+}
+
+func (c *StaticConfig) IsConfigExposed() bool {
+	return c.ExposeConfig
+}
+
+func (c *StaticConfig) GetMaxListItems() int {
+	return c.MaxListItems
+}
+
 func (c *StaticConfig) GetProviderConfig(strategy string) (api.ExtendedConfig, bool) {
 	cfg, ok := c.parsedClusterProviderConfigs[strategy]
 
@@ -318,6 +684,34 @@ func (c *StaticConfig) GetToolsetConfig(name string) (api.ExtendedConfig, bool)
 	return cfg, ok
 }
 
+// GetEffectiveConfig returns the TOML representation of the configuration with the secrets redacted.
+// The cluster provider and toolset specific configurations are omitted since they might contain credentials.
+func (c *StaticConfig) GetEffectiveConfig() (string, error) {
+	redacted := *c
+	if redacted.StsClientSecret != "" {
+		redacted.StsClientSecret = RedactedValue
+	}
+	if proxyURL, err := url.Parse(redacted.ProxyURL); err == nil && redacted.ProxyURL != "" {
+		// Hide the password of the proxy credentials, if any
+		redacted.ProxyURL = proxyURL.Redacted()
+	}
+	redacted.ClusterProviderConfigs = nil
+	redacted.ToolsetConfigs = nil
+	buf := new(bytes.Buffer)
+	if err := toml.NewEncoder(buf).Encode(redacted); err != nil {
+		return "", fmt.Errorf("failed to encode config: %w", err)
+	}
+	return buf.String(), nil
+}
+
 func (c *StaticConfig) IsRequireOAuth() bool {
 	return c.RequireOAuth
 }
+
+func (c *StaticConfig) IsClientCredentialsIgnored() bool {
+	return c.IgnoreClientCredentials
+}
+
+func (c *StaticConfig) GetAuthHeaderPropagationAllowedHosts() []string {
+	return c.AuthHeaderPropagationAllowedHosts
+}
@@ -2,14 +2,19 @@ package helm
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"sort"
 	"time"
 
+	"github.com/pmezard/go-difflib/difflib"
 	"helm.sh/helm/v3/pkg/action"
 	"helm.sh/helm/v3/pkg/chart/loader"
 	"helm.sh/helm/v3/pkg/cli"
 	"helm.sh/helm/v3/pkg/registry"
 	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage/driver"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/klog/v2"
 	"sigs.k8s.io/yaml"
@@ -105,6 +110,122 @@ func (h *Helm) Uninstall(name string, namespace string) (string, error) {
 	return fmt.Sprintf("Uninstalled release %s %s", uninstalledRelease.Release.Name, uninstalledRelease.Info), nil
 }
 
+// History lists the revisions of the specified release (newest first).
+func (h *Helm) History(name string, namespace string, max int) (string, error) {
+	cfg, err := h.newAction(h.kubernetes.NamespaceOrDefault(namespace), false)
+	if err != nil {
+		return "", err
+	}
+	releases, err := h.history(cfg, name, max)
+	if err != nil {
+		return "", err
+	}
+	ret, err := yaml.Marshal(simplifyHistory(releases...))
+	if err != nil {
+		return "", err
+	}
+	return string(ret), nil
+}
+
+// Rollback rolls back the specified release to the provided revision.
+// The rollback doesn't wait for the rolled back resources to be ready, only the rollback hooks are waited for (bounded by
+// the deadline of the provided context, 5 minutes at most).
+func (h *Helm) Rollback(ctx context.Context, name string, namespace string, revision int) (string, error) {
+	cfg, err := h.newAction(h.kubernetes.NamespaceOrDefault(namespace), false)
+	if err != nil {
+		return "", err
+	}
+	releases, err := h.history(cfg, name, 0)
+	if err != nil {
+		return "", err
+	}
+	if !slices.ContainsFunc(releases, func(r *release.Release) bool { return r.Version == revision }) {
+		return "", fmt.Errorf("revision %d not found for release %s", revision, name)
+	}
+	// action.Rollback doesn't accept a context (unlike action.Install), waiting for the resources to be ready would
+	// outlive the tool call, the resources might still be rolling out once the rollback returns
+	rollback := action.NewRollback(cfg)
+	rollback.Version = revision
+	rollback.Timeout = 5 * time.Minute
+	if deadline, ok := ctx.Deadline(); ok {
+		rollback.Timeout = min(rollback.Timeout, time.Until(deadline))
+	}
+	if err = rollback.Run(name); err != nil {
+		return "", err
+	}
+	current, err := cfg.Releases.Last(name)
+	if err != nil {
+		return fmt.Sprintf("Rolled back release %s to revision %d (the rolled back resources might still be rolling out)", name, revision), nil
+	}
+	ret, err := yaml.Marshal(simplifyHistory(current))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Rolled back release %s to revision %d (the rolled back resources might still be rolling out), current release:\n%s",
+		name, revision, ret), nil
+}
+
+// DiffRevisions returns a unified diff of the rendered manifests of two revisions of the specified release.
+func (h *Helm) DiffRevisions(name string, namespace string, fromRevision int, toRevision int) (string, error) {
+	cfg, err := h.newAction(h.kubernetes.NamespaceOrDefault(namespace), false)
+	if err != nil {
+		return "", err
+	}
+	releases, err := h.history(cfg, name, 0)
+	if err != nil {
+		return "", err
+	}
+	revision := func(version int) (*release.Release, error) {
+		idx := slices.IndexFunc(releases, func(r *release.Release) bool { return r.Version == version })
+		if idx < 0 {
+			return nil, fmt.Errorf("revision %d not found for release %s", version, name)
+		}
+		return releases[idx], nil
+	}
+	from, err := revision(fromRevision)
+	if err != nil {
+		return "", err
+	}
+	to, err := revision(toRevision)
+	if err != nil {
+		return "", err
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(from.Manifest),
+		B:        difflib.SplitLines(to.Manifest),
+		FromFile: fmt.Sprintf("%s revision %d", name, fromRevision),
+		ToFile:   fmt.Sprintf("%s revision %d", name, toRevision),
+		Context:  3,
+	})
+	if err != nil {
+		return "", err
+	}
+	if diff == "" {
+		return fmt.Sprintf("No differences between the manifests of revisions %d and %d of release %s", fromRevision, toRevision, name), nil
+	}
+	return diff, nil
+}
+
+func (h *Helm) history(cfg *action.Configuration, name string, max int) ([]*release.Release, error) {
+	history := action.NewHistory(cfg)
+	if max > 0 {
+		history.Max = max
+	}
+	releases, err := history.Run(name)
+	if errors.Is(err, driver.ErrReleaseNotFound) || (err == nil && len(releases) == 0) {
+		return nil, fmt.Errorf("release %s not found", name)
+	} else if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(releases, func(i, j int) bool {
+		return releases[i].Version > releases[j].Version
+	})
+	if max > 0 && len(releases) > max {
+		releases = releases[:max]
+	}
+	return releases, nil
+}
+
 func (h *Helm) newAction(namespace string, allNamespaces bool) (*action.Configuration, error) {
 	cfg := new(action.Configuration)
 	applicableNamespace := ""
@@ -141,3 +262,14 @@ func simplify(release ...*release.Release) []map[string]interface{} {
 	}
 	return ret
 }
+
+func simplifyHistory(release ...*release.Release) []map[string]interface{} {
+	ret := simplify(release...)
+	for i, r := range release {
+		delete(ret[i], "namespace")
+		if r.Info != nil && r.Info.Description != "" {
+			ret[i]["description"] = r.Info.Description
+		}
+	}
+	return ret
+}
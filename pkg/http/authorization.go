@@ -4,7 +4,11 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/coreos/go-oidc/v3/oidc"
 	"github.com/go-jose/go-jose/v4"
@@ -40,15 +44,19 @@ func write401(w http.ResponseWriter, wwwAuthenticateHeader, errorType, message s
 //	    2.1. Raw Token Validation (oidcProvider is nil):
 //	         - The token is validated offline for basic sanity checks (expiration).
 //	         - If OAuthAudience is set, the token is validated against the audience.
+//	         - If MaxTokenAgeSeconds is set, the token is validated against its issued at (iat) claim.
+//	         - If RequiredTokenClaims is set, the token is validated to carry the required claims (and values).
 //
 //	         see TestAuthorizationRawToken
 //
 //	    2.2. OIDC Provider Validation (oidcProvider is not nil):
 //	         - The token is validated offline for basic sanity checks (audience and expiration).
 //	         - If OAuthAudience is set, the token is validated against the audience.
+//	         - If RequiredTokenClaims is set, the token is validated to carry the required claims (and values).
 //	         - The token is then validated against the OIDC Provider.
+//	           Tokens signed with an unknown key (e.g. the IdP rotated its signing keys) refresh the cached provider keys.
 //
-//	         see TestAuthorizationOidcToken
+//	         see TestAuthorizationOidcToken, TestAuthorizationOidcKeyRotation
 func AuthorizationMiddleware(staticConfig *config.StaticConfig, oidcProvider *oidc.Provider) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -82,7 +90,10 @@ func AuthorizationMiddleware(staticConfig *config.StaticConfig, oidcProvider *oi
 			}
 			// Offline validation
 			if err == nil {
-				err = claims.ValidateOffline(staticConfig.OAuthAudience)
+				err = claims.ValidateOffline(staticConfig.OAuthAudience, staticConfig.GetMaxTokenAge())
+			}
+			if err == nil {
+				err = claims.ValidateRequiredClaims(staticConfig.RequiredTokenClaims)
 			}
 			// Online OIDC provider validation
 			if err == nil {
@@ -94,6 +105,11 @@ func AuthorizationMiddleware(staticConfig *config.StaticConfig, oidcProvider *oi
 				klog.V(2).Infof("JWT token validated - Scopes: %v", scopes)
 				r = r.WithContext(context.WithValue(r.Context(), mcp.TokenScopesContextKey, scopes))
 			}
+			if err == nil && staticConfig.TenantClaim != "" {
+				if tenant, found := claims.GetClaim(staticConfig.TenantClaim); found {
+					setAccessLogTenant(r.Context(), tenant)
+				}
+			}
 			if err != nil {
 				klog.V(1).Infof("Authentication failed - JWT validation error: %s %s from %s, error: %v", r.Method, r.URL.Path, r.RemoteAddr, err)
 				write401(w, wwwAuthenticateHeader, "invalid_token", "Unauthorized: Invalid token")
@@ -125,6 +141,8 @@ type JWTClaims struct {
 	jwt.Claims
 	Token string `json:"-"`
 	Scope string `json:"scope,omitempty"`
+	// Extra contains all the claims of the token (including the registered ones), used to validate arbitrary claims.
+	Extra map[string]any `json:"-"`
 }
 
 func (c *JWTClaims) GetScopes() []string {
@@ -135,7 +153,8 @@ func (c *JWTClaims) GetScopes() []string {
 }
 
 // ValidateOffline Checks if the JWT claims are valid and if the audience matches the expected one.
-func (c *JWTClaims) ValidateOffline(audience string) error {
+// If maxAge is greater than zero, tokens issued (iat) longer than maxAge ago are rejected.
+func (c *JWTClaims) ValidateOffline(audience string, maxAge time.Duration) error {
 	expected := jwt.Expected{}
 	if audience != "" {
 		expected.AnyAudience = jwt.Audience{audience}
@@ -143,9 +162,109 @@ func (c *JWTClaims) ValidateOffline(audience string) error {
 	if err := c.Validate(expected); err != nil {
 		return fmt.Errorf("JWT token validation error: %v", err)
 	}
+	if maxAge > 0 {
+		if c.IssuedAt == nil {
+			return fmt.Errorf("JWT token validation error: missing issued at claim (iat), required to enforce a maximum token age of %v", maxAge)
+		}
+		if age := time.Since(c.IssuedAt.Time()); age > maxAge {
+			return fmt.Errorf("JWT token validation error: token is too old (iat), issued %v ago, maximum age is %v", age.Truncate(time.Second), maxAge)
+		}
+	}
+	return nil
+}
+
+// ValidateRequiredClaims checks that the token carries each of the required claims (claim name -> expected value).
+// An empty expected value only requires the claim to be present, array claims (e.g. groups) must contain the expected value.
+func (c *JWTClaims) ValidateRequiredClaims(required map[string]string) error {
+	for _, name := range sortedKeys(required) {
+		value, found := c.Extra[name]
+		if !found || value == nil {
+			return fmt.Errorf("JWT token validation error: missing required claim (%s)", name)
+		}
+		expected := required[name]
+		if expected != "" && !claimMatches(value, expected) {
+			return fmt.Errorf("JWT token validation error: claim (%s) does not match the required value %q", name, expected)
+		}
+	}
 	return nil
 }
 
+// claimMatches returns true if the claim value is (or, for array claims, contains) the expected value.
+func claimMatches(value any, expected string) bool {
+	if values, ok := value.([]any); ok {
+		for _, v := range values {
+			if claimMatches(v, expected) {
+				return true
+			}
+		}
+		return false
+	}
+	switch v := value.(type) {
+	case string:
+		return v == expected
+	case bool, float64:
+		return fmt.Sprint(v) == expected
+	default:
+		return false
+	}
+}
+
+// claimPathRegexp matches a claim name optionally followed by the index of an element of an array claim (e.g. groups[0])
+var claimPathRegexp = regexp.MustCompile(`^([^\[\]]+)(?:\[(\d+)\])?$`)
+
+// ParseClaimPath returns the claim name and the array element index (-1 if none) of the provided claim path.
+func ParseClaimPath(path string) (string, int, error) {
+	m := claimPathRegexp.FindStringSubmatch(path)
+	if m == nil {
+		return "", -1, fmt.Errorf("invalid claim %q, expected a claim name optionally followed by an array index (e.g. groups[0])", path)
+	}
+	if m[2] == "" {
+		return m[1], -1, nil
+	}
+	index, err := strconv.Atoi(m[2])
+	if err != nil {
+		return "", -1, fmt.Errorf("invalid claim %q: %v", path, err)
+	}
+	return m[1], index, nil
+}
+
+// GetClaim returns the string value of the claim with the provided path (see ParseClaimPath).
+// Only string, boolean, and numeric values are returned, the claim is reported as not found otherwise.
+func (c *JWTClaims) GetClaim(path string) (string, bool) {
+	name, index, err := ParseClaimPath(path)
+	if err != nil {
+		return "", false
+	}
+	value, found := c.Extra[name]
+	if !found {
+		return "", false
+	}
+	if index >= 0 {
+		values, ok := value.([]any)
+		if !ok || index >= len(values) {
+			return "", false
+		}
+		value = values[index]
+	}
+	switch v := value.(type) {
+	case string:
+		return v, v != ""
+	case bool, float64:
+		return fmt.Sprint(v), true
+	default:
+		return "", false
+	}
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 // ValidateWithProvider validates the JWT claims against the OIDC provider.
 func (c *JWTClaims) ValidateWithProvider(ctx context.Context, audience string, provider *oidc.Provider) error {
 	if provider != nil {
@@ -166,7 +285,7 @@ func ParseJWTClaims(token string) (*JWTClaims, error) {
 		return nil, fmt.Errorf("failed to parse JWT token: %w", err)
 	}
 	claims := &JWTClaims{}
-	err = tkn.UnsafeClaimsWithoutVerification(claims)
+	err = tkn.UnsafeClaimsWithoutVerification(claims, &claims.Extra)
 	claims.Token = token
 	return claims, err
 }
@@ -3,6 +3,7 @@ package http
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-jose/go-jose/v4/jwt"
 )
@@ -111,7 +112,7 @@ func TestJWTTokenValidateOffline(t *testing.T) {
 			t.Fatalf("expected no error for expired token parsing, got %v", err)
 		}
 
-		err = claims.ValidateOffline("mcp-server")
+		err = claims.ValidateOffline("mcp-server", 0)
 		if err == nil {
 			t.Fatalf("expected error for expired token, got nil")
 		}
@@ -130,7 +131,7 @@ func TestJWTTokenValidateOffline(t *testing.T) {
 			t.Fatalf("expected claims to be returned, got nil")
 		}
 
-		err = claims.ValidateOffline("mcp-server")
+		err = claims.ValidateOffline("mcp-server", 0)
 		if err != nil {
 			t.Fatalf("expected no error for valid audience, got %v", err)
 		}
@@ -145,7 +146,7 @@ func TestJWTTokenValidateOffline(t *testing.T) {
 			t.Fatalf("expected claims to be returned, got nil")
 		}
 
-		err = claims.ValidateOffline("missing-audience")
+		err = claims.ValidateOffline("missing-audience", 0)
 		if err == nil {
 			t.Fatalf("expected error for token with wrong audience, got nil")
 		}
@@ -156,6 +157,153 @@ func TestJWTTokenValidateOffline(t *testing.T) {
 	})
 }
 
+func TestJWTTokenValidateOfflineMaxAge(t *testing.T) {
+	t.Run("old token returns error", func(t *testing.T) {
+		claims, err := ParseJWTClaims(tokenBasicNotExpired)
+		if err != nil {
+			t.Fatalf("expected no error for token parsing, got %v", err)
+		}
+
+		err = claims.ValidateOffline("mcp-server", time.Hour)
+		if err == nil {
+			t.Fatalf("expected error for old token, got nil")
+		}
+
+		if !strings.Contains(err.Error(), "token is too old (iat)") {
+			t.Errorf("expected token age error message, got %v", err)
+		}
+	})
+
+	t.Run("old token with max age disabled", func(t *testing.T) {
+		claims, err := ParseJWTClaims(tokenBasicNotExpired)
+		if err != nil {
+			t.Fatalf("expected no error for token parsing, got %v", err)
+		}
+
+		err = claims.ValidateOffline("mcp-server", 0)
+		if err != nil {
+			t.Fatalf("expected no error with max age disabled, got %v", err)
+		}
+	})
+
+	t.Run("fresh token", func(t *testing.T) {
+		claims := &JWTClaims{Claims: jwt.Claims{
+			Audience: jwt.Audience{"mcp-server"},
+			IssuedAt: jwt.NewNumericDate(time.Now().Add(-time.Minute)),
+			Expiry:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		}}
+
+		err := claims.ValidateOffline("mcp-server", time.Hour)
+		if err != nil {
+			t.Fatalf("expected no error for fresh token, got %v", err)
+		}
+	})
+
+	t.Run("token without iat returns error", func(t *testing.T) {
+		claims := &JWTClaims{Claims: jwt.Claims{
+			Audience: jwt.Audience{"mcp-server"},
+			Expiry:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		}}
+
+		err := claims.ValidateOffline("mcp-server", time.Hour)
+		if err == nil {
+			t.Fatalf("expected error for token without iat, got nil")
+		}
+
+		if !strings.Contains(err.Error(), "missing issued at claim (iat)") {
+			t.Errorf("expected missing iat error message, got %v", err)
+		}
+	})
+}
+
+func TestJWTClaimsValidateRequiredClaims(t *testing.T) {
+	claims, err := ParseJWTClaims(tokenBasicNotExpired)
+	if err != nil {
+		t.Fatalf("expected no error for token parsing, got %v", err)
+	}
+
+	t.Run("no required claims", func(t *testing.T) {
+		if err := claims.ValidateRequiredClaims(nil); err != nil {
+			t.Fatalf("expected no error without required claims, got %v", err)
+		}
+	})
+
+	t.Run("required claims present", func(t *testing.T) {
+		err := claims.ValidateRequiredClaims(map[string]string{
+			"kubernetes.io": "",
+			"sub":           "system:serviceaccount:default:default",
+		})
+		if err != nil {
+			t.Fatalf("expected no error for present required claims, got %v", err)
+		}
+	})
+
+	t.Run("required claim missing returns error", func(t *testing.T) {
+		err := claims.ValidateRequiredClaims(map[string]string{"sub": "", "tenant": ""})
+		if err == nil {
+			t.Fatalf("expected error for missing required claim, got nil")
+		}
+
+		if !strings.Contains(err.Error(), "missing required claim (tenant)") {
+			t.Errorf("expected missing claim error message, got %v", err)
+		}
+	})
+
+	t.Run("required claim value mismatch returns error", func(t *testing.T) {
+		err := claims.ValidateRequiredClaims(map[string]string{"sub": "system:serviceaccount:default:other"})
+		if err == nil {
+			t.Fatalf("expected error for mismatched required claim, got nil")
+		}
+
+		if !strings.Contains(err.Error(), `claim (sub) does not match the required value "system:serviceaccount:default:other"`) {
+			t.Errorf("expected claim mismatch error message, got %v", err)
+		}
+	})
+
+	t.Run("required claim value in array claim", func(t *testing.T) {
+		groupClaims := &JWTClaims{Extra: map[string]any{"groups": []any{"developers", "admins"}}}
+
+		if err := groupClaims.ValidateRequiredClaims(map[string]string{"groups": "admins"}); err != nil {
+			t.Fatalf("expected no error for array claim containing the required value, got %v", err)
+		}
+		if err := groupClaims.ValidateRequiredClaims(map[string]string{"groups": "operators"}); err == nil {
+			t.Fatalf("expected error for array claim not containing the required value, got nil")
+		}
+	})
+}
+
+func TestJWTClaimsGetClaim(t *testing.T) {
+	claims := &JWTClaims{Extra: map[string]any{
+		"tenant":   "acme",
+		"groups":   []any{"developers", "admins"},
+		"verified": true,
+		"nested":   map[string]any{"tenant": "other"},
+	}}
+
+	t.Run("string claim", func(t *testing.T) {
+		if value, found := claims.GetClaim("tenant"); !found || value != "acme" {
+			t.Errorf("expected tenant claim to be acme, got %q (found %t)", value, found)
+		}
+	})
+	t.Run("array claim element", func(t *testing.T) {
+		if value, found := claims.GetClaim("groups[1]"); !found || value != "admins" {
+			t.Errorf("expected groups[1] claim to be admins, got %q (found %t)", value, found)
+		}
+	})
+	t.Run("boolean claim", func(t *testing.T) {
+		if value, found := claims.GetClaim("verified"); !found || value != "true" {
+			t.Errorf("expected verified claim to be true, got %q (found %t)", value, found)
+		}
+	})
+	t.Run("missing claims are not found", func(t *testing.T) {
+		for _, path := range []string{"missing", "groups[2]", "tenant[0]", "groups", "nested", "groups[", "[0]"} {
+			if value, found := claims.GetClaim(path); found {
+				t.Errorf("expected claim %s not to be found, got %q", path, value)
+			}
+		}
+	})
+}
+
 func TestJWTClaimsGetScopes(t *testing.T) {
 	t.Run("no scopes", func(t *testing.T) {
 		claims, err := ParseJWTClaims(tokenBasicExpired)
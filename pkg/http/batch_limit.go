@@ -0,0 +1,76 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// jsonRpcInvalidRequest is the JSON-RPC error code of the requests that aren't valid Request objects
+const jsonRpcInvalidRequest = -32600
+
+// BatchLimitMiddleware rejects the JSON-RPC batch requests (POST requests with a JSON array body) containing more
+// than maxBatchSize calls with a 400 Bad Request and a JSON-RPC error, so that a single HTTP request can't trigger
+// an unbounded number of tool calls.
+// JSON-RPC batching is only part of the 2025-03-26 revision of the MCP specification, the MCP server already rejects
+// the batches of the clients negotiating 2025-06-18 or later, so the limit only applies to 2025-03-26 clients.
+// The body is decoded as a stream and the request is rejected as soon as the limit is exceeded, without reading
+// the rest of the batch.
+// A zero (or negative) maxBatchSize disables the limit.
+func BatchLimitMiddleware(maxBatchSize int) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if maxBatchSize <= 0 {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost || r.Body == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+			body := r.Body
+			var read bytes.Buffer
+			if !exceedsBatchSize(io.TeeReader(body, &read), maxBatchSize) {
+				// Replay the inspected bytes followed by the rest of the body, malformed batches are left to the
+				// MCP server to report
+				r.Body = struct {
+					io.Reader
+					io.Closer
+				}{io.MultiReader(&read, body), body}
+				next.ServeHTTP(w, r)
+				return
+			}
+			_ = body.Close()
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"jsonrpc": "2.0",
+				"id":      nil,
+				"error": map[string]any{
+					"code":    jsonRpcInvalidRequest,
+					"message": fmt.Sprintf("batch exceeds the maximum batch size of %d calls", maxBatchSize),
+				},
+			})
+		})
+	}
+}
+
+// exceedsBatchSize decodes the calls of the JSON array (batch) read from body and returns true as soon as more than
+// maxBatchSize calls are found. Only the first token is read if the body isn't a JSON array.
+func exceedsBatchSize(body io.Reader, maxBatchSize int) bool {
+	decoder := json.NewDecoder(body)
+	if token, err := decoder.Token(); err != nil || token != json.Delim('[') {
+		return false
+	}
+	for calls := 1; decoder.More(); calls++ {
+		var call json.RawMessage
+		if err := decoder.Decode(&call); err != nil {
+			return false
+		}
+		if calls > maxBatchSize {
+			return true
+		}
+	}
+	return false
+}
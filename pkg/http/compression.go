@@ -0,0 +1,102 @@
+package http
+
+import (
+	"bufio"
+	"compress/gzip"
+	"net"
+	"net/http"
+	"strings"
+)
+
+// CompressionMiddleware gzip-encodes the responses for the clients advertising gzip support in their Accept-Encoding header.
+// The streamable HTTP event streams (text/event-stream responses) are compressed too, every flush of the handler flushes
+// the gzip stream so that the events are delivered to the clients as soon as they are sent.
+// The legacy SSE endpoint is never compressed.
+func CompressionMiddleware(enabled bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if !enabled {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == sseEndpoint || !acceptsGzip(r) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			w.Header().Add("Vary", "Accept-Encoding")
+			gw := &gzipResponseWriter{ResponseWriter: w}
+			defer gw.close()
+			next.ServeHTTP(gw, r)
+		})
+	}
+}
+
+func acceptsGzip(r *http.Request) bool {
+	for _, encoding := range strings.Split(r.Header.Get("Accept-Encoding"), ",") {
+		encoding, params, _ := strings.Cut(strings.TrimSpace(encoding), ";")
+		if strings.EqualFold(strings.TrimSpace(encoding), "gzip") && strings.ReplaceAll(params, " ", "") != "q=0" {
+			return true
+		}
+	}
+	return false
+}
+
+// gzipResponseWriter decides whether to compress the response once the headers are written,
+// either explicitly or implicitly by the first Write or Flush.
+// Responses that are already encoded or have no body are passed through unchanged.
+type gzipResponseWriter struct {
+	http.ResponseWriter
+	gz            *gzip.Writer
+	headerWritten bool
+}
+
+func (gw *gzipResponseWriter) WriteHeader(code int) {
+	if gw.headerWritten {
+		return
+	}
+	gw.headerWritten = true
+	h := gw.ResponseWriter.Header()
+	if h.Get("Content-Encoding") == "" && code != http.StatusNoContent && code != http.StatusNotModified {
+		h.Set("Content-Encoding", "gzip")
+		h.Del("Content-Length")
+		gw.gz = gzip.NewWriter(gw.ResponseWriter)
+	}
+	gw.ResponseWriter.WriteHeader(code)
+}
+
+func (gw *gzipResponseWriter) Write(b []byte) (int, error) {
+	if !gw.headerWritten {
+		if gw.Header().Get("Content-Type") == "" {
+			gw.Header().Set("Content-Type", http.DetectContentType(b))
+		}
+		gw.WriteHeader(http.StatusOK)
+	}
+	if gw.gz != nil {
+		return gw.gz.Write(b)
+	}
+	return gw.ResponseWriter.Write(b)
+}
+
+func (gw *gzipResponseWriter) Flush() {
+	if !gw.headerWritten {
+		gw.WriteHeader(http.StatusOK)
+	}
+	if gw.gz != nil {
+		_ = gw.gz.Flush()
+	}
+	if flusher, ok := gw.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (gw *gzipResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	if hijacker, ok := gw.ResponseWriter.(http.Hijacker); ok {
+		return hijacker.Hijack()
+	}
+	return nil, nil, http.ErrNotSupported
+}
+
+func (gw *gzipResponseWriter) close() {
+	if gw.gz != nil {
+		_ = gw.gz.Close()
+	}
+}
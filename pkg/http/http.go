@@ -28,19 +28,26 @@ func Serve(ctx context.Context, mcpServer *mcp.Server, staticConfig *config.Stat
 	mux := http.NewServeMux()
 
 	wrappedMux := RequestMiddleware(
-		AuthorizationMiddleware(staticConfig, oidcProvider)(mux),
+		AuthorizationMiddleware(staticConfig, oidcProvider)(
+			CompressionMiddleware(staticConfig.EnableCompression)(mux),
+		),
 	)
 
+	tlsConfig, err := staticConfig.GetTLSConfig()
+	if err != nil {
+		return err
+	}
 	httpServer := &http.Server{
-		Addr:    ":" + staticConfig.Port,
-		Handler: wrappedMux,
+		Addr:      ":" + staticConfig.Port,
+		Handler:   wrappedMux,
+		TLSConfig: tlsConfig,
 	}
 
 	sseServer := mcpServer.ServeSse()
 	streamableHttpServer := mcpServer.ServeHTTP()
-	mux.Handle(sseEndpoint, sseServer)
+	mux.Handle(sseEndpoint, SSEKeepAliveMiddleware(time.Duration(staticConfig.SSEKeepAliveSeconds)*time.Second)(sseServer))
 	mux.Handle(sseMessageEndpoint, sseServer)
-	mux.Handle(mcpEndpoint, streamableHttpServer)
+	mux.Handle(mcpEndpoint, BatchLimitMiddleware(staticConfig.MaxBatchSize)(streamableHttpServer))
 	mux.HandleFunc(healthEndpoint, func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 	})
@@ -55,7 +62,13 @@ func Serve(ctx context.Context, mcpServer *mcp.Server, staticConfig *config.Stat
 	serverErr := make(chan error, 1)
 	go func() {
 		klog.V(0).Infof("Streaming and SSE HTTP servers starting on port %s and paths /mcp, /sse, /message", staticConfig.Port)
-		if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		var err error
+		if staticConfig.TLSCertFile != "" {
+			err = httpServer.ListenAndServeTLS(staticConfig.TLSCertFile, staticConfig.TLSKeyFile)
+		} else {
+			err = httpServer.ListenAndServe()
+		}
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
 			serverErr <- err
 		}
 	}()
@@ -71,14 +84,25 @@ func Serve(ctx context.Context, mcpServer *mcp.Server, staticConfig *config.Stat
 		return err
 	}
 
-	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), staticConfig.GetShutdownTimeout())
 	defer shutdownCancel()
 
 	klog.V(0).Infof("Shutting down HTTP server gracefully...")
-	if err := httpServer.Shutdown(shutdownCtx); err != nil {
-		klog.Errorf("HTTP server shutdown error: %v", err)
+	shutdownErr := httpServer.Shutdown(shutdownCtx)
+	if shutdownErr != nil {
+		klog.Errorf("HTTP server shutdown error: %v", shutdownErr)
+	}
+	// Tool calls may outlive their HTTP request (e.g. SSE transport), drain them before the provider is closed
+	if activeToolCalls := mcpServer.ActiveToolCalls(); activeToolCalls > 0 {
+		klog.V(0).Infof("Waiting for %d in-flight tool calls to complete...", activeToolCalls)
+	}
+	if err := mcpServer.WaitForToolCalls(shutdownCtx); err != nil {
+		klog.Errorf("In-flight tool calls drain error: %v", err)
 		return err
 	}
+	if shutdownErr != nil {
+		return shutdownErr
+	}
 
 	klog.V(0).Infof("HTTP server shutdown complete")
 	return nil
@@ -2,17 +2,22 @@ package http
 
 import (
 	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/containers/kubernetes-mcp-server/internal/test"
 	"github.com/coreos/go-oidc/v3/oidc"
 	"github.com/coreos/go-oidc/v3/oidc/oidctest"
+	"github.com/go-jose/go-jose/v4"
 	"github.com/mark3labs/mcp-go/client"
 	"github.com/mark3labs/mcp-go/client/transport"
 	"github.com/stretchr/testify/suite"
@@ -233,6 +238,34 @@ func (s *AuthorizationSuite) TestAuthorizationUnauthorizedHeaderInvalidAudience(
 	})
 }
 
+func (s *AuthorizationSuite) TestAuthorizationUnauthorizedHeaderMissingRequiredClaim() {
+	// Bearer token without the required tenant claim
+	s.StaticConfig.RequiredTokenClaims = map[string]string{"tenant": ""}
+	s.StartServer()
+	s.StartClient(transport.WithHTTPHeaders(map[string]string{
+		"Authorization": "Bearer " + tokenBasicNotExpired,
+	}))
+
+	s.Run("Initialize returns error for MISSING REQUIRED CLAIM Authorization header", func() {
+		_, err := s.mcpClient.Initialize(s.T().Context(), test.McpInitRequest())
+		s.Require().Error(err, "Expected error creating initial request")
+		s.ErrorContains(err, "transport error: request failed with status 401: Unauthorized: Invalid token")
+	})
+
+	s.Run("Protected resource with MISSING REQUIRED CLAIM Authorization header", func() {
+		resp := s.HttpGet("Bearer " + tokenBasicNotExpired)
+		s.T().Cleanup(func() { _ = resp.Body.Close })
+
+		s.Run("returns 401 - Unauthorized status", func() {
+			s.Equal(401, resp.StatusCode, "Expected HTTP 401 for MISSING REQUIRED CLAIM Authorization header")
+		})
+		s.Run("logs error", func() {
+			s.Contains(s.logBuffer.String(), "Authentication failed - JWT validation error", "Expected log entry for JWT validation error")
+			s.Contains(s.logBuffer.String(), "missing required claim (tenant)", "Expected log entry for JWT validation error details")
+		})
+	})
+}
+
 func (s *AuthorizationSuite) TestAuthorizationUnauthorizedOidcValidation() {
 	// Failed OIDC validation
 	s.StaticConfig.OAuthAudience = "mcp-server"
@@ -339,6 +372,105 @@ func (s *AuthorizationSuite) TestAuthorizationOidcToken() {
 	s.Require().NoError(s.WaitForShutdown())
 }
 
+func (s *AuthorizationSuite) TestAuthorizationTenantClaim() {
+	s.MockServer.ResetHandlers()
+
+	oidcTestServer := NewOidcTestServer(s.T())
+	s.T().Cleanup(oidcTestServer.Close)
+	rawClaims := `{
+		"iss": "` + oidcTestServer.URL + `",
+		"exp": ` + strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10) + `,
+		"aud": "mcp-server",
+		"groups": ["tenant-acme", "developers"]
+	}`
+	validOidcToken := oidctest.SignIDToken(oidcTestServer.PrivateKey, "test-oidc-key-id", oidc.RS256, rawClaims)
+
+	s.OidcProvider = oidcTestServer.Provider
+	s.StaticConfig.OAuthAudience = "mcp-server"
+	s.StaticConfig.TenantClaim = "groups[0]"
+	s.StartServer()
+	s.StartClient(transport.WithHTTPHeaders(map[string]string{
+		"Authorization": "Bearer " + validOidcToken,
+	}))
+
+	s.Run("Initialize returns OK for VALID OIDC Authorization header", func() {
+		result, err := s.mcpClient.Initialize(s.T().Context(), test.McpInitRequest())
+		s.Require().NoError(err, "Expected no error creating initial request")
+		s.Require().NotNil(result, "Expected initial request to not be nil")
+	})
+	s.Run("logs the tenant in the access log", func() {
+		s.Regexp(`"POST /mcp 200 .+" requestID="[^"]+" tenant="tenant-acme"`, s.logBuffer.String())
+	})
+	s.Run("does not log the tenant of unauthorized requests", func() {
+		s.logBuffer.Reset()
+		resp := s.HttpGet("Bearer " + tokenBasicExpired)
+		s.T().Cleanup(func() { _ = resp.Body.Close })
+		s.Equal(401, resp.StatusCode, "Expected HTTP 401 for EXPIRED Authorization header")
+		s.Contains(s.logBuffer.String(), "GET /mcp 401")
+		s.NotContains(s.logBuffer.String(), "tenant=")
+	})
+	_ = s.mcpClient.Close()
+	s.mcpClient = nil
+	s.StopServer()
+	s.Require().NoError(s.WaitForShutdown())
+}
+
+func (s *AuthorizationSuite) TestAuthorizationOidcKeyRotation() {
+	s.MockServer.ResetHandlers()
+
+	oidcTestServer := NewOidcTestServer(s.T())
+	s.T().Cleanup(oidcTestServer.Close)
+	rotatedKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	s.Require().NoError(err, "failed to generate rotated key")
+	rotated := &atomic.Bool{}
+	keysRequests := &atomic.Int32{}
+	oidcTestServer.KeysEndpointHandler = func(w http.ResponseWriter, _ *http.Request) {
+		keysRequests.Add(1)
+		keys := []jose.JSONWebKey{
+			{Key: oidcTestServer.Public(), KeyID: "test-oidc-key-id", Algorithm: oidc.RS256, Use: "sig"},
+		}
+		if rotated.Load() {
+			keys = append(keys, jose.JSONWebKey{Key: rotatedKey.Public(), KeyID: "rotated-oidc-key-id", Algorithm: oidc.RS256, Use: "sig"})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: keys})
+	}
+	rawClaims := `{
+		"iss": "` + oidcTestServer.URL + `",
+		"exp": ` + strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10) + `,
+		"aud": "mcp-server"
+	}`
+	oidcToken := oidctest.SignIDToken(oidcTestServer.PrivateKey, "test-oidc-key-id", oidc.RS256, rawClaims)
+	rotatedOidcToken := oidctest.SignIDToken(rotatedKey, "rotated-oidc-key-id", oidc.RS256, rawClaims)
+
+	s.OidcProvider = oidcTestServer.Provider
+	s.StaticConfig.OAuthAudience = "mcp-server"
+	s.StartServer()
+
+	s.Run("Protected resource with token signed with the current key", func() {
+		resp := s.HttpGet("Bearer " + oidcToken)
+		s.T().Cleanup(func() { _ = resp.Body.Close })
+		s.NotEqual(401, resp.StatusCode, "Expected token signed with the current key to be accepted")
+	})
+	rotated.Store(true)
+	s.Run("Protected resource with token signed with a rotated key", func() {
+		resp := s.HttpGet("Bearer " + rotatedOidcToken)
+		s.T().Cleanup(func() { _ = resp.Body.Close })
+		s.Run("is accepted", func() {
+			s.NotEqual(401, resp.StatusCode, "Expected token signed with the rotated key to be accepted")
+		})
+		s.Run("refreshes the cached provider keys once", func() {
+			s.Equal(int32(2), keysRequests.Load(), "Expected the provider keys to be fetched again")
+		})
+	})
+	s.Run("Protected resource with token signed with a rotated key (cached)", func() {
+		resp := s.HttpGet("Bearer " + rotatedOidcToken)
+		s.T().Cleanup(func() { _ = resp.Body.Close })
+		s.NotEqual(401, resp.StatusCode, "Expected token signed with the rotated key to be accepted")
+		s.Equal(int32(2), keysRequests.Load(), "Expected the refreshed provider keys to be reused")
+	})
+}
+
 func TestAuthorization(t *testing.T) {
 	suite.Run(t, new(AuthorizationSuite))
 }
@@ -0,0 +1,90 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type BatchLimitSuite struct {
+	BaseHttpSuite
+}
+
+func (s *BatchLimitSuite) SetupTest() {
+	s.BaseHttpSuite.SetupTest()
+	// Stateless mode allows performing requests without initializing a session
+	s.StaticConfig.Stateless = true
+}
+
+// post performs a POST request to the MCP endpoint with a protocol version that still supports JSON-RPC batching
+func (s *BatchLimitSuite) post(body string) (*http.Response, []byte) {
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("http://127.0.0.1:%s/mcp", s.StaticConfig.Port), strings.NewReader(body))
+	s.Require().NoError(err, "Expected no error creating request")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json, text/event-stream")
+	req.Header.Set("Mcp-Protocol-Version", "2025-03-26")
+	resp, err := http.DefaultClient.Do(req)
+	s.Require().NoError(err, "Expected no error performing request")
+	defer func() { _ = resp.Body.Close() }()
+	respBody, err := io.ReadAll(resp.Body)
+	s.Require().NoError(err, "Expected no error reading response body")
+	return resp, respBody
+}
+
+// toolsListBatch returns a JSON-RPC batch with the provided number of tools/list calls
+func toolsListBatch(size int) string {
+	calls := make([]string, size)
+	for i := range calls {
+		calls[i] = fmt.Sprintf(`{"jsonrpc":"2.0","id":%d,"method":"tools/list","params":{}}`, i+1)
+	}
+	return "[" + strings.Join(calls, ",") + "]"
+}
+
+func (s *BatchLimitSuite) TestMaxBatchSize() {
+	s.StaticConfig.MaxBatchSize = 2
+	s.StartServer()
+	s.Run("oversized batch is rejected", func() {
+		resp, body := s.post(toolsListBatch(3))
+		s.Equal(http.StatusBadRequest, resp.StatusCode)
+		var decoded map[string]any
+		s.Require().NoErrorf(json.Unmarshal(body, &decoded), "Expected JSON-RPC error body, got %s", body)
+		s.Equal("2.0", decoded["jsonrpc"])
+		s.Equal(float64(-32600), decoded["error"].(map[string]any)["code"])
+		s.Equal("batch exceeds the maximum batch size of 2 calls", decoded["error"].(map[string]any)["message"])
+	})
+	s.Run("oversized batch is rejected without reading the rest of the body", func() {
+		resp, body := s.post(strings.TrimSuffix(toolsListBatch(3), "]") + `,{"jsonrpc":"2.0","id":4,`)
+		s.Equal(http.StatusBadRequest, resp.StatusCode)
+		s.Contains(string(body), "batch exceeds the maximum batch size of 2 calls")
+	})
+	s.Run("malformed batch within the limit is passed through", func() {
+		_, body := s.post(`[{"jsonrpc":"2.0","id":1,`)
+		s.NotContains(string(body), "exceeds the maximum batch size")
+	})
+	s.Run("batch within the limit is passed through", func() {
+		_, body := s.post(toolsListBatch(2))
+		s.NotContains(string(body), "exceeds the maximum batch size")
+	})
+	s.Run("single request is passed through", func() {
+		resp, body := s.post(`{"jsonrpc":"2.0","id":1,"method":"tools/list","params":{}}`)
+		s.Equal(http.StatusOK, resp.StatusCode)
+		s.Contains(string(body), "tools")
+	})
+}
+
+func (s *BatchLimitSuite) TestMaxBatchSizeDisabled() {
+	s.StartServer()
+	s.Run("batch is passed through", func() {
+		_, body := s.post(toolsListBatch(3))
+		s.NotContains(string(body), "exceeds the maximum batch size")
+	})
+}
+
+func TestBatchLimit(t *testing.T) {
+	suite.Run(t, new(BatchLimitSuite))
+}
@@ -0,0 +1,131 @@
+package http
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type CompressionSuite struct {
+	BaseHttpSuite
+}
+
+func (s *CompressionSuite) SetupTest() {
+	s.BaseHttpSuite.SetupTest()
+	// Stateless mode allows performing requests without initializing a session
+	s.StaticConfig.Stateless = true
+}
+
+func (s *CompressionSuite) toolsList(acceptEncoding string) *http.Response {
+	body := `{"jsonrpc":"2.0","id":1,"method":"tools/list","params":{}}`
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("http://127.0.0.1:%s/mcp", s.StaticConfig.Port), strings.NewReader(body))
+	s.Require().NoError(err, "Expected no error creating request")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json, text/event-stream")
+	// Setting Accept-Encoding explicitly disables the transparent decompression of the Go HTTP client
+	req.Header.Set("Accept-Encoding", acceptEncoding)
+	resp, err := http.DefaultClient.Do(req)
+	s.Require().NoError(err, "Expected no error performing request")
+	s.T().Cleanup(func() { _ = resp.Body.Close() })
+	return resp
+}
+
+// toolsListResult reads the tools/list response from the event stream body.
+func (s *CompressionSuite) toolsListResult(body io.Reader) map[string]any {
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	for scanner.Scan() {
+		if data, ok := strings.CutPrefix(scanner.Text(), "data: "); ok {
+			var decoded map[string]any
+			s.Require().NoErrorf(json.Unmarshal([]byte(data), &decoded), "Expected JSON event data, got %s", data)
+			return decoded
+		}
+	}
+	s.Require().NoError(scanner.Err(), "Expected no error reading the event stream")
+	s.Fail("Expected a data event in the event stream")
+	return nil
+}
+
+func (s *CompressionSuite) TestCompressionEnabled() {
+	s.StaticConfig.EnableCompression = true
+	s.StartServer()
+	s.Run("event stream response is gzip-encoded when the client advertises gzip", func() {
+		resp := s.toolsList("gzip, deflate")
+		s.Equal(http.StatusOK, resp.StatusCode)
+		s.Equal("gzip", resp.Header.Get("Content-Encoding"))
+		s.Contains(resp.Header.Values("Vary"), "Accept-Encoding")
+		s.Equal("text/event-stream", resp.Header.Get("Content-Type"))
+		gz, err := gzip.NewReader(resp.Body)
+		s.Require().NoError(err, "Expected gzip-encoded body")
+		decoded := s.toolsListResult(gz)
+		s.NotEmpty(decoded["result"].(map[string]any)["tools"])
+	})
+	s.Run("response is not encoded when the client does not advertise gzip", func() {
+		resp := s.toolsList("identity")
+		s.Equal(http.StatusOK, resp.StatusCode)
+		s.Empty(resp.Header.Get("Content-Encoding"))
+		decoded := s.toolsListResult(resp.Body)
+		s.NotEmpty(decoded["result"].(map[string]any)["tools"])
+	})
+	s.Run("SSE event stream is not encoded", func() {
+		ctx, cancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+		defer cancel()
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("http://127.0.0.1:%s/sse", s.StaticConfig.Port), nil)
+		s.Require().NoError(err, "Expected no error creating SSE request")
+		req.Header.Set("Accept", "text/event-stream")
+		req.Header.Set("Accept-Encoding", "gzip")
+		resp, err := http.DefaultClient.Do(req)
+		s.Require().NoError(err, "Expected no error connecting to SSE endpoint")
+		defer func() { _ = resp.Body.Close() }()
+		s.Empty(resp.Header.Get("Content-Encoding"))
+		scanner := bufio.NewScanner(resp.Body)
+		s.Require().True(scanner.Scan(), "Expected first SSE event")
+		s.Equal("event: endpoint", scanner.Text())
+	})
+}
+
+func (s *CompressionSuite) TestCompressionDisabled() {
+	s.StartServer()
+	s.Run("response is not encoded when compression is disabled", func() {
+		resp := s.toolsList("gzip")
+		s.Equal(http.StatusOK, resp.StatusCode)
+		s.Empty(resp.Header.Get("Content-Encoding"))
+	})
+}
+
+func (s *CompressionSuite) TestCompressionFlush() {
+	handler := CompressionMiddleware(true)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.(http.Flusher).Flush()
+		_, _ = w.Write([]byte("event: message\ndata: {}\n\n"))
+		w.(http.Flusher).Flush()
+	}))
+	recorder := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/mcp", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	handler.ServeHTTP(recorder, req)
+	s.Run("flush before write sends the Content-Encoding header", func() {
+		s.Equal("gzip", recorder.Result().Header.Get("Content-Encoding"))
+	})
+	s.Run("flushed events are gzip-encoded", func() {
+		gz, err := gzip.NewReader(recorder.Body)
+		s.Require().NoError(err, "Expected gzip-encoded body")
+		decompressed, err := io.ReadAll(gz)
+		s.Require().NoError(err, "Expected no error decompressing body")
+		s.Equal("event: message\ndata: {}\n\n", string(decompressed))
+	})
+}
+
+func TestCompression(t *testing.T) {
+	suite.Run(t, new(CompressionSuite))
+}
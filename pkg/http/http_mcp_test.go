@@ -1,14 +1,21 @@
 package http
 
 import (
+	"bufio"
+	"context"
 	"fmt"
+	"net/http"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/containers/kubernetes-mcp-server/internal/test"
 	"github.com/mark3labs/mcp-go/client"
 	"github.com/mark3labs/mcp-go/client/transport"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/stretchr/testify/suite"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type McpTransportSuite struct {
@@ -44,6 +51,57 @@ func (s *McpTransportSuite) TestSseTransport() {
 	})
 }
 
+func (s *McpTransportSuite) TestSseKeepAlive() {
+	s.StaticConfig.SSEKeepAliveSeconds = 1
+	s.StartServer()
+	ctx, cancel := context.WithTimeout(s.T().Context(), 5*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("http://127.0.0.1:%s/sse", s.StaticConfig.Port), nil)
+	s.Require().NoError(err, "Expected no error creating SSE request")
+	req.Header.Set("Accept", "text/event-stream")
+	start := time.Now()
+	resp, err := http.DefaultClient.Do(req)
+	s.Require().NoError(err, "Expected no error connecting to SSE endpoint")
+	defer func() { _ = resp.Body.Close() }()
+	var lines []string
+	keepAliveReceived := false
+	scanner := bufio.NewScanner(resp.Body)
+	for !keepAliveReceived && scanner.Scan() {
+		lines = append(lines, scanner.Text())
+		keepAliveReceived = scanner.Text() == ": keep-alive"
+	}
+	s.Run("Sends endpoint event first", func() {
+		s.Require().NotEmpty(lines)
+		s.Equal("event: endpoint", lines[0])
+	})
+	s.Run("Sends keep-alive comment", func() {
+		s.Truef(keepAliveReceived, "Expected keep-alive comment, got %v", lines)
+	})
+	s.Run("Sends keep-alive comment within the interval", func() {
+		s.Less(time.Since(start), 3*time.Second)
+	})
+}
+
+func (s *McpTransportSuite) TestSseWithoutKeepAlive() {
+	s.StartServer()
+	ctx, cancel := context.WithTimeout(s.T().Context(), 2*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("http://127.0.0.1:%s/sse", s.StaticConfig.Port), nil)
+	s.Require().NoError(err, "Expected no error creating SSE request")
+	req.Header.Set("Accept", "text/event-stream")
+	resp, err := http.DefaultClient.Do(req)
+	s.Require().NoError(err, "Expected no error connecting to SSE endpoint")
+	defer func() { _ = resp.Body.Close() }()
+	var lines []string
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	s.Run("Does not send keep-alive comments", func() {
+		s.NotContains(lines, ": keep-alive")
+	})
+}
+
 func (s *McpTransportSuite) TestStreamableHttpTransport() {
 	testCases := []bool{true, false}
 	for _, stateless := range testCases {
@@ -72,6 +130,84 @@ func (s *McpTransportSuite) TestStreamableHttpTransport() {
 	}
 }
 
+func (s *McpTransportSuite) TestGracefulShutdownWaitsForInFlightToolCalls() {
+	s.StaticConfig.Stateless = true
+	s.StaticConfig.ShutdownTimeoutSeconds = 5
+	toolCallStarted := make(chan struct{})
+	var kubeApiResponded atomic.Bool
+	s.MockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/api/v1/namespaces/slow/pods" {
+			return
+		}
+		close(toolCallStarted)
+		time.Sleep(1 * time.Second)
+		kubeApiResponded.Store(true)
+		test.WriteObject(w, &corev1.PodList{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "PodList"}})
+	}))
+	s.StartServer()
+	httpClient, err := client.NewStreamableHttpClient(fmt.Sprintf("http://127.0.0.1:%s/mcp", s.StaticConfig.Port))
+	s.Require().NoError(err, "Expected no error creating Streamable HTTP MCP client")
+	s.Require().NoError(httpClient.Start(s.T().Context()), "Expected no error starting Streamable HTTP MCP client")
+	_, err = httpClient.Initialize(s.T().Context(), test.McpInitRequest())
+	s.Require().NoError(err, "Expected no error initializing Streamable HTTP MCP client")
+	toolCallErr := make(chan error, 1)
+	go func() {
+		_, callErr := httpClient.CallTool(s.T().Context(), mcp.CallToolRequest{Params: mcp.CallToolParams{
+			Name:      "pods_list_in_namespace",
+			Arguments: map[string]interface{}{"namespace": "slow"},
+		}})
+		toolCallErr <- callErr
+	}()
+	select {
+	case <-toolCallStarted:
+	case <-time.After(5 * time.Second):
+		s.Fail("Tool call did not reach the Kubernetes API in time")
+	}
+	s.StopServer()
+	shutdownErr := s.WaitForShutdown()
+	s.Run("Stops gracefully", func() {
+		s.NoError(shutdownErr)
+	})
+	s.Run("Waits for the in-flight tool call before returning", func() {
+		s.True(kubeApiResponded.Load(), "Shutdown completed before the in-flight tool call")
+	})
+	s.Run("In-flight tool call completes successfully", func() {
+		select {
+		case callErr := <-toolCallErr:
+			s.NoError(callErr, "Expected the in-flight tool call to complete successfully")
+		case <-time.After(5 * time.Second):
+			s.Fail("In-flight tool call did not complete")
+		}
+	})
+	s.Run("No tool calls remain in flight", func() {
+		s.Zero(s.mcpServer.ActiveToolCalls())
+	})
+	_ = httpClient.Close()
+}
+
+func (s *McpTransportSuite) TestGracefulShutdownRejectsToolCallsWhileDraining() {
+	s.StaticConfig.Stateless = true
+	s.StartServer()
+	httpClient, err := client.NewStreamableHttpClient(fmt.Sprintf("http://127.0.0.1:%s/mcp", s.StaticConfig.Port))
+	s.Require().NoError(err, "Expected no error creating Streamable HTTP MCP client")
+	s.Require().NoError(httpClient.Start(s.T().Context()), "Expected no error starting Streamable HTTP MCP client")
+	s.T().Cleanup(func() { _ = httpClient.Close() })
+	_, err = httpClient.Initialize(s.T().Context(), test.McpInitRequest())
+	s.Require().NoError(err, "Expected no error initializing Streamable HTTP MCP client")
+	s.Require().NoError(s.mcpServer.WaitForToolCalls(s.T().Context()), "Expected no tool calls to drain")
+	_, err = httpClient.CallTool(s.T().Context(), mcp.CallToolRequest{Params: mcp.CallToolParams{
+		Name:      "namespaces_list",
+		Arguments: map[string]interface{}{},
+	}})
+	s.Run("Rejects the tool call", func() {
+		s.Require().Error(err, "Expected tool call to be rejected while draining")
+		s.Contains(err.Error(), "server is shutting down")
+	})
+	s.Run("No tool calls remain in flight", func() {
+		s.Zero(s.mcpServer.ActiveToolCalls())
+	})
+}
+
 func TestMcpTransport(t *testing.T) {
 	suite.Run(t, new(McpTransportSuite))
 }
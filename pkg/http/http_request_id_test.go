@@ -0,0 +1,123 @@
+package http
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+	"github.com/mark3labs/mcp-go/client"
+	"github.com/mark3labs/mcp-go/client/transport"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/klog/v2/textlogger"
+)
+
+type RequestIDSuite struct {
+	BaseHttpSuite
+	klogState klog.State
+	logBuffer bytes.Buffer
+	mu        sync.Mutex
+	// receivedRequestIDs are the X-Request-Id header values received by the mock Kube API server
+	receivedRequestIDs []string
+}
+
+func (s *RequestIDSuite) SetupTest() {
+	s.BaseHttpSuite.SetupTest()
+	s.receivedRequestIDs = nil
+	s.MockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/api/v1/namespaces/default/pods" {
+			return
+		}
+		s.mu.Lock()
+		s.receivedRequestIDs = append(s.receivedRequestIDs, req.Header.Get("X-Request-Id"))
+		s.mu.Unlock()
+		test.WriteObject(w, &corev1.PodList{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "PodList"}})
+	}))
+
+	// Capture logs
+	s.logBuffer.Reset()
+	s.klogState = klog.CaptureState()
+	flags := flag.NewFlagSet("test", flag.ContinueOnError)
+	klog.InitFlags(flags)
+	_ = flags.Set("v", "5")
+	klog.SetLogger(textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(5), textlogger.Output(&s.logBuffer))))
+}
+
+func (s *RequestIDSuite) TearDownTest() {
+	s.BaseHttpSuite.TearDownTest()
+	s.klogState.Restore()
+}
+
+func (s *RequestIDSuite) TestInboundRequestID() {
+	s.StartServer()
+	mcpClient, err := client.NewStreamableHttpClient(fmt.Sprintf("http://127.0.0.1:%s/mcp", s.StaticConfig.Port),
+		transport.WithHTTPHeaders(map[string]string{"X-Request-Id": "a-request-id-from-the-client"}))
+	s.Require().NoError(err, "Expected no error creating Streamable HTTP MCP client")
+	s.T().Cleanup(func() { _ = mcpClient.Close() })
+	s.Require().NoError(mcpClient.Start(s.T().Context()), "Expected no error starting Streamable HTTP MCP client")
+	_, err = mcpClient.Initialize(s.T().Context(), test.McpInitRequest())
+	s.Require().NoError(err, "Expected no error initializing Streamable HTTP MCP client")
+	callToolRequest := mcp.CallToolRequest{}
+	callToolRequest.Params.Name = "pods_list_in_namespace"
+	callToolRequest.Params.Arguments = map[string]interface{}{"namespace": "default"}
+	toolResult, err := mcpClient.CallTool(s.T().Context(), callToolRequest)
+	s.Require().NoError(err, "Expected no error calling tool")
+	s.Require().Falsef(toolResult.IsError, "call tool failed %v", toolResult.Content)
+
+	s.Run("Kube API server receives the request ID", func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.Require().NotEmpty(s.receivedRequestIDs, "Expected the Kube API server to receive requests")
+		for _, requestID := range s.receivedRequestIDs {
+			s.Equal("a-request-id-from-the-client", requestID)
+		}
+	})
+	s.Run("Access log contains the request ID", func() {
+		s.Contains(s.logBuffer.String(), `"POST /mcp 200`)
+		s.Contains(s.logBuffer.String(), `requestID="a-request-id-from-the-client"`)
+	})
+	s.Run("Tool call log contains the request ID", func() {
+		s.Regexp(`"mcp tool call: pods_list_in_namespace\(.+\)" requestID="a-request-id-from-the-client"`, s.logBuffer.String())
+	})
+	s.Run("Failed tool call", func() {
+		failingToolRequest := mcp.CallToolRequest{}
+		failingToolRequest.Params.Name = "pods_get"
+		failingToolRequest.Params.Arguments = map[string]interface{}{}
+		failingToolResult, err := mcpClient.CallTool(s.T().Context(), failingToolRequest)
+		s.Require().NoError(err, "Expected no error calling tool")
+		s.Require().True(failingToolResult.IsError, "Expected tool call to fail")
+		s.Run("result contains the request ID", func() {
+			s.Require().NotNil(failingToolResult.Meta, "Expected result _meta")
+			s.Equal("a-request-id-from-the-client", failingToolResult.Meta.AdditionalFields["requestId"])
+		})
+		s.Run("log contains the request ID", func() {
+			s.Regexp(`"mcp tool call pods_get returned an error: .+" requestID="a-request-id-from-the-client"`, s.logBuffer.String())
+		})
+	})
+}
+
+func (s *RequestIDSuite) TestGeneratedRequestID() {
+	s.StartServer()
+	resp, err := http.Get(fmt.Sprintf("http://127.0.0.1:%s/.well-known/oauth-protected-resource", s.StaticConfig.Port))
+	s.Require().NoError(err, "Failed to get well-known endpoint")
+	s.T().Cleanup(func() { _ = resp.Body.Close() })
+
+	requestID := resp.Header.Get("X-Request-Id")
+	s.Run("Response contains a generated request ID", func() {
+		s.NotEmpty(requestID, "Expected a generated request ID")
+	})
+	s.Run("Access log contains the generated request ID", func() {
+		s.Contains(s.logBuffer.String(), fmt.Sprintf(`requestID="%s"`, requestID))
+	})
+}
+
+func TestRequestID(t *testing.T) {
+	suite.Run(t, new(RequestIDSuite))
+}
@@ -56,7 +56,7 @@ func (s *BaseHttpSuite) StartServer() {
 	s.Require().NoError(err, "Expected no error getting random port address")
 	s.StaticConfig.Port = strconv.Itoa(tcpAddr.Port)
 
-	s.mcpServer, err = mcp.NewServer(mcp.Configuration{StaticConfig: s.StaticConfig}, s.OidcProvider, nil)
+	s.mcpServer, err = mcp.NewServer(s.T().Context(), mcp.Configuration{StaticConfig: s.StaticConfig}, s.OidcProvider, nil)
 	s.Require().NoError(err, "Expected no error creating MCP server")
 	s.Require().NotNil(s.mcpServer, "MCP server should not be nil")
 	var timeoutCtx, cancelCtx context.Context
@@ -116,7 +116,7 @@ func (c *httpContext) beforeEach(t *testing.T) {
 		t.Fatalf("Failed to close random port listener: %v", randomPortErr)
 	}
 	c.StaticConfig.Port = fmt.Sprintf("%d", ln.Addr().(*net.TCPAddr).Port)
-	mcpServer, err := mcp.NewServer(mcp.Configuration{StaticConfig: c.StaticConfig}, c.OidcProvider, nil)
+	mcpServer, err := mcp.NewServer(t.Context(), mcp.Configuration{StaticConfig: c.StaticConfig}, c.OidcProvider, nil)
 	if err != nil {
 		t.Fatalf("Failed to create MCP server: %v", err)
 	}
@@ -165,6 +165,7 @@ type OidcTestServer struct {
 	*oidc.Provider
 	*httptest.Server
 	TokenEndpointHandler http.HandlerFunc
+	KeysEndpointHandler  http.HandlerFunc
 }
 
 func NewOidcTestServer(t *testing.T) (oidcTestServer *OidcTestServer) {
@@ -190,6 +191,10 @@ func NewOidcTestServer(t *testing.T) (oidcTestServer *OidcTestServer) {
 			oidcTestServer.TokenEndpointHandler.ServeHTTP(w, r)
 			return
 		}
+		if r.URL.Path == "/keys" && oidcTestServer.KeysEndpointHandler != nil {
+			oidcTestServer.KeysEndpointHandler.ServeHTTP(w, r)
+			return
+		}
 		oidcServer.ServeHTTP(w, r)
 	}))
 	oidcServer.SetIssuer(oidcTestServer.URL)
@@ -301,6 +306,71 @@ func TestWellKnownReverseProxy(t *testing.T) {
 			})
 		}
 	})
+	// With Authorization URL configured but slow upstream
+	slowServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"issuer": "https://example.com"}`))
+	}))
+	t.Cleanup(slowServer.Close)
+	slowConfig := &config.StaticConfig{
+		AuthorizationURL:             slowServer.URL,
+		WellKnownProxyTimeoutSeconds: 1,
+		RequireOAuth:                 true,
+		ClusterProviderStrategy:      api.ClusterProviderKubeConfig,
+	}
+	testCaseWithContext(t, &httpContext{StaticConfig: slowConfig}, func(ctx *httpContext) {
+		start := time.Now()
+		resp, err := http.Get(fmt.Sprintf("http://%s/%s", ctx.HttpAddress, cases[0]))
+		elapsed := time.Since(start)
+		t.Cleanup(func() { _ = resp.Body.Close() })
+		t.Run("Protected resource with slow Authorization URL returns 504 - Gateway Timeout", func(t *testing.T) {
+			if err != nil {
+				t.Fatalf("Failed to get %s endpoint: %v", cases[0], err)
+			}
+			if resp.StatusCode != http.StatusGatewayTimeout {
+				t.Errorf("Expected HTTP 504 Gateway Timeout, got %d", resp.StatusCode)
+			}
+		})
+		t.Run("Protected resource with slow Authorization URL responds within the timeout", func(t *testing.T) {
+			if elapsed > 3*time.Second {
+				t.Errorf("Expected response shortly after the 1s timeout, took %v", elapsed)
+			}
+		})
+		t.Run("Protected resource with slow Authorization URL describes the timeout", func(t *testing.T) {
+			body, _ := io.ReadAll(resp.Body)
+			if !strings.Contains(string(body), "the authorization server did not respond within 1s") {
+				t.Errorf("Expected timeout description in body, got %s", string(body))
+			}
+		})
+	})
+	// With Authorization URL configured, slow upstream, and no timeout (default)
+	slowerServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(1500 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"issuer": "https://example.com"}`))
+	}))
+	t.Cleanup(slowerServer.Close)
+	noTimeoutConfig := &config.StaticConfig{
+		AuthorizationURL:        slowerServer.URL,
+		RequireOAuth:            true,
+		ClusterProviderStrategy: api.ClusterProviderKubeConfig,
+	}
+	testCaseWithContext(t, &httpContext{StaticConfig: noTimeoutConfig}, func(ctx *httpContext) {
+		resp, err := http.Get(fmt.Sprintf("http://%s/%s", ctx.HttpAddress, cases[0]))
+		t.Cleanup(func() { _ = resp.Body.Close() })
+		t.Run("Protected resource with slow Authorization URL and no timeout returns 200 - OK", func(t *testing.T) {
+			if err != nil {
+				t.Fatalf("Failed to get %s endpoint: %v", cases[0], err)
+			}
+			if resp.StatusCode != http.StatusOK {
+				t.Errorf("Expected HTTP 200 OK, got %d", resp.StatusCode)
+			}
+		})
+	})
 	// With Authorization URL configured and valid payload
 	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if !strings.HasPrefix(r.URL.EscapedPath(), "/.well-known/") {
@@ -337,6 +407,72 @@ func TestWellKnownReverseProxy(t *testing.T) {
 	})
 }
 
+func TestWellKnownAcceptNegotiation(t *testing.T) {
+	path := ".well-known/oauth-authorization-server"
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"issuer": "https://example.com"}`))
+	}))
+	t.Cleanup(testServer.Close)
+	get := func(ctx *httpContext, accept string) *http.Response {
+		req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("http://%s/%s", ctx.HttpAddress, path), nil)
+		if err != nil {
+			t.Fatalf("Failed to create request: %v", err)
+		}
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("Failed to get %s endpoint: %v", path, err)
+		}
+		t.Cleanup(func() { _ = resp.Body.Close() })
+		return resp
+	}
+	// Lenient (default)
+	lenientConfig := &config.StaticConfig{
+		AuthorizationURL:        testServer.URL,
+		RequireOAuth:            true,
+		ClusterProviderStrategy: api.ClusterProviderKubeConfig,
+	}
+	testCaseWithContext(t, &httpContext{StaticConfig: lenientConfig}, func(ctx *httpContext) {
+		resp := get(ctx, "text/html")
+		t.Run("Incompatible Accept returns 200 - OK by default", func(t *testing.T) {
+			if resp.StatusCode != http.StatusOK {
+				t.Errorf("Expected HTTP 200 OK, got %d", resp.StatusCode)
+			}
+		})
+	})
+	// Strict
+	strictConfig := &config.StaticConfig{
+		AuthorizationURL:        testServer.URL,
+		WellKnownStrictAccept:   true,
+		RequireOAuth:            true,
+		ClusterProviderStrategy: api.ClusterProviderKubeConfig,
+	}
+	testCaseWithContext(t, &httpContext{StaticConfig: strictConfig}, func(ctx *httpContext) {
+		for _, accept := range []string{"text/html", "application/xml, text/*", "application/json;q=0, text/html"} {
+			resp := get(ctx, accept)
+			t.Run("Incompatible Accept '"+accept+"' returns 406 - Not Acceptable", func(t *testing.T) {
+				if resp.StatusCode != http.StatusNotAcceptable {
+					t.Errorf("Expected HTTP 406 Not Acceptable, got %d", resp.StatusCode)
+				}
+			})
+		}
+		for _, accept := range []string{"", "application/json", "text/html, application/json;q=0.5", "application/*", "*/*"} {
+			resp := get(ctx, accept)
+			t.Run("Compatible Accept '"+accept+"' returns 200 - OK", func(t *testing.T) {
+				if resp.StatusCode != http.StatusOK {
+					t.Errorf("Expected HTTP 200 OK, got %d", resp.StatusCode)
+				}
+				if resp.Header.Get("Content-Type") != "application/json" {
+					t.Errorf("Expected Content-Type application/json, got %s", resp.Header.Get("Content-Type"))
+				}
+			})
+		}
+	})
+}
+
 func TestWellKnownHeaderPropagation(t *testing.T) {
 	cases := []string{
 		".well-known/oauth-authorization-server",
@@ -546,7 +682,7 @@ func TestMiddlewareLogging(t *testing.T) {
 			}
 		})
 		t.Run("Logs HTTP request duration", func(t *testing.T) {
-			expected := `"GET /.well-known/oauth-protected-resource 404 (.+)"`
+			expected := `"GET /.well-known/oauth-protected-resource 404 ([^"]+)"`
 			m := regexp.MustCompile(expected).FindStringSubmatch(ctx.LogBuffer.String())
 			if len(m) != 2 {
 				t.Fatalf("Expected log entry to contain duration, got %s", ctx.LogBuffer.String())
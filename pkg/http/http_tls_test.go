@@ -0,0 +1,110 @@
+package http
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/config"
+)
+
+// writeSelfSignedCertificate writes a self-signed certificate for 127.0.0.1 and its key to the provided directory
+func writeSelfSignedCertificate(t *testing.T, dir string) (certFile, keyFile string, certPool *x509.CertPool) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "kubernetes-mcp-server"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	keyDer, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal key: %v", err)
+	}
+	certFile = filepath.Join(dir, "tls.crt")
+	keyFile = filepath.Join(dir, "tls.key")
+	if err = os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600); err != nil {
+		t.Fatalf("failed to write certificate: %v", err)
+	}
+	if err = os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDer}), 0600); err != nil {
+		t.Fatalf("failed to write key: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("failed to parse certificate: %v", err)
+	}
+	certPool = x509.NewCertPool()
+	certPool.AddCert(cert)
+	return
+}
+
+func TestTLS(t *testing.T) {
+	certFile, keyFile, certPool := writeSelfSignedCertificate(t, t.TempDir())
+	staticConfig := config.Default()
+	staticConfig.TLSCertFile = certFile
+	staticConfig.TLSKeyFile = keyFile
+	staticConfig.TLSMinVersion = "1.3"
+	testCaseWithContext(t, &httpContext{StaticConfig: staticConfig}, func(ctx *httpContext) {
+		address := net.JoinHostPort("127.0.0.1", ctx.StaticConfig.Port)
+		t.Run("Serves HTTPS with the configured certificate", func(t *testing.T) {
+			client := &http.Client{
+				Timeout:   10 * time.Second,
+				Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: certPool}},
+			}
+			resp, err := client.Get(fmt.Sprintf("https://%s/healthz", address))
+			if err != nil {
+				t.Fatalf("Failed to get health check endpoint over HTTPS: %v", err)
+			}
+			t.Cleanup(func() { _ = resp.Body.Close() })
+			if resp.StatusCode != http.StatusOK {
+				t.Errorf("Expected HTTP 200 OK, got %d", resp.StatusCode)
+			}
+			if resp.TLS == nil || resp.TLS.Version != tls.VersionTLS13 {
+				t.Errorf("Expected a TLS 1.3 connection, got %v", resp.TLS)
+			}
+		})
+		t.Run("Rejects handshakes below the minimum version", func(t *testing.T) {
+			conn, err := tls.Dial("tcp", address, &tls.Config{RootCAs: certPool, MaxVersion: tls.VersionTLS12})
+			if conn != nil {
+				_ = conn.Close()
+			}
+			if err == nil || !strings.Contains(err.Error(), "protocol version") {
+				t.Errorf("Expected the TLS 1.2 handshake to be rejected, got %v", err)
+			}
+		})
+		t.Run("Rejects plain HTTP requests", func(t *testing.T) {
+			resp, err := http.Get(fmt.Sprintf("http://%s/healthz", address))
+			if err != nil {
+				t.Fatalf("Failed to send plain HTTP request: %v", err)
+			}
+			t.Cleanup(func() { _ = resp.Body.Close() })
+			if resp.StatusCode != http.StatusBadRequest {
+				t.Errorf("Expected HTTP 400 Bad Request, got %d", resp.StatusCode)
+			}
+		})
+	})
+}
@@ -2,13 +2,35 @@ package http
 
 import (
 	"bufio"
+	"context"
+	"fmt"
 	"net"
 	"net/http"
 	"time"
 
+	"k8s.io/apimachinery/pkg/util/uuid"
 	"k8s.io/klog/v2"
+
+	internalk8s "github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
 )
 
+type accessLogContextKey struct{}
+
+// accessLogFields are the fields of the access log entry of a request set by the inner middlewares.
+type accessLogFields struct {
+	tenant string
+}
+
+// setAccessLogTenant sets the tenant (see config.StaticConfig.TenantClaim) included in the access log entry of the request.
+func setAccessLogTenant(ctx context.Context, tenant string) {
+	if fields, ok := ctx.Value(accessLogContextKey{}).(*accessLogFields); ok {
+		fields.tenant = tenant
+	}
+}
+
+// RequestMiddleware logs the HTTP requests and assigns them a request ID.
+// The request ID is taken from the inbound X-Request-Id header (or generated if missing), stored in the request
+// context, returned in the response headers, and propagated to the Kube API requests performed while serving it.
 func RequestMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Path == "/healthz" {
@@ -18,6 +40,15 @@ func RequestMiddleware(next http.Handler) http.Handler {
 
 		start := time.Now()
 
+		requestID := r.Header.Get(string(internalk8s.RequestIDHeader))
+		if requestID == "" {
+			requestID = string(uuid.NewUUID())
+		}
+		w.Header().Set(string(internalk8s.RequestIDHeader), requestID)
+		r = r.WithContext(internalk8s.WithRequestID(r.Context(), requestID))
+		fields := &accessLogFields{}
+		r = r.WithContext(context.WithValue(r.Context(), accessLogContextKey{}, fields))
+
 		lrw := &loggingResponseWriter{
 			ResponseWriter: w,
 			statusCode:     http.StatusOK,
@@ -26,7 +57,11 @@ func RequestMiddleware(next http.Handler) http.Handler {
 		next.ServeHTTP(lrw, r)
 
 		duration := time.Since(start)
-		klog.V(5).Infof("%s %s %d %v", r.Method, r.URL.Path, lrw.statusCode, duration)
+		var keysAndValues []any
+		if fields.tenant != "" {
+			keysAndValues = append(keysAndValues, "tenant", fields.tenant)
+		}
+		klog.FromContext(r.Context()).V(5).Info(fmt.Sprintf("%s %s %d %v", r.Method, r.URL.Path, lrw.statusCode, duration), keysAndValues...)
 	})
 }
 
@@ -0,0 +1,94 @@
+package http
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// sseKeepAliveComment is an SSE comment line, ignored by the clients, sent to keep the event stream connections alive.
+const sseKeepAliveComment = ": keep-alive\n\n"
+
+// SSEKeepAliveMiddleware periodically sends keep-alive comments on the SSE event streams (GET requests).
+// Long-lived idle connections might otherwise be closed by intermediate proxies.
+// A zero (or negative) interval disables the keep-alive comments.
+func SSEKeepAliveMiddleware(interval time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if interval <= 0 {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet {
+				next.ServeHTTP(w, r)
+				return
+			}
+			kw := &keepAliveResponseWriter{ResponseWriter: w}
+			defer kw.close()
+			go func() {
+				ticker := time.NewTicker(interval)
+				defer ticker.Stop()
+				for {
+					select {
+					case <-r.Context().Done():
+						return
+					case <-ticker.C:
+						if !kw.keepAlive() {
+							return
+						}
+					}
+				}
+			}()
+			next.ServeHTTP(kw, r)
+		})
+	}
+}
+
+// keepAliveResponseWriter synchronizes the writes of the wrapped handler with the keep-alive comments.
+// Keep-alive comments are only sent once the event stream has started (the handler wrote its first event)
+// and until the handler returns.
+type keepAliveResponseWriter struct {
+	http.ResponseWriter
+	mu      sync.Mutex
+	started bool
+	closed  bool
+}
+
+func (kw *keepAliveResponseWriter) Write(b []byte) (int, error) {
+	kw.mu.Lock()
+	defer kw.mu.Unlock()
+	kw.started = true
+	return kw.ResponseWriter.Write(b)
+}
+
+func (kw *keepAliveResponseWriter) Flush() {
+	kw.mu.Lock()
+	defer kw.mu.Unlock()
+	if flusher, ok := kw.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// keepAlive sends a keep-alive comment, returns false if the stream is closed.
+func (kw *keepAliveResponseWriter) keepAlive() bool {
+	kw.mu.Lock()
+	defer kw.mu.Unlock()
+	if kw.closed {
+		return false
+	}
+	if !kw.started {
+		return true
+	}
+	if _, err := kw.ResponseWriter.Write([]byte(sseKeepAliveComment)); err != nil {
+		return false
+	}
+	if flusher, ok := kw.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+	return true
+}
+
+func (kw *keepAliveResponseWriter) close() {
+	kw.mu.Lock()
+	defer kw.mu.Unlock()
+	kw.closed = true
+}
@@ -1,10 +1,14 @@
 package http
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/containers/kubernetes-mcp-server/pkg/config"
 )
@@ -25,7 +29,9 @@ type WellKnown struct {
 	authorizationUrl                 string
 	scopesSupported                  []string
 	disableDynamicClientRegistration bool
+	strictAccept                     bool
 	httpClient                       *http.Client
+	timeout                          time.Duration
 }
 
 var _ http.Handler = &WellKnown{}
@@ -42,7 +48,9 @@ func WellKnownHandler(staticConfig *config.StaticConfig, httpClient *http.Client
 		authorizationUrl:                 authorizationUrl,
 		disableDynamicClientRegistration: staticConfig.DisableDynamicClientRegistration,
 		scopesSupported:                  staticConfig.OAuthScopes,
+		strictAccept:                     staticConfig.WellKnownStrictAccept,
 		httpClient:                       httpClient,
+		timeout:                          time.Duration(staticConfig.WellKnownProxyTimeoutSeconds) * time.Second,
 	}
 }
 
@@ -51,6 +59,10 @@ func (w WellKnown) ServeHTTP(writer http.ResponseWriter, request *http.Request)
 		http.Error(writer, "Authorization URL is not configured", http.StatusNotFound)
 		return
 	}
+	if w.strictAccept && !acceptsJson(request) {
+		http.Error(writer, "Not Acceptable: the .well-known metadata is only available as application/json", http.StatusNotAcceptable)
+		return
+	}
 	req, err := http.NewRequest(request.Method, w.authorizationUrl+request.URL.EscapedPath(), nil)
 	if err != nil {
 		http.Error(writer, "Failed to create request: "+err.Error(), http.StatusInternalServerError)
@@ -61,8 +73,17 @@ func (w WellKnown) ServeHTTP(writer http.ResponseWriter, request *http.Request)
 			req.Header.Add(key, value)
 		}
 	}
-	resp, err := w.httpClient.Do(req.WithContext(request.Context()))
+	ctx, cancel := context.WithCancel(request.Context())
+	if w.timeout > 0 {
+		ctx, cancel = context.WithTimeout(request.Context(), w.timeout)
+	}
+	defer cancel()
+	resp, err := w.httpClient.Do(req.WithContext(ctx))
 	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			w.writeTimeout(writer)
+			return
+		}
 		http.Error(writer, "Failed to perform request: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -70,6 +91,10 @@ func (w WellKnown) ServeHTTP(writer http.ResponseWriter, request *http.Request)
 	var resourceMetadata map[string]interface{}
 	err = json.NewDecoder(resp.Body).Decode(&resourceMetadata)
 	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			w.writeTimeout(writer)
+			return
+		}
 		http.Error(writer, "Failed to read response body: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -94,3 +119,39 @@ func (w WellKnown) ServeHTTP(writer http.ResponseWriter, request *http.Request)
 	writer.WriteHeader(resp.StatusCode)
 	_, _ = writer.Write(body)
 }
+
+// acceptsJson returns true if the request has no Accept header or if any of its media ranges allows application/json.
+func acceptsJson(r *http.Request) bool {
+	accept := strings.Join(r.Header.Values("Accept"), ",")
+	if strings.TrimSpace(accept) == "" {
+		return true
+	}
+	for _, mediaRange := range strings.Split(accept, ",") {
+		mediaType, params, _ := strings.Cut(strings.TrimSpace(mediaRange), ";")
+		mediaType = strings.ToLower(strings.TrimSpace(mediaType))
+		if mediaType != "application/json" && mediaType != "application/*" && mediaType != "*/*" {
+			continue
+		}
+		if !hasZeroQuality(params) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasZeroQuality returns true if the provided media range parameters have a quality value of zero (i.e. not acceptable).
+func hasZeroQuality(params string) bool {
+	for _, param := range strings.Split(params, ";") {
+		key, value, _ := strings.Cut(strings.TrimSpace(param), "=")
+		if strings.EqualFold(strings.TrimSpace(key), "q") {
+			q, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			return err == nil && q == 0
+		}
+	}
+	return false
+}
+
+// writeTimeout sends a 504/Gateway Timeout response when the authorization server doesn't respond in time.
+func (w WellKnown) writeTimeout(writer http.ResponseWriter) {
+	http.Error(writer, fmt.Sprintf("Gateway Timeout: the authorization server did not respond within %v", w.timeout), http.StatusGatewayTimeout)
+}
@@ -11,9 +11,10 @@ import (
 	"net/url"
 	"os"
 	"os/signal"
+	"path"
+	"regexp"
 	"strconv"
 	"strings"
-	"syscall"
 
 	"github.com/coreos/go-oidc/v3/oidc"
 	"github.com/spf13/cobra"
@@ -54,6 +55,10 @@ kubernetes-mcp-server --port 8443 --sse-base-url https://example.com:8443
 # start a SSE server on port 8080 with multi-cluster tools disabled
 kubernetes-mcp-server --port 8080 --disable-multi-cluster
 `))
+	// targetParameterNameRegexp matches the valid tool argument names for the target_parameter_name override
+	targetParameterNameRegexp = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_-]*$`)
+	// openShiftToolPrefixRegexp matches the valid prefixes for the openshift_tool_prefix option
+	openShiftToolPrefixRegexp = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_-]*$`)
 )
 
 const (
@@ -252,6 +257,24 @@ func (m *MCPServerOptions) Validate() error {
 	if err := toolsets.Validate(m.StaticConfig.Toolsets); err != nil {
 		return err
 	}
+	switch m.StaticConfig.MetricsUnavailableBehavior {
+	case "", api.MetricsUnavailableBehaviorError, api.MetricsUnavailableBehaviorEmpty:
+	default:
+		return fmt.Errorf("invalid metrics_unavailable_behavior: %s, valid values are: %s, %s",
+			m.StaticConfig.MetricsUnavailableBehavior, api.MetricsUnavailableBehaviorError, api.MetricsUnavailableBehaviorEmpty)
+	}
+	switch m.StaticConfig.ToolConflictPolicy {
+	case "", api.ToolConflictPolicyLastWins, api.ToolConflictPolicyError:
+	default:
+		return fmt.Errorf("invalid tool_conflict_policy: %s, valid values are: %s, %s",
+			m.StaticConfig.ToolConflictPolicy, api.ToolConflictPolicyLastWins, api.ToolConflictPolicyError)
+	}
+	switch m.StaticConfig.ValidateDefaultTargetOnStartup {
+	case "", config.ValidateDefaultTargetWarn, config.ValidateDefaultTargetFail:
+	default:
+		return fmt.Errorf("invalid validate_default_target_on_startup: %s, valid values are: %s, %s",
+			m.StaticConfig.ValidateDefaultTargetOnStartup, config.ValidateDefaultTargetWarn, config.ValidateDefaultTargetFail)
+	}
 	if !m.StaticConfig.RequireOAuth && (m.StaticConfig.OAuthAudience != "" || m.StaticConfig.AuthorizationURL != "" || m.StaticConfig.ServerURL != "" || m.StaticConfig.CertificateAuthority != "") {
 		return fmt.Errorf("oauth-audience, authorization-url, server-url and certificate-authority are only valid if require-oauth is enabled. Missing --port may implicitly set require-oauth to false")
 	}
@@ -267,6 +290,42 @@ func (m *MCPServerOptions) Validate() error {
 			klog.Warningf("authorization-url is using http://, this is not recommended production use")
 		}
 	}
+	if m.StaticConfig.ProxyURL != "" {
+		u, err := url.Parse(m.StaticConfig.ProxyURL)
+		if err != nil {
+			return fmt.Errorf("proxy_url must be a valid URL: %w", err)
+		}
+		switch u.Scheme {
+		case "http", "https", "socks5":
+		default:
+			return fmt.Errorf("proxy_url must be a valid http, https or socks5 URL")
+		}
+	}
+	if (m.StaticConfig.TLSCertFile == "") != (m.StaticConfig.TLSKeyFile == "") {
+		return fmt.Errorf("tls_cert_file and tls_key_file must be provided together")
+	}
+	if m.StaticConfig.TLSCertFile == "" && (m.StaticConfig.TLSMinVersion != "" || len(m.StaticConfig.TLSCipherSuites) > 0) {
+		return fmt.Errorf("tls_min_version and tls_cipher_suites require tls_cert_file and tls_key_file")
+	}
+	if _, err := m.StaticConfig.GetTLSConfig(); err != nil {
+		return err
+	}
+	for _, pattern := range m.StaticConfig.ExcludedContexts {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("excluded_contexts must contain valid context names or glob patterns, invalid pattern %q: %w", pattern, err)
+		}
+	}
+	if m.StaticConfig.TenantClaim != "" {
+		if _, _, err := internalhttp.ParseClaimPath(m.StaticConfig.TenantClaim); err != nil {
+			return fmt.Errorf("invalid tenant_claim: %w", err)
+		}
+	}
+	if name := m.StaticConfig.TargetParameterName; name != "" && !targetParameterNameRegexp.MatchString(name) {
+		return fmt.Errorf("invalid target_parameter_name: %s, must start with a letter and contain only letters, digits, '_' and '-'", name)
+	}
+	if prefix := m.StaticConfig.OpenShiftToolPrefix; prefix != "" && !openShiftToolPrefixRegexp.MatchString(prefix) {
+		return fmt.Errorf("invalid openshift_tool_prefix: %s, must start with a letter and contain only letters, digits, '_' and '-'", prefix)
+	}
 	// Validate that certificate_authority is a valid file
 	if caValue := strings.TrimSpace(m.StaticConfig.CertificateAuthority); caValue != "" {
 		if _, err := os.Stat(caValue); err != nil {
@@ -297,31 +356,44 @@ func (m *MCPServerOptions) Run() error {
 		return nil
 	}
 
+	if m.StaticConfig.RequireHTTPTransport && m.StaticConfig.Port == "" {
+		return fmt.Errorf("require_http_transport is enabled but no port is configured, refusing to start the stdio transport (set --port to start the HTTP server)")
+	}
+
 	var oidcProvider *oidc.Provider
 	var httpClient *http.Client
 	if m.StaticConfig.AuthorizationURL != "" {
 		ctx := context.Background()
-		if m.StaticConfig.CertificateAuthority != "" {
-			httpClient = &http.Client{}
-			caCert, err := os.ReadFile(m.StaticConfig.CertificateAuthority)
-			if err != nil {
-				return fmt.Errorf("failed to read CA certificate from %s: %w", m.StaticConfig.CertificateAuthority, err)
-			}
-			caCertPool := x509.NewCertPool()
-			if !caCertPool.AppendCertsFromPEM(caCert) {
-				return fmt.Errorf("failed to append CA certificate from %s to pool", m.StaticConfig.CertificateAuthority)
+		if m.StaticConfig.CertificateAuthority != "" || m.StaticConfig.ProxyURL != "" {
+			transport := &http.Transport{
+				Proxy: http.ProxyFromEnvironment,
 			}
-
-			if caCertPool.Equal(x509.NewCertPool()) {
-				caCertPool = nil
+			if m.StaticConfig.ProxyURL != "" {
+				proxyURL, err := url.Parse(m.StaticConfig.ProxyURL)
+				if err != nil {
+					return fmt.Errorf("invalid proxy-url %s: %w", m.StaticConfig.ProxyURL, err)
+				}
+				transport.Proxy = http.ProxyURL(proxyURL)
 			}
-
-			transport := &http.Transport{
-				TLSClientConfig: &tls.Config{
+			if m.StaticConfig.CertificateAuthority != "" {
+				caCert, err := os.ReadFile(m.StaticConfig.CertificateAuthority)
+				if err != nil {
+					return fmt.Errorf("failed to read CA certificate from %s: %w", m.StaticConfig.CertificateAuthority, err)
+				}
+				caCertPool := x509.NewCertPool()
+				if !caCertPool.AppendCertsFromPEM(caCert) {
+					return fmt.Errorf("failed to append CA certificate from %s to pool", m.StaticConfig.CertificateAuthority)
+				}
+
+				if caCertPool.Equal(x509.NewCertPool()) {
+					caCertPool = nil
+				}
+
+				transport.TLSClientConfig = &tls.Config{
 					RootCAs: caCertPool,
-				},
+				}
 			}
-			httpClient.Transport = transport
+			httpClient = &http.Client{Transport: transport}
 			ctx = oidc.ClientContext(ctx, httpClient)
 		}
 		provider, err := oidc.NewProvider(ctx, m.StaticConfig.AuthorizationURL)
@@ -331,17 +403,20 @@ func (m *MCPServerOptions) Run() error {
 		oidcProvider = provider
 	}
 
-	mcpServer, err := mcp.NewServer(mcp.Configuration{
+	// The startup (e.g. the initial toolset load retries) is aborted if the process is asked to terminate
+	startupCtx, stopStartup := signal.NotifyContext(context.Background(), shutdownSignals...)
+	mcpServer, err := mcp.NewServer(startupCtx, mcp.Configuration{
 		StaticConfig: m.StaticConfig,
 	}, oidcProvider, httpClient)
+	stopStartup()
 	if err != nil {
 		return fmt.Errorf("failed to initialize MCP server: %w", err)
 	}
 	defer mcpServer.Close()
 
-	// Set up SIGHUP handler for configuration reload
+	// Set up SIGHUP/SIGUSR1 handler for configuration reload
 	if m.ConfigPath != "" || m.ConfigDir != "" {
-		m.setupSIGHUPHandler(mcpServer)
+		m.setupReloadSignalHandler(mcpServer)
 	}
 
 	if m.StaticConfig.Port != "" {
@@ -357,15 +432,15 @@ func (m *MCPServerOptions) Run() error {
 	return nil
 }
 
-// setupSIGHUPHandler sets up a signal handler to reload configuration on SIGHUP.
+// setupReloadSignalHandler sets up a signal handler to reload configuration on any of the reloadSignals (SIGHUP, SIGUSR1).
 // This is a blocking call that runs in a separate goroutine.
-func (m *MCPServerOptions) setupSIGHUPHandler(mcpServer *mcp.Server) {
-	sigHupCh := make(chan os.Signal, 1)
-	signal.Notify(sigHupCh, syscall.SIGHUP)
+func (m *MCPServerOptions) setupReloadSignalHandler(mcpServer *mcp.Server) {
+	reloadCh := make(chan os.Signal, 1)
+	signal.Notify(reloadCh, reloadSignals...)
 
 	go func() {
-		for range sigHupCh {
-			klog.V(1).Info("Received SIGHUP signal, reloading configuration...")
+		for sig := range reloadCh {
+			klog.V(1).Infof("Received signal %q, reloading configuration...", sig)
 
 			// Reload config from files
 			newConfig, err := config.Read(m.ConfigPath, m.ConfigDir)
@@ -380,9 +455,9 @@ func (m *MCPServerOptions) setupSIGHUPHandler(mcpServer *mcp.Server) {
 				continue
 			}
 
-			klog.V(1).Info("Configuration reloaded successfully via SIGHUP")
+			klog.V(1).Infof("Configuration reloaded successfully via signal %q", sig)
 		}
 	}()
 
-	klog.V(2).Info("SIGHUP handler registered for configuration reload")
+	klog.V(2).Infof("Signal handler registered for configuration reload on %v", reloadSignals)
 }
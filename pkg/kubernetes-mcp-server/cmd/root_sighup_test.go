@@ -20,7 +20,7 @@ import (
 	"k8s.io/klog/v2/textlogger"
 )
 
-// SIGHUPSuite tests the SIGHUP configuration reload behavior
+// SIGHUPSuite tests the SIGHUP (and SIGUSR1) configuration reload behavior
 type SIGHUPSuite struct {
 	suite.Suite
 	mockServer      *test.MockServer
@@ -57,16 +57,16 @@ func (s *SIGHUPSuite) InitServer(configPath, configDir string) {
 	s.Require().NoError(err)
 	cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
 
-	s.server, err = mcp.NewServer(mcp.Configuration{
+	s.server, err = mcp.NewServer(s.T().Context(), mcp.Configuration{
 		StaticConfig: cfg,
 	}, nil, nil)
 	s.Require().NoError(err)
-	// Set up SIGHUP handler
+	// Set up SIGHUP/SIGUSR1 handler
 	opts := &MCPServerOptions{
 		ConfigPath: configPath,
 		ConfigDir:  configDir,
 	}
-	opts.setupSIGHUPHandler(s.server)
+	opts.setupReloadSignalHandler(s.server)
 }
 
 func (s *SIGHUPSuite) TestSIGHUPReloadsConfigFromFile() {
@@ -96,6 +96,33 @@ func (s *SIGHUPSuite) TestSIGHUPReloadsConfigFromFile() {
 	})
 }
 
+func (s *SIGHUPSuite) TestSIGUSR1ReloadsConfigFromFile() {
+	// Create initial config file - start with only core toolset (no helm)
+	configPath := filepath.Join(s.tempDir, "config.toml")
+	s.Require().NoError(os.WriteFile(configPath, []byte(`
+		toolsets = ["core", "config"]
+	`), 0644))
+	s.InitServer(configPath, "")
+
+	s.Run("helm tools are not initially available", func() {
+		s.False(slices.Contains(s.server.GetEnabledTools(), "helm_list"))
+	})
+
+	// Modify the config file to add helm toolset
+	s.Require().NoError(os.WriteFile(configPath, []byte(`
+		toolsets = ["core", "config", "helm"]
+	`), 0644))
+
+	// Send SIGUSR1 to current process
+	s.Require().NoError(syscall.Kill(syscall.Getpid(), syscall.SIGUSR1))
+
+	s.Run("helm tools become available after SIGUSR1", func() {
+		s.Require().Eventually(func() bool {
+			return slices.Contains(s.server.GetEnabledTools(), "helm_list")
+		}, 2*time.Second, 50*time.Millisecond)
+	})
+}
+
 func (s *SIGHUPSuite) TestSIGHUPReloadsFromDropInDirectory() {
 	// Create initial config file - with helm enabled
 	configPath := filepath.Join(s.tempDir, "config.toml")
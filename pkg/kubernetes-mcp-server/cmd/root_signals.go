@@ -0,0 +1,14 @@
+//go:build !windows
+
+package cmd
+
+import (
+	"os"
+	"syscall"
+)
+
+// reloadSignals are the signals that trigger a configuration reload.
+var reloadSignals = []os.Signal{syscall.SIGHUP, syscall.SIGUSR1}
+
+// shutdownSignals are the signals that abort the server startup (e.g. the initial toolset load retries).
+var shutdownSignals = []os.Signal{os.Interrupt, syscall.SIGTERM}
@@ -0,0 +1,15 @@
+//go:build windows
+
+package cmd
+
+import (
+	"os"
+	"syscall"
+)
+
+// reloadSignals are the signals that trigger a configuration reload.
+// SIGUSR1 is not available on Windows.
+var reloadSignals = []os.Signal{syscall.SIGHUP}
+
+// shutdownSignals are the signals that abort the server startup (e.g. the initial toolset load retries).
+var shutdownSignals = []os.Signal{os.Interrupt, syscall.SIGTERM}
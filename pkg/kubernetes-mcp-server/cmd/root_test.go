@@ -387,6 +387,188 @@ func TestDisableDestructive(t *testing.T) {
 	})
 }
 
+func TestMetricsUnavailableBehavior(t *testing.T) {
+	t.Run("invalid value", func(t *testing.T) {
+		configPath := filepath.Join(t.TempDir(), "config.toml")
+		require.NoError(t, os.WriteFile(configPath, []byte(`metrics_unavailable_behavior = "ignore"`), 0644))
+		ioStreams, _ := testStream()
+		rootCmd := NewMCPServer(ioStreams)
+		rootCmd.SetArgs([]string{"--version", "--config", configPath})
+		err := rootCmd.Execute()
+		require.Error(t, err)
+		assert.Equal(t, "invalid metrics_unavailable_behavior: ignore, valid values are: error, empty", err.Error())
+	})
+	t.Run("valid value", func(t *testing.T) {
+		configPath := filepath.Join(t.TempDir(), "config.toml")
+		require.NoError(t, os.WriteFile(configPath, []byte(`metrics_unavailable_behavior = "empty"`), 0644))
+		ioStreams, _ := testStream()
+		rootCmd := NewMCPServer(ioStreams)
+		rootCmd.SetArgs([]string{"--version", "--config", configPath})
+		require.NoError(t, rootCmd.Execute())
+	})
+}
+
+func TestProxyURL(t *testing.T) {
+	t.Run("invalid scheme", func(t *testing.T) {
+		configPath := filepath.Join(t.TempDir(), "config.toml")
+		require.NoError(t, os.WriteFile(configPath, []byte(`proxy_url = "ftp://proxy.example.com"`), 0644))
+		ioStreams, _ := testStream()
+		rootCmd := NewMCPServer(ioStreams)
+		rootCmd.SetArgs([]string{"--version", "--config", configPath})
+		err := rootCmd.Execute()
+		require.Error(t, err)
+		assert.Equal(t, "proxy_url must be a valid http, https or socks5 URL", err.Error())
+	})
+	t.Run("valid value", func(t *testing.T) {
+		configPath := filepath.Join(t.TempDir(), "config.toml")
+		require.NoError(t, os.WriteFile(configPath, []byte(`proxy_url = "http://proxy.example.com:3128"`), 0644))
+		ioStreams, _ := testStream()
+		rootCmd := NewMCPServer(ioStreams)
+		rootCmd.SetArgs([]string{"--version", "--config", configPath})
+		require.NoError(t, rootCmd.Execute())
+	})
+}
+
+func TestTLS(t *testing.T) {
+	t.Run("invalid min version", func(t *testing.T) {
+		configPath := filepath.Join(t.TempDir(), "config.toml")
+		require.NoError(t, os.WriteFile(configPath, []byte("tls_cert_file = \"tls.crt\"\ntls_key_file = \"tls.key\"\ntls_min_version = \"TLS1.2\""), 0644))
+		ioStreams, _ := testStream()
+		rootCmd := NewMCPServer(ioStreams)
+		rootCmd.SetArgs([]string{"--version", "--config", configPath})
+		err := rootCmd.Execute()
+		require.Error(t, err)
+		assert.Equal(t, "invalid tls_min_version: TLS1.2, valid values are: 1.0, 1.1, 1.2, 1.3", err.Error())
+	})
+	t.Run("invalid cipher suite", func(t *testing.T) {
+		configPath := filepath.Join(t.TempDir(), "config.toml")
+		require.NoError(t, os.WriteFile(configPath, []byte("tls_cert_file = \"tls.crt\"\ntls_key_file = \"tls.key\"\ntls_cipher_suites = [\"TLS_AES_256_GCM_SHA384\", \"ECDHE-RSA-AES128-GCM-SHA256\"]"), 0644))
+		ioStreams, _ := testStream()
+		rootCmd := NewMCPServer(ioStreams)
+		rootCmd.SetArgs([]string{"--version", "--config", configPath})
+		err := rootCmd.Execute()
+		require.Error(t, err)
+		assert.Equal(t, "invalid tls_cipher_suites: ECDHE-RSA-AES128-GCM-SHA256 is not a supported cipher suite", err.Error())
+	})
+	t.Run("valid values", func(t *testing.T) {
+		configPath := filepath.Join(t.TempDir(), "config.toml")
+		require.NoError(t, os.WriteFile(configPath, []byte(`
+			tls_cert_file = "tls.crt"
+			tls_key_file = "tls.key"
+			tls_min_version = "1.2"
+			tls_cipher_suites = ["TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256", "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"]
+		`), 0644))
+		ioStreams, _ := testStream()
+		rootCmd := NewMCPServer(ioStreams)
+		rootCmd.SetArgs([]string{"--version", "--config", configPath})
+		require.NoError(t, rootCmd.Execute())
+	})
+	t.Run("certificate without key", func(t *testing.T) {
+		configPath := filepath.Join(t.TempDir(), "config.toml")
+		require.NoError(t, os.WriteFile(configPath, []byte(`tls_cert_file = "tls.crt"`), 0644))
+		ioStreams, _ := testStream()
+		rootCmd := NewMCPServer(ioStreams)
+		rootCmd.SetArgs([]string{"--version", "--config", configPath})
+		err := rootCmd.Execute()
+		require.Error(t, err)
+		assert.Equal(t, "tls_cert_file and tls_key_file must be provided together", err.Error())
+	})
+	t.Run("min version without certificate", func(t *testing.T) {
+		configPath := filepath.Join(t.TempDir(), "config.toml")
+		require.NoError(t, os.WriteFile(configPath, []byte(`tls_min_version = "1.2"`), 0644))
+		ioStreams, _ := testStream()
+		rootCmd := NewMCPServer(ioStreams)
+		rootCmd.SetArgs([]string{"--version", "--config", configPath})
+		err := rootCmd.Execute()
+		require.Error(t, err)
+		assert.Equal(t, "tls_min_version and tls_cipher_suites require tls_cert_file and tls_key_file", err.Error())
+	})
+}
+
+func TestExcludedContexts(t *testing.T) {
+	t.Run("invalid pattern", func(t *testing.T) {
+		configPath := filepath.Join(t.TempDir(), "config.toml")
+		require.NoError(t, os.WriteFile(configPath, []byte(`excluded_contexts = ["prod-[", "staging-*"]`), 0644))
+		ioStreams, _ := testStream()
+		rootCmd := NewMCPServer(ioStreams)
+		rootCmd.SetArgs([]string{"--version", "--config", configPath})
+		err := rootCmd.Execute()
+		require.Error(t, err)
+		assert.Equal(t, `excluded_contexts must contain valid context names or glob patterns, invalid pattern "prod-[": syntax error in pattern`, err.Error())
+	})
+	t.Run("valid patterns", func(t *testing.T) {
+		configPath := filepath.Join(t.TempDir(), "config.toml")
+		require.NoError(t, os.WriteFile(configPath, []byte(`excluded_contexts = ["prod-admin", "staging-*"]`), 0644))
+		ioStreams, _ := testStream()
+		rootCmd := NewMCPServer(ioStreams)
+		rootCmd.SetArgs([]string{"--version", "--config", configPath})
+		require.NoError(t, rootCmd.Execute())
+	})
+}
+
+func TestTenantClaim(t *testing.T) {
+	t.Run("invalid claim", func(t *testing.T) {
+		configPath := filepath.Join(t.TempDir(), "config.toml")
+		require.NoError(t, os.WriteFile(configPath, []byte(`tenant_claim = "groups[first]"`), 0644))
+		ioStreams, _ := testStream()
+		rootCmd := NewMCPServer(ioStreams)
+		rootCmd.SetArgs([]string{"--version", "--config", configPath})
+		err := rootCmd.Execute()
+		require.Error(t, err)
+		assert.Equal(t, `invalid tenant_claim: invalid claim "groups[first]", expected a claim name optionally followed by an array index (e.g. groups[0])`, err.Error())
+	})
+	t.Run("valid claim", func(t *testing.T) {
+		configPath := filepath.Join(t.TempDir(), "config.toml")
+		require.NoError(t, os.WriteFile(configPath, []byte(`tenant_claim = "groups[0]"`), 0644))
+		ioStreams, _ := testStream()
+		rootCmd := NewMCPServer(ioStreams)
+		rootCmd.SetArgs([]string{"--version", "--config", configPath})
+		require.NoError(t, rootCmd.Execute())
+	})
+}
+
+func TestTargetParameterName(t *testing.T) {
+	t.Run("invalid name", func(t *testing.T) {
+		configPath := filepath.Join(t.TempDir(), "config.toml")
+		require.NoError(t, os.WriteFile(configPath, []byte(`target_parameter_name = "the cluster"`), 0644))
+		ioStreams, _ := testStream()
+		rootCmd := NewMCPServer(ioStreams)
+		rootCmd.SetArgs([]string{"--version", "--config", configPath})
+		err := rootCmd.Execute()
+		require.Error(t, err)
+		assert.Equal(t, "invalid target_parameter_name: the cluster, must start with a letter and contain only letters, digits, '_' and '-'", err.Error())
+	})
+	t.Run("valid name", func(t *testing.T) {
+		configPath := filepath.Join(t.TempDir(), "config.toml")
+		require.NoError(t, os.WriteFile(configPath, []byte(`target_parameter_name = "cluster"`), 0644))
+		ioStreams, _ := testStream()
+		rootCmd := NewMCPServer(ioStreams)
+		rootCmd.SetArgs([]string{"--version", "--config", configPath})
+		require.NoError(t, rootCmd.Execute())
+	})
+}
+
+func TestOpenShiftToolPrefix(t *testing.T) {
+	t.Run("invalid prefix", func(t *testing.T) {
+		configPath := filepath.Join(t.TempDir(), "config.toml")
+		require.NoError(t, os.WriteFile(configPath, []byte(`openshift_tool_prefix = "openshift."`), 0644))
+		ioStreams, _ := testStream()
+		rootCmd := NewMCPServer(ioStreams)
+		rootCmd.SetArgs([]string{"--version", "--config", configPath})
+		err := rootCmd.Execute()
+		require.Error(t, err)
+		assert.Equal(t, "invalid openshift_tool_prefix: openshift., must start with a letter and contain only letters, digits, '_' and '-'", err.Error())
+	})
+	t.Run("valid prefix", func(t *testing.T) {
+		configPath := filepath.Join(t.TempDir(), "config.toml")
+		require.NoError(t, os.WriteFile(configPath, []byte(`openshift_tool_prefix = "openshift_"`), 0644))
+		ioStreams, _ := testStream()
+		rootCmd := NewMCPServer(ioStreams)
+		rootCmd.SetArgs([]string{"--version", "--config", configPath})
+		require.NoError(t, rootCmd.Execute())
+	})
+}
+
 func TestAuthorizationURL(t *testing.T) {
 	t.Run("invalid authorization-url without protocol", func(t *testing.T) {
 		ioStreams, _ := testStream()
@@ -431,6 +613,25 @@ func TestStdioLogging(t *testing.T) {
 	})
 }
 
+func TestRequireHTTPTransport(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.toml")
+	require.NoError(t, os.WriteFile(configPath, []byte(`require_http_transport = true`), 0644))
+	t.Run("refuses to start stdio transport without port", func(t *testing.T) {
+		ioStreams, _ := testStream()
+		rootCmd := NewMCPServer(ioStreams)
+		rootCmd.SetArgs([]string{"--config", configPath})
+		err := rootCmd.Execute()
+		require.Error(t, err, "Expected error when require_http_transport is set and port is empty")
+		assert.Contains(t, err.Error(), "require_http_transport is enabled but no port is configured")
+	})
+	t.Run("allows HTTP transport with port", func(t *testing.T) {
+		ioStreams, _ := testStream()
+		rootCmd := NewMCPServer(ioStreams)
+		rootCmd.SetArgs([]string{"--version", "--config", configPath, "--port=1337"})
+		require.NoError(t, rootCmd.Execute())
+	})
+}
+
 func TestDisableMultiCluster(t *testing.T) {
 	t.Run("defaults to false", func(t *testing.T) {
 		ioStreams, out := testStream()
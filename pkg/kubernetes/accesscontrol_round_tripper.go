@@ -1,6 +1,7 @@
 package kubernetes
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -10,6 +11,9 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
+// ErrResourceNotAllowed is returned when a request targets a resource that is denied by the configuration
+var ErrResourceNotAllowed = errors.New("resource not allowed")
+
 type AccessControlRoundTripper struct {
 	delegate                http.RoundTripper
 	deniedResourcesProvider api.DeniedResourcesProvider
@@ -28,7 +32,7 @@ func (rt *AccessControlRoundTripper) RoundTrip(req *http.Request) (*http.Respons
 		return nil, fmt.Errorf("failed to make request: AccessControlRoundTripper failed to get kind for gvr %v: %w", gvr, err)
 	}
 	if !rt.isAllowed(gvk) {
-		return nil, fmt.Errorf("resource not allowed: %s", gvk.String())
+		return nil, fmt.Errorf("%w: %s", ErrResourceNotAllowed, gvk.String())
 	}
 
 	return rt.delegate.RoundTrip(req)
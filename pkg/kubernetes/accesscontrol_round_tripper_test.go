@@ -3,6 +3,8 @@ package kubernetes
 import (
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/BurntSushi/toml"
@@ -230,6 +232,27 @@ func (s *AccessControlRoundTripperTestSuite) TestRoundTripForDeniedAPIResources(
 
 	})
 
+	s.Run("Resource kind denied in denied_resources_file", func() {
+		deniedResourcesFile := filepath.Join(s.T().TempDir(), "denied-resources.toml")
+		s.Require().NoError(os.WriteFile(deniedResourcesFile, []byte(`
+			denied_resources = [ { version = "v1", kind = "Pod" } ]
+		`), 0644))
+		rt.deniedResourcesProvider = test.Must(config.ReadToml([]byte(`
+			denied_resources_file = "` + filepath.ToSlash(deniedResourcesFile) + `"
+		`)))
+
+		s.Run("List pods is denied", func() {
+			delegateCalled = false
+			req := httptest.NewRequest("GET", "/api/v1/pods", nil)
+			resp, err := rt.RoundTrip(req)
+			s.Error(err)
+			s.Nil(resp)
+			s.False(delegateCalled, "Expected delegate not to be called for denied resource")
+			s.Contains(err.Error(), "resource not allowed")
+			s.Contains(err.Error(), "Pod")
+		})
+	})
+
 	s.Run("RESTMapper error for unknown resource", func() {
 		rt.deniedResourcesProvider = nil
 		delegateCalled = false
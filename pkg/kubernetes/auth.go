@@ -0,0 +1,28 @@
+package kubernetes
+
+import (
+	"context"
+
+	authv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// AuthCanI performs a SelfSubjectAccessReview for the current (possibly impersonated or exchanged) identity.
+// The resource can be qualified with its API group using the resource.group notation (e.g. deployments.apps).
+func (c *Core) AuthCanI(ctx context.Context, verb, resource, namespace, name string) (*authv1.SubjectAccessReviewStatus, error) {
+	gr := schema.ParseGroupResource(resource)
+	response, err := c.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authv1.SelfSubjectAccessReview{
+		Spec: authv1.SelfSubjectAccessReviewSpec{ResourceAttributes: &authv1.ResourceAttributes{
+			Namespace: namespace,
+			Verb:      verb,
+			Group:     gr.Group,
+			Resource:  gr.Resource,
+			Name:      name,
+		}},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return &response.Status, nil
+}
@@ -0,0 +1,49 @@
+package kubernetes
+
+import (
+	"net/http"
+	"net/url"
+	"slices"
+
+	"k8s.io/klog/v2"
+)
+
+// authorizationHostGuardRoundTripper removes the Authorization header from the requests to any host other than
+// the cluster API server or the explicitly allowed hosts.
+// This guarantees that a propagated (or exchanged) token is never forwarded to the wrong endpoint due to a misconfiguration.
+type authorizationHostGuardRoundTripper struct {
+	delegate     http.RoundTripper
+	allowedHosts []string
+}
+
+// newAuthorizationHostGuard returns a transport wrapper that only allows the Authorization header to be sent to the
+// provided cluster API server host and the additional allowed hosts (an allowed host without port matches any port).
+func newAuthorizationHostGuard(clusterHost string, allowedHosts []string) func(http.RoundTripper) http.RoundTripper {
+	hosts := []string{hostOf(clusterHost)}
+	for _, allowedHost := range allowedHosts {
+		hosts = append(hosts, hostOf(allowedHost))
+	}
+	return func(delegate http.RoundTripper) http.RoundTripper {
+		return &authorizationHostGuardRoundTripper{delegate: delegate, allowedHosts: hosts}
+	}
+}
+
+func (rt *authorizationHostGuardRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Header.Get(string(OAuthAuthorizationHeader)) == "" ||
+		slices.Contains(rt.allowedHosts, req.URL.Host) || slices.Contains(rt.allowedHosts, req.URL.Hostname()) {
+		return rt.delegate.RoundTrip(req)
+	}
+	klog.Warningf("Removing the propagated %s header from a request to %s, the host is not the cluster API server or an allowed host",
+		OAuthAuthorizationHeader, req.URL.Host)
+	req = req.Clone(req.Context())
+	req.Header.Del(string(OAuthAuthorizationHeader))
+	return rt.delegate.RoundTrip(req)
+}
+
+// hostOf returns the host (and port, if any) of the provided URL, or the provided value if it's not a URL with a host.
+func hostOf(host string) string {
+	if u, err := url.Parse(host); err == nil && u.Host != "" {
+		return u.Host
+	}
+	return host
+}
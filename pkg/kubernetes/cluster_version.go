@@ -0,0 +1,91 @@
+package kubernetes
+
+import (
+	"context"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/openshift"
+)
+
+// ClusterVersion is a summary of the version and health of a cluster.
+type ClusterVersion struct {
+	KubernetesVersion string
+	Platform          string `json:",omitempty"`
+	BuildDate         string `json:",omitempty"`
+	// OpenShiftVersion is the version of the OpenShift cluster (ClusterVersion status), empty if not OpenShift
+	OpenShiftVersion string `json:",omitempty"`
+	// APIServerReadiness is "ok" if the API server /readyz endpoint reports it's ready, or the reason it isn't
+	APIServerReadiness string
+	// Components are the conditions of the control plane components (ComponentStatus), empty if not available
+	Components []ComponentHealth `json:",omitempty"`
+}
+
+// ComponentHealth is the health of a control plane component.
+type ComponentHealth struct {
+	Name    string
+	Healthy bool
+	Message string `json:",omitempty"`
+	Error   string `json:",omitempty"`
+}
+
+// ClusterVersion returns the Kubernetes version of the cluster, the OpenShift version if applicable, and the health
+// of the API server and of the control plane components where available.
+func (c *Core) ClusterVersion(ctx context.Context) (*ClusterVersion, error) {
+	info, err := c.DiscoveryClient().ServerVersion()
+	if err != nil {
+		return nil, err
+	}
+	ret := &ClusterVersion{
+		KubernetesVersion:  info.GitVersion,
+		Platform:           info.Platform,
+		BuildDate:          info.BuildDate,
+		APIServerReadiness: c.apiServerReadiness(ctx),
+	}
+	if openshift.IsOpenshift(c.DiscoveryClient()) {
+		ret.OpenShiftVersion = c.openShiftVersion(ctx)
+	}
+	// ComponentStatus is deprecated and may be unavailable (or denied), the components are omitted in that case
+	if componentStatuses, err := c.CoreV1().ComponentStatuses().List(ctx, metav1.ListOptions{}); err == nil {
+		for _, componentStatus := range componentStatuses.Items {
+			component := ComponentHealth{Name: componentStatus.Name}
+			for _, condition := range componentStatus.Conditions {
+				if condition.Type == v1.ComponentHealthy {
+					component.Healthy = condition.Status == v1.ConditionTrue
+					component.Message = condition.Message
+					component.Error = condition.Error
+				}
+			}
+			ret.Components = append(ret.Components, component)
+		}
+	}
+	return ret, nil
+}
+
+// apiServerReadiness returns "ok" if the API server reports it's ready, the reason otherwise
+func (c *Core) apiServerReadiness(ctx context.Context) string {
+	body, err := c.DiscoveryClient().RESTClient().Get().AbsPath("/readyz").DoRaw(ctx)
+	if err != nil {
+		return "not ready: " + err.Error()
+	}
+	if readiness := strings.TrimSpace(string(body)); readiness != "" {
+		return readiness
+	}
+	return "ok"
+}
+
+// openShiftVersion returns the version of the OpenShift cluster from the ClusterVersion status, or "unknown"
+func (c *Core) openShiftVersion(ctx context.Context) string {
+	clusterVersion, err := c.ResourcesGet(ctx, &schema.GroupVersionKind{Group: "config.openshift.io", Version: "v1", Kind: "ClusterVersion"}, "", "version")
+	if err != nil {
+		return "unknown"
+	}
+	if version, found, _ := unstructured.NestedString(clusterVersion.Object, "status", "desired", "version"); found && version != "" {
+		return version
+	}
+	return "unknown"
+}
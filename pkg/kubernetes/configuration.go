@@ -1,6 +1,8 @@
 package kubernetes
 
 import (
+	"sort"
+
 	"github.com/containers/kubernetes-mcp-server/pkg/api"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
@@ -24,7 +26,7 @@ var InClusterConfig = func() (*rest.Config, error) {
 
 func IsInCluster(cfg api.ClusterProvider) bool {
 	// Even if running in-cluster, if a kubeconfig is provided, we consider it as out-of-cluster
-	if cfg != nil && cfg.GetKubeConfigPath() != "" {
+	if cfg != nil && (cfg.GetKubeConfigPath() != "" || len(cfg.GetKubeConfigPaths()) > 0) {
 		return false
 	}
 	restConfig, err := InClusterConfig()
@@ -60,6 +62,41 @@ func (c *Core) ConfigurationContextsList() (map[string]string, error) {
 	return contexts, nil
 }
 
+// ConfigurationContextsDescribe returns the name, cluster name, server URL, and namespace of each of the available contexts
+// sorted by name (credentials are never included).
+// TODO: Should be moved to the Provider level ?
+func (c *Core) ConfigurationContextsDescribe() ([]map[string]any, error) {
+	cfg, err := c.ToRawKubeConfigLoader().RawConfig()
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(cfg.Contexts))
+	for name := range cfg.Contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	contexts := make([]map[string]any, 0, len(names))
+	for _, name := range names {
+		context := cfg.Contexts[name]
+		server := "unknown"
+		if cluster, ok := cfg.Clusters[context.Cluster]; ok && cluster.Server != "" {
+			server = cluster.Server
+		}
+		namespace := context.Namespace
+		if namespace == "" {
+			namespace = "default"
+		}
+		contexts = append(contexts, map[string]any{
+			"Name":      name,
+			"Cluster":   context.Cluster,
+			"Server":    server,
+			"Namespace": namespace,
+			"Default":   name == cfg.CurrentContext,
+		})
+	}
+	return contexts, nil
+}
+
 // ConfigurationView returns the current kubeconfig content as a kubeconfig YAML
 // If minify is true, keeps only the current-context and the relevant pieces of the configuration for that context.
 // If minify is false, all contexts, clusters, auth-infos, and users are returned in the configuration.
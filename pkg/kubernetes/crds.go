@@ -0,0 +1,182 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+// crdSchemaMaxDepth is the maximum depth of the fields summarized from the OpenAPI schema of a CustomResourceDefinition
+const crdSchemaMaxDepth = 3
+
+var customResourceDefinitionGVK = &schema.GroupVersionKind{
+	Group: apiextensionsv1.GroupName, Version: "v1", Kind: "CustomResourceDefinition",
+}
+
+// CustomResourceDefinitionSummary is a summary of the group, names, scope, and versions of a CustomResourceDefinition.
+type CustomResourceDefinitionSummary struct {
+	Name       string
+	Group      string
+	Kind       string
+	Scope      string
+	ShortNames []string
+	// ServedVersions are the versions served by the REST API
+	ServedVersions []string
+	// StorageVersion is the version used to persist the custom resources
+	StorageVersion string
+}
+
+// CustomResourceDefinitionDescription describes a CustomResourceDefinition, including a summary of the schema of each version.
+type CustomResourceDefinitionDescription struct {
+	Name       string
+	Group      string
+	Kind       string
+	Plural     string
+	Singular   string
+	ShortNames []string `json:",omitempty"`
+	Categories []string `json:",omitempty"`
+	Scope      string
+	Versions   []CustomResourceDefinitionVersion
+	Conditions []string `json:",omitempty"`
+}
+
+// CustomResourceDefinitionVersion describes a version of a CustomResourceDefinition.
+type CustomResourceDefinitionVersion struct {
+	Name               string
+	Served             bool
+	Storage            bool
+	Deprecated         bool   `json:",omitempty"`
+	DeprecationWarning string `json:",omitempty"`
+	// Fields summarizes the fields of the OpenAPI schema (e.g. "spec.replicas (integer, required)")
+	Fields []string `json:",omitempty"`
+}
+
+// CustomResourceDefinitionsList returns the summary of the CustomResourceDefinitions of the provided group (or all of them, if empty).
+func (c *Core) CustomResourceDefinitionsList(ctx context.Context, group string) ([]CustomResourceDefinitionSummary, error) {
+	list, err := c.ResourcesList(ctx, customResourceDefinitionGVK, "", api.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]CustomResourceDefinitionSummary, 0)
+	err = list.EachListItem(func(obj runtime.Object) error {
+		crd := &apiextensionsv1.CustomResourceDefinition{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.(*unstructured.Unstructured).Object, crd); err != nil {
+			return err
+		}
+		if group != "" && crd.Spec.Group != group {
+			return nil
+		}
+		summary := CustomResourceDefinitionSummary{
+			Name:           crd.Name,
+			Group:          crd.Spec.Group,
+			Kind:           crd.Spec.Names.Kind,
+			Scope:          string(crd.Spec.Scope),
+			ShortNames:     crd.Spec.Names.ShortNames,
+			ServedVersions: make([]string, 0, len(crd.Spec.Versions)),
+		}
+		for _, version := range crd.Spec.Versions {
+			if version.Served {
+				summary.ServedVersions = append(summary.ServedVersions, version.Name)
+			}
+			if version.Storage {
+				summary.StorageVersion = version.Name
+			}
+		}
+		ret = append(ret, summary)
+		return nil
+	})
+	return ret, err
+}
+
+// CustomResourceDefinitionsDescribe returns the description of the CustomResourceDefinition with the provided name
+// (e.g. routes.route.openshift.io), including its versions and a summary of their schema.
+func (c *Core) CustomResourceDefinitionsDescribe(ctx context.Context, name string) (*CustomResourceDefinitionDescription, error) {
+	obj, err := c.ResourcesGet(ctx, customResourceDefinitionGVK, "", name)
+	if err != nil {
+		return nil, err
+	}
+	crd := &apiextensionsv1.CustomResourceDefinition{}
+	if err = runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, crd); err != nil {
+		return nil, err
+	}
+	ret := &CustomResourceDefinitionDescription{
+		Name:       crd.Name,
+		Group:      crd.Spec.Group,
+		Kind:       crd.Spec.Names.Kind,
+		Plural:     crd.Spec.Names.Plural,
+		Singular:   crd.Spec.Names.Singular,
+		ShortNames: crd.Spec.Names.ShortNames,
+		Categories: crd.Spec.Names.Categories,
+		Scope:      string(crd.Spec.Scope),
+		Versions:   make([]CustomResourceDefinitionVersion, 0, len(crd.Spec.Versions)),
+	}
+	for _, version := range crd.Spec.Versions {
+		v := CustomResourceDefinitionVersion{
+			Name:               version.Name,
+			Served:             version.Served,
+			Storage:            version.Storage,
+			Deprecated:         version.Deprecated,
+			DeprecationWarning: strings.TrimSpace(ptr.Deref(version.DeprecationWarning, "")),
+		}
+		if version.Schema != nil && version.Schema.OpenAPIV3Schema != nil {
+			v.Fields = crdSchemaFields(version.Schema.OpenAPIV3Schema, "", 1)
+		}
+		ret.Versions = append(ret.Versions, v)
+	}
+	for _, condition := range crd.Status.Conditions {
+		ret.Conditions = append(ret.Conditions, fmt.Sprintf("%s=%s", condition.Type, condition.Status))
+	}
+	return ret, nil
+}
+
+// crdSchemaFields summarizes the properties of the provided OpenAPI schema, recursing into the object properties
+// up to crdSchemaMaxDepth (e.g. "spec.replicas (integer, required)")
+func crdSchemaFields(props *apiextensionsv1.JSONSchemaProps, prefix string, depth int) []string {
+	var ret []string
+	names := make([]string, 0, len(props.Properties))
+	for name := range props.Properties {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		property := props.Properties[name]
+		details := []string{crdSchemaType(property)}
+		if slices.Contains(props.Required, name) {
+			details = append(details, "required")
+		}
+		if ptr.Deref(property.XPreserveUnknownFields, false) {
+			details = append(details, "preserves unknown fields")
+		}
+		ret = append(ret, fmt.Sprintf("%s%s (%s)", prefix, name, strings.Join(details, ", ")))
+		if depth < crdSchemaMaxDepth && len(property.Properties) > 0 {
+			ret = append(ret, crdSchemaFields(&property, prefix+name+".", depth+1)...)
+		}
+	}
+	if prefix == "" && len(ret) == 0 && ptr.Deref(props.XPreserveUnknownFields, false) {
+		ret = append(ret, "(schemaless, preserves unknown fields)")
+	}
+	return ret
+}
+
+// crdSchemaType returns the type of the provided property (e.g. "string", "[]object", "int-or-string")
+func crdSchemaType(property apiextensionsv1.JSONSchemaProps) string {
+	switch {
+	case property.XIntOrString:
+		return "int-or-string"
+	case property.Type == "array" && property.Items != nil && property.Items.Schema != nil:
+		return "[]" + crdSchemaType(*property.Items.Schema)
+	case property.Type == "":
+		return "any"
+	default:
+		return property.Type
+	}
+}
@@ -0,0 +1,66 @@
+package kubernetes
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// DaemonSetStatus is a summary of the rollout status of a DaemonSet.
+type DaemonSetStatus struct {
+	Namespace    string
+	Name         string
+	Desired      int32
+	Current      int32
+	Ready        int32
+	UpToDate     int32
+	Available    int32
+	NodeSelector string
+}
+
+// DaemonSetsList returns the desired, current, ready, up-to-date, and available Pod counts and the node selector of the
+// DaemonSets in the provided namespace (or all namespaces) matching the provided label selector.
+func (c *Core) DaemonSetsList(ctx context.Context, namespace string, allNamespaces bool, labelSelector string) ([]DaemonSetStatus, error) {
+	daemonSets, err := c.ResourcesList(ctx, &schema.GroupVersionKind{
+		Group: "apps", Version: "v1", Kind: "DaemonSet",
+	}, c.listNamespace(namespace, allNamespaces), api.ListOptions{ListOptions: metav1.ListOptions{LabelSelector: labelSelector}})
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]DaemonSetStatus, 0)
+	err = daemonSets.EachListItem(func(obj runtime.Object) error {
+		daemonSet := &appsv1.DaemonSet{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.(*unstructured.Unstructured).Object, daemonSet); err != nil {
+			return err
+		}
+		ret = append(ret, DaemonSetStatus{
+			Namespace:    daemonSet.Namespace,
+			Name:         daemonSet.Name,
+			Desired:      daemonSet.Status.DesiredNumberScheduled,
+			Current:      daemonSet.Status.CurrentNumberScheduled,
+			Ready:        daemonSet.Status.NumberReady,
+			UpToDate:     daemonSet.Status.UpdatedNumberScheduled,
+			Available:    daemonSet.Status.NumberAvailable,
+			NodeSelector: nodeSelectorToString(daemonSet.Spec.Template.Spec.NodeSelector),
+		})
+		return nil
+	})
+	return ret, err
+}
+
+// nodeSelectorToString returns the provided node selector as a sorted, comma-separated list of key=value pairs.
+func nodeSelectorToString(nodeSelector map[string]string) string {
+	pairs := make([]string, 0, len(nodeSelector))
+	for k, v := range nodeSelector {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
@@ -0,0 +1,136 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// deploymentRevisionAnnotation is the annotation set by the Deployment controller with the revision of a ReplicaSet
+	deploymentRevisionAnnotation = "deployment.kubernetes.io/revision"
+	// podTemplateHashLabel is the label added by the Deployment controller to the Pod template of its ReplicaSets
+	podTemplateHashLabel = "pod-template-hash"
+)
+
+// DeploymentsSetImage updates the image of the provided container of the Deployment with the provided name by patching
+// its Pod template (equivalent to kubectl set image).
+// If dryRun is true, the patch is submitted in server-side dry-run mode and the Deployment is not modified.
+// Returns the previous and the new image of the container.
+func (c *Core) DeploymentsSetImage(ctx context.Context, namespace, name, container, image string, dryRun bool) (oldImage, newImage string, err error) {
+	deployments := c.AppsV1().Deployments(c.NamespaceOrDefault(namespace))
+	deployment, err := deployments.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", "", err
+	}
+	found := false
+	for _, ctr := range deployment.Spec.Template.Spec.Containers {
+		if ctr.Name == container {
+			oldImage, found = ctr.Image, true
+			break
+		}
+	}
+	if !found {
+		return "", "", fmt.Errorf("container %s not found in deployment %s", container, name)
+	}
+	patch, err := json.Marshal(map[string]any{
+		"spec": map[string]any{
+			"template": map[string]any{
+				"spec": map[string]any{
+					"containers": []map[string]any{{"name": container, "image": image}},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return "", "", err
+	}
+	patchOptions := metav1.PatchOptions{}
+	if dryRun {
+		patchOptions.DryRun = []string{metav1.DryRunAll}
+	}
+	patched, err := deployments.Patch(ctx, name, types.StrategicMergePatchType, patch, patchOptions)
+	if err != nil {
+		return "", "", err
+	}
+	for _, ctr := range patched.Spec.Template.Spec.Containers {
+		if ctr.Name == container {
+			newImage = ctr.Image
+		}
+	}
+	return oldImage, newImage, nil
+}
+
+// DeploymentsRollback rolls back the Deployment with the provided name to the Pod template of the ReplicaSet with the
+// provided revision (equivalent to kubectl rollout undo).
+// If toRevision is 0, the Deployment is rolled back to the revision previous to the current one.
+// Returns the revision the Deployment was rolled back to.
+func (c *Core) DeploymentsRollback(ctx context.Context, namespace, name string, toRevision int64) (int64, error) {
+	namespace = c.NamespaceOrDefault(namespace)
+	deployments := c.AppsV1().Deployments(namespace)
+	deployment, err := deployments.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return 0, err
+	}
+	if deployment.Spec.Paused {
+		return 0, fmt.Errorf("cannot roll back paused deployment %s, resume it first", name)
+	}
+	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return 0, err
+	}
+	replicaSets, err := c.AppsV1().ReplicaSets(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return 0, err
+	}
+	// ReplicaSets controlled by the Deployment mapped to their revision
+	revisions := make(map[int64]*appsv1.ReplicaSet)
+	currentRevision := int64(0)
+	for i := range replicaSets.Items {
+		replicaSet := &replicaSets.Items[i]
+		if ref := metav1.GetControllerOf(replicaSet); ref == nil || ref.UID != deployment.UID {
+			continue
+		}
+		revision, err := strconv.ParseInt(replicaSet.Annotations[deploymentRevisionAnnotation], 10, 64)
+		if err != nil {
+			continue
+		}
+		revisions[revision] = replicaSet
+		currentRevision = max(currentRevision, revision)
+	}
+	if toRevision == 0 {
+		for revision := range revisions {
+			if revision < currentRevision {
+				toRevision = max(toRevision, revision)
+			}
+		}
+		if toRevision == 0 {
+			return 0, fmt.Errorf("no rollout history found for deployment %s", name)
+		}
+	}
+	replicaSet, ok := revisions[toRevision]
+	if !ok {
+		return 0, fmt.Errorf("revision %d not found for deployment %s", toRevision, name)
+	}
+	template := replicaSet.Spec.Template.DeepCopy()
+	delete(template.Labels, podTemplateHashLabel)
+	if equality.Semantic.DeepEqual(template, &deployment.Spec.Template) {
+		return 0, fmt.Errorf("deployment %s already matches the template of revision %d", name, toRevision)
+	}
+	patch, err := json.Marshal([]map[string]any{
+		{"op": "replace", "path": "/spec/template", "value": template},
+	})
+	if err != nil {
+		return 0, err
+	}
+	if _, err = deployments.Patch(ctx, name, types.JSONPatchType, patch, metav1.PatchOptions{}); err != nil {
+		return 0, err
+	}
+	return toRevision, nil
+}
@@ -0,0 +1,84 @@
+package kubernetes
+
+import (
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/klog/v2"
+)
+
+// newCachedDiscoveryClient returns a memory-cached discovery client.
+// If ttl is greater than zero, the cached discovery results are reused for (at most) ttl before being invalidated.
+func newCachedDiscoveryClient(delegate discovery.DiscoveryInterface, ttl time.Duration) discovery.CachedDiscoveryInterface {
+	cached := memory.NewMemCacheClient(delegate)
+	if ttl <= 0 {
+		return cached
+	}
+	return &ttlCachedDiscoveryClient{
+		CachedDiscoveryInterface: cached,
+		ttl:                      ttl,
+		now:                      time.Now,
+		cachedAt:                 time.Now(),
+	}
+}
+
+// ttlCachedDiscoveryClient is a discovery.CachedDiscoveryInterface whose cached results expire after a TTL.
+// Explicit invalidation requests (e.g. from the CRD watcher) are always forwarded to the underlying cache.
+type ttlCachedDiscoveryClient struct {
+	discovery.CachedDiscoveryInterface
+	ttl      time.Duration
+	now      func() time.Time
+	mu       sync.Mutex
+	cachedAt time.Time
+}
+
+var _ discovery.CachedDiscoveryInterface = (*ttlCachedDiscoveryClient)(nil)
+
+// Invalidate invalidates the cached discovery results and restarts the TTL.
+func (c *ttlCachedDiscoveryClient) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cachedAt = c.now()
+	c.CachedDiscoveryInterface.Invalidate()
+}
+
+func (c *ttlCachedDiscoveryClient) ServerGroups() (*metav1.APIGroupList, error) {
+	c.expire()
+	return c.CachedDiscoveryInterface.ServerGroups()
+}
+
+func (c *ttlCachedDiscoveryClient) ServerResourcesForGroupVersion(groupVersion string) (*metav1.APIResourceList, error) {
+	c.expire()
+	return c.CachedDiscoveryInterface.ServerResourcesForGroupVersion(groupVersion)
+}
+
+func (c *ttlCachedDiscoveryClient) ServerGroupsAndResources() ([]*metav1.APIGroup, []*metav1.APIResourceList, error) {
+	c.expire()
+	return c.CachedDiscoveryInterface.ServerGroupsAndResources()
+}
+
+func (c *ttlCachedDiscoveryClient) ServerPreferredResources() ([]*metav1.APIResourceList, error) {
+	c.expire()
+	return c.CachedDiscoveryInterface.ServerPreferredResources()
+}
+
+func (c *ttlCachedDiscoveryClient) ServerPreferredNamespacedResources() ([]*metav1.APIResourceList, error) {
+	c.expire()
+	return c.CachedDiscoveryInterface.ServerPreferredNamespacedResources()
+}
+
+// expire invalidates the underlying cache if the TTL has elapsed.
+func (c *ttlCachedDiscoveryClient) expire() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	if now.Sub(c.cachedAt) < c.ttl {
+		return
+	}
+	klog.V(5).Infof("Discovery cache results are older than %v, invalidating", c.ttl)
+	c.cachedAt = now
+	c.CachedDiscoveryInterface.Invalidate()
+}
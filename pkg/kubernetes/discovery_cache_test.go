@@ -0,0 +1,101 @@
+package kubernetes
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+	"github.com/containers/kubernetes-mcp-server/pkg/config"
+	"github.com/stretchr/testify/suite"
+)
+
+type DiscoveryCacheTestSuite struct {
+	suite.Suite
+	mockServer     *test.MockServer
+	discoveryCalls int
+}
+
+func (s *DiscoveryCacheTestSuite) SetupTest() {
+	s.discoveryCalls = 0
+	s.mockServer = test.NewMockServer()
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/api" {
+			s.discoveryCalls++
+		}
+	}))
+	s.mockServer.Handle(test.NewDiscoveryClientHandler())
+}
+
+func (s *DiscoveryCacheTestSuite) TearDownTest() {
+	s.mockServer.Close()
+}
+
+func (s *DiscoveryCacheTestSuite) newManager(toml string) *Manager {
+	kubeconfigPath := s.mockServer.KubeconfigFile(s.T())
+	testStaticConfig := test.Must(config.ReadToml([]byte(toml + `
+		kubeconfig = "` + strings.ReplaceAll(kubeconfigPath, `\`, `\\`) + `"
+	`)))
+	testManager, err := NewKubeconfigManager(testStaticConfig, "")
+	s.Require().NoErrorf(err, "failed to create test manager: %v", err)
+	return testManager
+}
+
+func (s *DiscoveryCacheTestSuite) TestWithoutTTL() {
+	testManager := s.newManager("")
+	s.Run("uses memory cached discovery client", func() {
+		_, ok := testManager.kubernetes.DiscoveryClient().(*ttlCachedDiscoveryClient)
+		s.False(ok, "expected plain memory cached discovery client")
+	})
+	s.Run("invalidation re-fetches discovery", func() {
+		_, err := testManager.kubernetes.DiscoveryClient().ServerGroups()
+		s.Require().NoError(err)
+		calls := s.discoveryCalls
+		testManager.Invalidate()
+		_, err = testManager.kubernetes.DiscoveryClient().ServerGroups()
+		s.Require().NoError(err)
+		s.Greater(s.discoveryCalls, calls, "expected discovery to be re-fetched after invalidation")
+	})
+}
+
+func (s *DiscoveryCacheTestSuite) TestWithTTL() {
+	testManager := s.newManager("discovery_cache_ttl_seconds = 60")
+	discoveryClient, ok := testManager.kubernetes.DiscoveryClient().(*ttlCachedDiscoveryClient)
+	s.Require().True(ok, "expected TTL cached discovery client")
+	s.Equal(60*time.Second, discoveryClient.ttl)
+	now := time.Now()
+	discoveryClient.now = func() time.Time { return now }
+	_, err := discoveryClient.ServerGroups()
+	s.Require().NoError(err)
+	calls := s.discoveryCalls
+	s.Run("discovery is not re-fetched within the TTL", func() {
+		now = now.Add(30 * time.Second)
+		_, err := discoveryClient.ServerGroups()
+		s.Require().NoError(err)
+		s.Equal(calls, s.discoveryCalls, "expected discovery to be served from cache")
+	})
+	s.Run("invalidation within the TTL re-fetches discovery", func() {
+		testManager.Invalidate()
+		_, err := discoveryClient.ServerGroups()
+		s.Require().NoError(err)
+		s.Greater(s.discoveryCalls, calls, "expected discovery to be re-fetched after invalidation")
+		calls = s.discoveryCalls
+	})
+	s.Run("invalidation restarts the TTL", func() {
+		now = now.Add(31 * time.Second)
+		_, err := discoveryClient.ServerGroups()
+		s.Require().NoError(err)
+		s.Equal(calls, s.discoveryCalls, "expected discovery to be served from cache")
+	})
+	s.Run("discovery is re-fetched once the TTL elapses", func() {
+		now = now.Add(30 * time.Second)
+		_, err := discoveryClient.ServerGroups()
+		s.Require().NoError(err)
+		s.Greater(s.discoveryCalls, calls, "expected discovery to be re-fetched after the TTL")
+	})
+}
+
+func TestDiscoveryCache(t *testing.T) {
+	suite.Run(t, new(DiscoveryCacheTestSuite))
+}
@@ -2,43 +2,80 @@ package kubernetes
 
 import (
 	"context"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/containers/kubernetes-mcp-server/pkg/api"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/duration"
 )
 
+// DefaultRecentEventsLimit is the default number of events returned by EventsRecent.
+const DefaultRecentEventsLimit = 20
+
 func (c *Core) EventsList(ctx context.Context, namespace string) ([]map[string]any, error) {
 	var eventMap []map[string]any
-	raw, err := c.ResourcesList(ctx, &schema.GroupVersionKind{
-		Group: "", Version: "v1", Kind: "Event",
-	}, namespace, api.ListOptions{})
+	events, err := c.events(ctx, namespace, api.ListOptions{})
 	if err != nil {
 		return eventMap, err
 	}
-	unstructuredList := raw.(*unstructured.UnstructuredList)
-	if len(unstructuredList.Items) == 0 {
-		return eventMap, nil
+	for _, event := range events {
+		eventMap = append(eventMap, map[string]any{
+			"Namespace": event.Namespace,
+			"Timestamp": eventTimestamp(&event).String(),
+			"Type":      event.Type,
+			"Reason":    event.Reason,
+			"InvolvedObject": map[string]string{
+				"apiVersion": event.InvolvedObject.APIVersion,
+				"Kind":       event.InvolvedObject.Kind,
+				"Name":       event.InvolvedObject.Name,
+			},
+			"Message": strings.TrimSpace(event.Message),
+		})
 	}
-	for _, item := range unstructuredList.Items {
-		event := &v1.Event{}
-		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, event); err != nil {
-			return eventMap, err
+	return eventMap, nil
+}
+
+// EventsRecent returns the most recent events (newest first) limited to the provided number of entries.
+// If warningsOnly is true, only events of type Warning are considered.
+func (c *Core) EventsRecent(ctx context.Context, namespace string, limit int, warningsOnly bool) ([]map[string]any, error) {
+	var eventMap []map[string]any
+	events, err := c.events(ctx, namespace, api.ListOptions{})
+	if err != nil {
+		return eventMap, err
+	}
+	if warningsOnly {
+		filtered := events[:0]
+		for _, event := range events {
+			if event.Type == v1.EventTypeWarning {
+				filtered = append(filtered, event)
+			}
 		}
-		timestamp := event.EventTime.Time
-		if timestamp.IsZero() && event.Series != nil {
-			timestamp = event.Series.LastObservedTime.Time
-		} else if timestamp.IsZero() && event.Count > 1 {
-			timestamp = event.LastTimestamp.Time
-		} else if timestamp.IsZero() {
-			timestamp = event.FirstTimestamp.Time
+		events = filtered
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return eventTimestamp(&events[i]).After(eventTimestamp(&events[j]))
+	})
+	if limit > 0 && len(events) > limit {
+		events = events[:limit]
+	}
+	now := time.Now()
+	for _, event := range events {
+		timestamp := eventTimestamp(&event)
+		age := "<unknown>"
+		if !timestamp.IsZero() {
+			age = duration.HumanDuration(now.Sub(timestamp))
 		}
 		eventMap = append(eventMap, map[string]any{
 			"Namespace": event.Namespace,
 			"Timestamp": timestamp.String(),
+			"Age":       age,
 			"Type":      event.Type,
 			"Reason":    event.Reason,
 			"InvolvedObject": map[string]string{
@@ -51,3 +88,83 @@ func (c *Core) EventsList(ctx context.Context, namespace string) ([]map[string]a
 	}
 	return eventMap, nil
 }
+
+// ResourcesEvents returns the events of the provided resource in chronological order (oldest first), equivalent to
+// the Events section of kubectl describe.
+func (c *Core) ResourcesEvents(ctx context.Context, gvk *schema.GroupVersionKind, namespace, name string) ([]map[string]any, error) {
+	obj, err := c.ResourcesGet(ctx, gvk, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	fieldSelector := fields.Set{"involvedObject.name": obj.GetName(), "involvedObject.kind": obj.GetKind()}
+	if obj.GetNamespace() != "" {
+		fieldSelector["involvedObject.namespace"] = obj.GetNamespace()
+	}
+	events, err := c.events(ctx, obj.GetNamespace(), api.ListOptions{
+		ListOptions: metav1.ListOptions{FieldSelector: fieldSelector.AsSelector().String()},
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Field selectors are not supported by every API server (or proxy), make sure only the events of the object are returned
+	filtered := events[:0]
+	for _, event := range events {
+		if event.InvolvedObject.Name == obj.GetName() && event.InvolvedObject.Kind == obj.GetKind() &&
+			(event.InvolvedObject.UID == "" || event.InvolvedObject.UID == obj.GetUID()) {
+			filtered = append(filtered, event)
+		}
+	}
+	events = filtered
+	sort.SliceStable(events, func(i, j int) bool {
+		return eventTimestamp(&events[i]).Before(eventTimestamp(&events[j]))
+	})
+	eventMap := make([]map[string]any, 0, len(events))
+	now := time.Now()
+	for _, event := range events {
+		timestamp := eventTimestamp(&event)
+		age := "<unknown>"
+		if !timestamp.IsZero() {
+			age = duration.HumanDuration(now.Sub(timestamp))
+		}
+		eventMap = append(eventMap, map[string]any{
+			"Timestamp": timestamp.String(),
+			"Age":       age,
+			"Type":      event.Type,
+			"Reason":    event.Reason,
+			"Message":   strings.TrimSpace(event.Message),
+		})
+	}
+	return eventMap, nil
+}
+
+func (c *Core) events(ctx context.Context, namespace string, options api.ListOptions) ([]v1.Event, error) {
+	raw, err := c.ResourcesList(ctx, &schema.GroupVersionKind{
+		Group: "", Version: "v1", Kind: "Event",
+	}, namespace, options)
+	if err != nil {
+		return nil, err
+	}
+	unstructuredList := raw.(*unstructured.UnstructuredList)
+	events := make([]v1.Event, 0, len(unstructuredList.Items))
+	for _, item := range unstructuredList.Items {
+		event := v1.Event{}
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(item.Object, &event); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// eventTimestamp returns the most relevant timestamp for the provided event.
+func eventTimestamp(event *v1.Event) time.Time {
+	timestamp := event.EventTime.Time
+	if timestamp.IsZero() && event.Series != nil {
+		timestamp = event.Series.LastObservedTime.Time
+	} else if timestamp.IsZero() && event.Count > 1 {
+		timestamp = event.LastTimestamp.Time
+	} else if timestamp.IsZero() {
+		timestamp = event.FirstTimestamp.Time
+	}
+	return timestamp
+}
@@ -0,0 +1,43 @@
+package kubernetes
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// DefaultMultiClusterConcurrency is the maximum number of targets ForEachTarget calls concurrently if not configured.
+const DefaultMultiClusterConcurrency = 10
+
+// TargetResult is the result (or error) of the call of ForEachTarget for a single target.
+type TargetResult[T any] struct {
+	Target string
+	Value  T
+	Err    error
+}
+
+// ForEachTarget calls fn for each of the provided targets concurrently, with at most concurrency calls in flight
+// (DefaultMultiClusterConcurrency if not positive), and returns the results sorted by target.
+// A failing target doesn't prevent the other targets from being called, its error is reported in its TargetResult.
+func ForEachTarget[T any](ctx context.Context, targets []string, concurrency int, fn func(ctx context.Context, target string) (T, error)) []TargetResult[T] {
+	if concurrency <= 0 {
+		concurrency = DefaultMultiClusterConcurrency
+	}
+	results := make([]TargetResult[T], len(targets))
+	g := new(errgroup.Group)
+	g.SetLimit(concurrency)
+	for i, target := range targets {
+		g.Go(func() error {
+			value, err := fn(ctx, target)
+			results[i] = TargetResult[T]{Target: target, Value: value, Err: err}
+			return nil
+		})
+	}
+	_ = g.Wait()
+	slices.SortStableFunc(results, func(a, b TargetResult[T]) int {
+		return strings.Compare(a.Target, b.Target)
+	})
+	return results
+}
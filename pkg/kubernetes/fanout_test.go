@@ -0,0 +1,85 @@
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type FanOutTestSuite struct {
+	suite.Suite
+}
+
+func (s *FanOutTestSuite) TestForEachTarget() {
+	targets := []string{"delta", "alpha", "echo", "charlie", "bravo", "foxtrot"}
+	s.Run("bounds the number of concurrent calls", func() {
+		var inFlight, maxInFlight atomic.Int32
+		ForEachTarget(s.T().Context(), targets, 2, func(_ context.Context, _ string) (struct{}, error) {
+			current := inFlight.Add(1)
+			for {
+				previous := maxInFlight.Load()
+				if current <= previous || maxInFlight.CompareAndSwap(previous, current) {
+					break
+				}
+			}
+			time.Sleep(20 * time.Millisecond)
+			inFlight.Add(-1)
+			return struct{}{}, nil
+		})
+		s.Equal(int32(2), maxInFlight.Load(), "Expected at most 2 concurrent calls")
+	})
+	s.Run("defaults the concurrency if not positive", func() {
+		var calls atomic.Int32
+		results := ForEachTarget(s.T().Context(), targets, 0, func(_ context.Context, _ string) (struct{}, error) {
+			calls.Add(1)
+			return struct{}{}, nil
+		})
+		s.Len(results, len(targets))
+		s.Equal(int32(len(targets)), calls.Load())
+	})
+	s.Run("returns the results sorted by target", func() {
+		results := ForEachTarget(s.T().Context(), targets, 3, func(_ context.Context, target string) (string, error) {
+			// The first targets finish last
+			time.Sleep(time.Duration(len(target)) * 5 * time.Millisecond)
+			return "value-" + target, nil
+		})
+		s.Require().Len(results, len(targets))
+		for i, expected := range []string{"alpha", "bravo", "charlie", "delta", "echo", "foxtrot"} {
+			s.Equal(expected, results[i].Target)
+			s.Equal("value-"+expected, results[i].Value)
+			s.NoError(results[i].Err)
+		}
+	})
+	s.Run("reports the error of failing targets without affecting the others", func() {
+		results := ForEachTarget(s.T().Context(), targets, 2, func(_ context.Context, target string) (int, error) {
+			if target == "charlie" {
+				return 0, errors.New("connection refused")
+			}
+			return len(target), nil
+		})
+		s.Require().Len(results, len(targets))
+		s.Equal("charlie", results[2].Target)
+		s.EqualError(results[2].Err, "connection refused")
+		for _, result := range results {
+			if result.Target != "charlie" {
+				s.NoError(result.Err)
+				s.Equal(len(result.Target), result.Value)
+			}
+		}
+	})
+	s.Run("with no targets returns no results", func() {
+		results := ForEachTarget(s.T().Context(), nil, 2, func(_ context.Context, _ string) (struct{}, error) {
+			s.Fail("Expected no calls")
+			return struct{}{}, nil
+		})
+		s.Empty(results)
+	})
+}
+
+func TestFanOut(t *testing.T) {
+	suite.Run(t, new(FanOutTestSuite))
+}
@@ -0,0 +1,157 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+)
+
+// HorizontalPodAutoscalerStatus is a summary of the scaling status of a HorizontalPodAutoscaler.
+type HorizontalPodAutoscalerStatus struct {
+	Namespace       string
+	Name            string
+	Reference       string
+	Targets         []string
+	MinReplicas     int32
+	MaxReplicas     int32
+	CurrentReplicas int32
+	DesiredReplicas int32
+	Conditions      []string
+}
+
+// HorizontalPodAutoscalersList returns the scale target, the current versus target metrics, the replica bounds and counts,
+// and the conditions of the HorizontalPodAutoscalers in the provided namespace (or all namespaces).
+func (c *Core) HorizontalPodAutoscalersList(ctx context.Context, namespace string, allNamespaces bool) ([]HorizontalPodAutoscalerStatus, error) {
+	hpas, err := c.ResourcesList(ctx, &schema.GroupVersionKind{
+		Group: "autoscaling", Version: "v2", Kind: "HorizontalPodAutoscaler",
+	}, c.listNamespace(namespace, allNamespaces), api.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]HorizontalPodAutoscalerStatus, 0)
+	err = hpas.EachListItem(func(obj runtime.Object) error {
+		hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.(*unstructured.Unstructured).Object, hpa); err != nil {
+			return err
+		}
+		status := HorizontalPodAutoscalerStatus{
+			Namespace:       hpa.Namespace,
+			Name:            hpa.Name,
+			Reference:       hpa.Spec.ScaleTargetRef.Kind + "/" + hpa.Spec.ScaleTargetRef.Name,
+			Targets:         make([]string, 0, len(hpa.Spec.Metrics)),
+			MinReplicas:     ptr.Deref(hpa.Spec.MinReplicas, 1),
+			MaxReplicas:     hpa.Spec.MaxReplicas,
+			CurrentReplicas: hpa.Status.CurrentReplicas,
+			DesiredReplicas: hpa.Status.DesiredReplicas,
+			Conditions:      make([]string, 0, len(hpa.Status.Conditions)),
+		}
+		for i, metric := range hpa.Spec.Metrics {
+			var current *autoscalingv2.MetricStatus
+			if i < len(hpa.Status.CurrentMetrics) {
+				current = &hpa.Status.CurrentMetrics[i]
+			}
+			status.Targets = append(status.Targets, metricTargetToString(metric, current))
+		}
+		for _, condition := range hpa.Status.Conditions {
+			conditionString := fmt.Sprintf("%s=%s", condition.Type, condition.Status)
+			if condition.Reason != "" {
+				conditionString += "(" + condition.Reason + ")"
+			}
+			status.Conditions = append(status.Conditions, conditionString)
+		}
+		ret = append(ret, status)
+		return nil
+	})
+	return ret, err
+}
+
+// metricTargetToString returns the provided metric as a "name: current/target" string (similar to kubectl get hpa).
+// The current value is <unknown> if the metric has not been collected yet.
+func metricTargetToString(metric autoscalingv2.MetricSpec, current *autoscalingv2.MetricStatus) string {
+	switch metric.Type {
+	case autoscalingv2.ResourceMetricSourceType:
+		if metric.Resource == nil {
+			break
+		}
+		var currentValue *autoscalingv2.MetricValueStatus
+		if current != nil && current.Resource != nil {
+			currentValue = &current.Resource.Current
+		}
+		return fmt.Sprintf("%s: %s", metric.Resource.Name, metricValueToString(metric.Resource.Target, currentValue))
+	case autoscalingv2.ContainerResourceMetricSourceType:
+		if metric.ContainerResource == nil {
+			break
+		}
+		var currentValue *autoscalingv2.MetricValueStatus
+		if current != nil && current.ContainerResource != nil {
+			currentValue = &current.ContainerResource.Current
+		}
+		return fmt.Sprintf("%s(container %s): %s", metric.ContainerResource.Name, metric.ContainerResource.Container,
+			metricValueToString(metric.ContainerResource.Target, currentValue))
+	case autoscalingv2.PodsMetricSourceType:
+		if metric.Pods == nil {
+			break
+		}
+		var currentValue *autoscalingv2.MetricValueStatus
+		if current != nil && current.Pods != nil {
+			currentValue = &current.Pods.Current
+		}
+		return fmt.Sprintf("%s: %s", metric.Pods.Metric.Name, metricValueToString(metric.Pods.Target, currentValue))
+	case autoscalingv2.ObjectMetricSourceType:
+		if metric.Object == nil {
+			break
+		}
+		var currentValue *autoscalingv2.MetricValueStatus
+		if current != nil && current.Object != nil {
+			currentValue = &current.Object.Current
+		}
+		return fmt.Sprintf("%s(on %s/%s): %s", metric.Object.Metric.Name, metric.Object.DescribedObject.Kind,
+			metric.Object.DescribedObject.Name, metricValueToString(metric.Object.Target, currentValue))
+	case autoscalingv2.ExternalMetricSourceType:
+		if metric.External == nil {
+			break
+		}
+		var currentValue *autoscalingv2.MetricValueStatus
+		if current != nil && current.External != nil {
+			currentValue = &current.External.Current
+		}
+		return fmt.Sprintf("%s(external): %s", metric.External.Metric.Name, metricValueToString(metric.External.Target, currentValue))
+	}
+	return fmt.Sprintf("%s: <unknown>", metric.Type)
+}
+
+// metricValueToString returns the current and the target value of a metric as "current/target".
+func metricValueToString(target autoscalingv2.MetricTarget, current *autoscalingv2.MetricValueStatus) string {
+	currentString := "<unknown>"
+	switch target.Type {
+	case autoscalingv2.UtilizationMetricType:
+		if current != nil && current.AverageUtilization != nil {
+			currentString = fmt.Sprintf("%d%%", *current.AverageUtilization)
+		}
+		return fmt.Sprintf("%s/%d%%", currentString, ptr.Deref(target.AverageUtilization, 0))
+	case autoscalingv2.AverageValueMetricType:
+		if current != nil && current.AverageValue != nil {
+			currentString = current.AverageValue.String()
+		}
+		return fmt.Sprintf("%s/%s (avg)", currentString, quantityToString(target.AverageValue))
+	default:
+		if current != nil && current.Value != nil {
+			currentString = current.Value.String()
+		}
+		return fmt.Sprintf("%s/%s", currentString, quantityToString(target.Value))
+	}
+}
+
+func quantityToString(quantity *resource.Quantity) string {
+	if quantity == nil {
+		return "<unset>"
+	}
+	return quantity.String()
+}
@@ -0,0 +1,70 @@
+package kubernetes
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+// ImageUsage is a summary of a container image used by the Pods of a namespace (or the cluster).
+type ImageUsage struct {
+	Image string
+	// Pods is the number of distinct Pods with at least one (init, ephemeral) container using the image
+	Pods int
+	// Mutable is true if the image is not pinned by digest and references the latest tag (explicitly or implicitly)
+	Mutable bool
+}
+
+// ImagesList returns the distinct container images used by the Pods in the provided namespace (or all namespaces),
+// sorted by image reference, along with the number of Pods using each of them.
+func (c *Core) ImagesList(ctx context.Context, namespace string, allNamespaces bool) ([]ImageUsage, error) {
+	pods, err := c.ResourcesList(ctx, &schema.GroupVersionKind{
+		Group: "", Version: "v1", Kind: "Pod",
+	}, c.listNamespace(namespace, allNamespaces), api.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[string]int)
+	err = pods.EachListItem(func(obj runtime.Object) error {
+		pod := obj.(*unstructured.Unstructured)
+		podImages := make(map[string]bool)
+		for _, field := range []string{"initContainers", "containers", "ephemeralContainers"} {
+			containers, _, _ := unstructured.NestedSlice(pod.Object, "spec", field)
+			for _, container := range containers {
+				if image, _, _ := unstructured.NestedString(asMap(container), "image"); image != "" {
+					podImages[image] = true
+				}
+			}
+		}
+		for image := range podImages {
+			counts[image]++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]ImageUsage, 0, len(counts))
+	for image, count := range counts {
+		ret = append(ret, ImageUsage{Image: image, Pods: count, Mutable: isMutableImage(image)})
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].Image < ret[j].Image })
+	return ret, nil
+}
+
+// isMutableImage returns true if the image reference is not pinned by digest and its tag is either latest or missing
+// (which defaults to latest).
+func isMutableImage(image string) bool {
+	if strings.Contains(image, "@") {
+		return false
+	}
+	name := image[strings.LastIndex(image, "/")+1:]
+	_, tag, found := strings.Cut(name, ":")
+	return !found || tag == "latest"
+}
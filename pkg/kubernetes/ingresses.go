@@ -0,0 +1,152 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// IngressRoute is a summary of a single host/path routing rule of an Ingress or an OpenShift Route.
+type IngressRoute struct {
+	Namespace string
+	Name      string
+	Host      string
+	Path      string
+	Service   string
+	Port      string
+	TLS       string
+}
+
+// IngressesList returns the host/path rules of the Ingresses in the provided namespace (or all namespaces),
+// including their backing Services and TLS status.
+func (c *Core) IngressesList(ctx context.Context, namespace string, allNamespaces bool) ([]IngressRoute, error) {
+	ingresses, err := c.ResourcesList(ctx, &schema.GroupVersionKind{
+		Group: "networking.k8s.io", Version: "v1", Kind: "Ingress",
+	}, c.listNamespace(namespace, allNamespaces), api.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]IngressRoute, 0)
+	err = ingresses.EachListItem(func(obj runtime.Object) error {
+		ingress := obj.(*unstructured.Unstructured)
+		tlsHosts := make(map[string]bool)
+		tlsSlice, _, _ := unstructured.NestedSlice(ingress.Object, "spec", "tls")
+		for _, tls := range tlsSlice {
+			hosts, _, _ := unstructured.NestedStringSlice(asMap(tls), "hosts")
+			for _, host := range hosts {
+				tlsHosts[host] = true
+			}
+		}
+		tlsFor := func(host string) string {
+			if tlsHosts[host] || (host == "*" && len(tlsSlice) > 0) {
+				return "yes"
+			}
+			return "no"
+		}
+		if service, port, found := ingressBackend(ingress.Object, "spec", "defaultBackend"); found {
+			ret = append(ret, IngressRoute{
+				Namespace: ingress.GetNamespace(), Name: ingress.GetName(),
+				Host: "*", Path: "/", Service: service, Port: port, TLS: tlsFor("*"),
+			})
+		}
+		rules, _, _ := unstructured.NestedSlice(ingress.Object, "spec", "rules")
+		for _, r := range rules {
+			rule := asMap(r)
+			host, _, _ := unstructured.NestedString(rule, "host")
+			if host == "" {
+				host = "*"
+			}
+			paths, _, _ := unstructured.NestedSlice(rule, "http", "paths")
+			for _, p := range paths {
+				path, _, _ := unstructured.NestedString(asMap(p), "path")
+				if path == "" {
+					path = "/"
+				}
+				service, port, _ := ingressBackend(asMap(p), "backend")
+				ret = append(ret, IngressRoute{
+					Namespace: ingress.GetNamespace(), Name: ingress.GetName(),
+					Host: host, Path: path, Service: service, Port: port, TLS: tlsFor(host),
+				})
+			}
+		}
+		return nil
+	})
+	return ret, err
+}
+
+// RoutesList returns the hosts and paths of the OpenShift Routes in the provided namespace (or all namespaces),
+// including their backing Services and TLS termination.
+func (c *Core) RoutesList(ctx context.Context, namespace string, allNamespaces bool) ([]IngressRoute, error) {
+	routes, err := c.ResourcesList(ctx, &schema.GroupVersionKind{
+		Group: "route.openshift.io", Version: "v1", Kind: "Route",
+	}, c.listNamespace(namespace, allNamespaces), api.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]IngressRoute, 0)
+	err = routes.EachListItem(func(obj runtime.Object) error {
+		route := obj.(*unstructured.Unstructured)
+		host, _, _ := unstructured.NestedString(route.Object, "spec", "host")
+		path, _, _ := unstructured.NestedString(route.Object, "spec", "path")
+		if path == "" {
+			path = "/"
+		}
+		service, _, _ := unstructured.NestedString(route.Object, "spec", "to", "name")
+		port := "-"
+		if targetPort, found, _ := unstructured.NestedFieldNoCopy(route.Object, "spec", "port", "targetPort"); found {
+			port = fmt.Sprint(targetPort)
+		}
+		tls := "no"
+		if termination, _, _ := unstructured.NestedString(route.Object, "spec", "tls", "termination"); termination != "" {
+			tls = strings.ToLower(termination)
+		}
+		ret = append(ret, IngressRoute{
+			Namespace: route.GetNamespace(), Name: route.GetName(),
+			Host: host, Path: path, Service: service, Port: port, TLS: tls,
+		})
+		return nil
+	})
+	return ret, err
+}
+
+// listNamespace returns the namespace to list resources from, empty for all namespaces.
+func (c *Core) listNamespace(namespace string, allNamespaces bool) string {
+	if allNamespaces && namespace == "" {
+		return ""
+	}
+	return c.NamespaceOrDefault(namespace)
+}
+
+// ingressBackend returns the Service name and port of the Ingress backend at the provided path.
+func ingressBackend(obj map[string]any, fields ...string) (service, port string, found bool) {
+	backend, found, _ := unstructured.NestedMap(obj, fields...)
+	if !found {
+		return "", "", false
+	}
+	service, _, _ = unstructured.NestedString(backend, "service", "name")
+	if portNumber, ok, _ := unstructured.NestedInt64(backend, "service", "port", "number"); ok {
+		port = fmt.Sprint(portNumber)
+	} else if portName, ok, _ := unstructured.NestedString(backend, "service", "port", "name"); ok {
+		port = portName
+	}
+	if service == "" {
+		if kind, ok, _ := unstructured.NestedString(backend, "resource", "kind"); ok {
+			name, _, _ := unstructured.NestedString(backend, "resource", "name")
+			service = kind + "/" + name
+		}
+	}
+	if port == "" {
+		port = "-"
+	}
+	return service, port, true
+}
+
+func asMap(obj any) map[string]any {
+	m, _ := obj.(map[string]any)
+	return m
+}
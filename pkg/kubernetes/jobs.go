@@ -0,0 +1,161 @@
+package kubernetes
+
+import (
+	"context"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/ptr"
+)
+
+// JobsFollowMaxDuration is the maximum time JobsFollow waits for a Job to finish
+const JobsFollowMaxDuration = 300 * time.Second
+
+// jobsFollowInterval is the interval between the Job status checks of JobsFollow
+const jobsFollowInterval = time.Second
+
+// JobsStatus returns a summary of the status of the Job with the provided name.
+func (c *Core) JobsStatus(ctx context.Context, namespace, name string) (map[string]any, error) {
+	job, err := c.BatchV1().Jobs(c.NamespaceOrDefault(namespace)).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return jobStatus(job), nil
+}
+
+// JobsFollow waits until the Job with the provided name finishes (Complete or Failed condition) or the provided
+// duration (at most JobsFollowMaxDuration) elapses, and returns a summary of its last observed status.
+// The returned bool is false if the duration elapsed before the Job finished.
+func (c *Core) JobsFollow(ctx context.Context, namespace, name string, duration time.Duration) (map[string]any, bool, error) {
+	if duration <= 0 || duration > JobsFollowMaxDuration {
+		duration = JobsFollowMaxDuration
+	}
+	jobs := c.BatchV1().Jobs(c.NamespaceOrDefault(namespace))
+	var job *batchv1.Job
+	err := wait.PollUntilContextTimeout(ctx, jobsFollowInterval, duration, true, func(ctx context.Context) (bool, error) {
+		current, err := jobs.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		job = current
+		return jobFinished(job), nil
+	})
+	if err != nil && (job == nil || !wait.Interrupted(err)) {
+		return nil, false, err
+	}
+	return jobStatus(job), err == nil, nil
+}
+
+// jobFinished returns true if the Job has a Complete or Failed condition.
+func jobFinished(job *batchv1.Job) bool {
+	for _, condition := range job.Status.Conditions {
+		if (condition.Type == batchv1.JobComplete || condition.Type == batchv1.JobFailed) && condition.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+func jobStatus(job *batchv1.Job) map[string]any {
+	status := map[string]any{
+		"Kind":      "Job",
+		"Name":      job.Name,
+		"Namespace": job.Namespace,
+		"Active":    job.Status.Active,
+		"Succeeded": job.Status.Succeeded,
+		"Failed":    job.Status.Failed,
+	}
+	if job.Spec.Completions != nil {
+		status["Completions"] = *job.Spec.Completions
+	}
+	if job.Status.StartTime != nil {
+		status["StartTime"] = job.Status.StartTime.UTC().Format(time.RFC3339)
+	}
+	if job.Status.CompletionTime != nil {
+		status["CompletionTime"] = job.Status.CompletionTime.UTC().Format(time.RFC3339)
+	}
+	conditions := make([]map[string]string, 0, len(job.Status.Conditions))
+	for _, condition := range job.Status.Conditions {
+		conditions = append(conditions, map[string]string{
+			"Type":               string(condition.Type),
+			"Status":             string(condition.Status),
+			"Reason":             condition.Reason,
+			"Message":            condition.Message,
+			"LastTransitionTime": condition.LastTransitionTime.UTC().Format(time.RFC3339),
+		})
+	}
+	status["Conditions"] = conditions
+	return status
+}
+
+// CronJobsStatus returns a summary of the status of the CronJob with the provided name.
+func (c *Core) CronJobsStatus(ctx context.Context, namespace, name string) (map[string]any, error) {
+	cronJob, err := c.BatchV1().CronJobs(c.NamespaceOrDefault(namespace)).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	status := map[string]any{
+		"Kind":      "CronJob",
+		"Name":      cronJob.Name,
+		"Namespace": cronJob.Namespace,
+		"Schedule":  cronJob.Spec.Schedule,
+		"Suspended": cronJob.Spec.Suspend != nil && *cronJob.Spec.Suspend,
+	}
+	if cronJob.Status.LastScheduleTime != nil {
+		status["LastScheduleTime"] = cronJob.Status.LastScheduleTime.UTC().Format(time.RFC3339)
+	}
+	if cronJob.Status.LastSuccessfulTime != nil {
+		status["LastSuccessfulTime"] = cronJob.Status.LastSuccessfulTime.UTC().Format(time.RFC3339)
+	}
+	activeJobs := make([]string, 0, len(cronJob.Status.Active))
+	for _, active := range cronJob.Status.Active {
+		activeJobs = append(activeJobs, active.Name)
+	}
+	status["ActiveJobs"] = activeJobs
+	return status, nil
+}
+
+// CronJobsTrigger creates a Job from the job template of the CronJob with the provided name
+// (equivalent to kubectl create job --from=cronjob/name).
+// If no job name is provided, a name is generated from the CronJob name.
+func (c *Core) CronJobsTrigger(ctx context.Context, namespace, name, jobName string) (*batchv1.Job, error) {
+	namespace = c.NamespaceOrDefault(namespace)
+	cronJob, err := c.BatchV1().CronJobs(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if jobName == "" {
+		// Job names are limited to 63 characters (they're used as label values for the Pods)
+		prefix := cronJob.Name
+		if len(prefix) > 50 {
+			prefix = prefix[:50]
+		}
+		jobName = prefix + "-manual-" + rand.String(5)
+	}
+	annotations := map[string]string{"cronjob.kubernetes.io/instantiate": "manual"}
+	for k, v := range cronJob.Spec.JobTemplate.Annotations {
+		annotations[k] = v
+	}
+	job := &batchv1.Job{
+		TypeMeta: metav1.TypeMeta{APIVersion: "batch/v1", Kind: "Job"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        jobName,
+			Namespace:   namespace,
+			Labels:      cronJob.Spec.JobTemplate.Labels,
+			Annotations: annotations,
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "batch/v1",
+				Kind:       "CronJob",
+				Name:       cronJob.Name,
+				UID:        cronJob.UID,
+				Controller: ptr.To(true),
+			}},
+		},
+		Spec: cronJob.Spec.JobTemplate.Spec,
+	}
+	return c.BatchV1().Jobs(namespace).Create(ctx, job, metav1.CreateOptions{})
+}
@@ -3,12 +3,13 @@ package kubernetes
 import (
 	"fmt"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/containers/kubernetes-mcp-server/pkg/api"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/discovery"
-	"k8s.io/client-go/discovery/cached/memory"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -16,7 +17,6 @@ import (
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/restmapper"
 	"k8s.io/client-go/tools/clientcmd"
-	metricsv1beta1 "k8s.io/metrics/pkg/client/clientset/versioned/typed/metrics/v1beta1"
 )
 
 type HeaderKey string
@@ -24,8 +24,13 @@ type HeaderKey string
 const (
 	CustomAuthorizationHeader = HeaderKey("kubernetes-authorization")
 	OAuthAuthorizationHeader  = HeaderKey("Authorization")
+	// RequestIDHeader is used both as the context key and the header name to propagate the request ID
+	RequestIDHeader = HeaderKey("X-Request-Id")
 
 	CustomUserAgent = "kubernetes-mcp-server/bearer-token-auth"
+
+	// discoveryTimeout matches the default timeout set by client-go for discovery clients
+	discoveryTimeout = 32 * time.Second
 )
 
 type CloseWatchKubeConfig func() error
@@ -44,16 +49,25 @@ type Kubernetes struct {
 	restMapper      meta.ResettableRESTMapper
 	discoveryClient discovery.CachedDiscoveryInterface
 	dynamicClient   dynamic.Interface
-	metricsV1beta1  *metricsv1beta1.MetricsV1beta1Client
+	// baseTransport is the shared (credential-less) transport reused by derived clients, nil otherwise
+	baseTransport http.RoundTripper
 }
 
 var _ api.KubernetesClient = (*Kubernetes)(nil)
 
 func NewKubernetes(config api.BaseConfig, clientCmdConfig clientcmd.ClientConfig, restConfig *rest.Config) (*Kubernetes, error) {
+	return newKubernetes(config, clientCmdConfig, restConfig, nil)
+}
+
+// newKubernetes creates a new Kubernetes client.
+// If baseTransport is provided, the HTTP clients are layered on top of it (authentication, user agent, access control)
+// instead of creating (or looking up) a transport from the rest.Config.
+func newKubernetes(config api.BaseConfig, clientCmdConfig clientcmd.ClientConfig, restConfig *rest.Config, baseTransport http.RoundTripper) (*Kubernetes, error) {
 	k := &Kubernetes{
 		config:          config,
 		clientCmdConfig: clientCmdConfig,
 		restConfig:      rest.CopyConfig(restConfig),
+		baseTransport:   baseTransport,
 	}
 	if k.restConfig.UserAgent == "" {
 		k.restConfig.UserAgent = rest.DefaultKubernetesUserAgent()
@@ -65,25 +79,72 @@ func NewKubernetes(config api.BaseConfig, clientCmdConfig clientcmd.ClientConfig
 			restMapper:              k.restMapper,
 		}
 	})
+	k.restConfig.Wrap(func(original http.RoundTripper) http.RoundTripper {
+		return &requestIDRoundTripper{delegate: original}
+	})
+	initClients := k.initClients
+	if k.baseTransport != nil {
+		initClients = k.initClientsWithBaseTransport
+	}
+	if err := initClients(); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+func (k *Kubernetes) initClients() error {
 	discoveryClient, err := discovery.NewDiscoveryClientForConfig(k.restConfig)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create discovery client: %v", err)
+		return fmt.Errorf("failed to create discovery client: %v", err)
 	}
-	k.discoveryClient = memory.NewMemCacheClient(discoveryClient)
-	k.restMapper = restmapper.NewDeferredDiscoveryRESTMapper(k.discoveryClient)
+	k.discoveryClient = newCachedDiscoveryClient(discoveryClient, k.config.GetDiscoveryCacheTTL())
+	k.restMapper = newPreferredVersionsRESTMapper(restmapper.NewDeferredDiscoveryRESTMapper(k.discoveryClient), k.config.GetPreferredVersions())
 	k.Interface, err = kubernetes.NewForConfig(k.restConfig)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	k.dynamicClient, err = dynamic.NewForConfig(k.restConfig)
+	return err
+}
+
+// initClientsWithBaseTransport initializes the clients reusing the base transport.
+// Only the rest.Config wrappers (authentication, user agent, access control) are layered on top of it for each client.
+func (k *Kubernetes) initClientsWithBaseTransport() error {
+	discoveryHttpClient, err := k.httpClient(discoveryTimeout)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	discoveryClient, err := discovery.NewDiscoveryClientForConfigAndClient(k.restConfig, discoveryHttpClient)
+	if err != nil {
+		return fmt.Errorf("failed to create discovery client: %v", err)
 	}
-	k.metricsV1beta1, err = metricsv1beta1.NewForConfig(k.restConfig)
+	k.discoveryClient = newCachedDiscoveryClient(discoveryClient, k.config.GetDiscoveryCacheTTL())
+	k.restMapper = newPreferredVersionsRESTMapper(restmapper.NewDeferredDiscoveryRESTMapper(k.discoveryClient), k.config.GetPreferredVersions())
+	// The HTTP client must be created once the restMapper is initialized (used by the AccessControlRoundTripper)
+	httpClient, err := k.httpClient(0)
+	if err != nil {
+		return err
+	}
+	k.Interface, err = kubernetes.NewForConfigAndClient(k.restConfig, httpClient)
+	if err != nil {
+		return err
+	}
+	k.dynamicClient, err = dynamic.NewForConfigAndClient(k.restConfig, httpClient)
+	return err
+}
+
+// httpClient returns an HTTP client layered on top of the base transport.
+// The defaultTimeout is used if the rest.Config doesn't specify a timeout.
+func (k *Kubernetes) httpClient(defaultTimeout time.Duration) (*http.Client, error) {
+	rt, err := rest.HTTPWrappersForConfig(k.restConfig, k.baseTransport)
 	if err != nil {
 		return nil, err
 	}
-	return k, nil
+	timeout := k.restConfig.Timeout
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+	return &http.Client{Transport: rt, Timeout: timeout}, nil
 }
 
 func (k *Kubernetes) RESTConfig() *rest.Config {
@@ -102,8 +163,18 @@ func (k *Kubernetes) DynamicClient() dynamic.Interface {
 	return k.dynamicClient
 }
 
-func (k *Kubernetes) MetricsV1beta1Client() *metricsv1beta1.MetricsV1beta1Client {
-	return k.metricsV1beta1
+func (k *Kubernetes) MetricsGroupVersion() string {
+	return k.config.GetMetricsGroupVersion()
+}
+
+// DefaultMaxListItems is the maximum number of items merged from the pages of a paginated list if not configured.
+const DefaultMaxListItems = 10000
+
+func (k *Kubernetes) MaxListItems() int {
+	if maxListItems := k.config.GetMaxListItems(); maxListItems > 0 {
+		return maxListItems
+	}
+	return DefaultMaxListItems
 }
 
 func (k *Kubernetes) configuredNamespace() string {
@@ -120,6 +191,18 @@ func (k *Kubernetes) NamespaceOrDefault(namespace string) string {
 	return namespace
 }
 
+func (k *Kubernetes) IsNamespaceExcluded(namespace string) bool {
+	if namespace == "" {
+		return false
+	}
+	for _, prefix := range k.config.GetExcludedNamespacePrefixes() {
+		if strings.HasPrefix(namespace, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
 func (k *Kubernetes) ToDiscoveryClient() (discovery.CachedDiscoveryInterface, error) {
 	return k.DiscoveryClient(), nil
 }
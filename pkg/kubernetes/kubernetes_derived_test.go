@@ -2,6 +2,8 @@ package kubernetes
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"strings"
@@ -10,6 +12,8 @@ import (
 	"github.com/containers/kubernetes-mcp-server/internal/test"
 	"github.com/containers/kubernetes-mcp-server/pkg/config"
 	"github.com/stretchr/testify/suite"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/clientcmd"
 )
 
@@ -134,7 +138,6 @@ users:
 				s.NotNilf(derived.RESTMapper(), "expected RESTMapper to be initialized")
 				s.NotNilf(derived.DiscoveryClient(), "expected discoveryClient to be initialized")
 				s.NotNilf(derived.DynamicClient(), "expected dynamicClient to be initialized")
-				s.NotNilf(derived.MetricsV1beta1Client(), "expected metricsV1beta1Client to be initialized")
 			})
 		})
 	})
@@ -291,6 +294,146 @@ users:
 			s.Equalf("aiTana-julIA", derivedCfg.BearerToken, "expected BearerToken %s, got %s", "aiTana-julIA", derivedCfg.BearerToken)
 		})
 	})
+
+	s.Run("with RequireOAuth=true and IgnoreClientCredentials=true", func() {
+		testStaticConfig := test.Must(config.ReadToml([]byte(`
+			kubeconfig = "` + strings.ReplaceAll(kubeconfigPath, `\`, `\\`) + `"
+			require_oauth = true
+			ignore_client_credentials = true
+		`)))
+
+		s.Run("with no authorization header returns original client", func() {
+			testManager, err := NewKubeconfigManager(testStaticConfig, "")
+			s.Require().NoErrorf(err, "failed to create test manager: %v", err)
+
+			derived, err := testManager.Derived(s.T().Context())
+			s.Require().NoErrorf(err, "expected no error when client credentials are ignored, got: %v", err)
+			s.Equal(derived, testManager.kubernetes, "expected original client when client credentials are ignored")
+		})
+
+		s.Run("with valid bearer token returns original client", func() {
+			testManager, err := NewKubeconfigManager(testStaticConfig, "")
+			s.Require().NoErrorf(err, "failed to create test manager: %v", err)
+
+			ctx := context.WithValue(s.T().Context(), HeaderKey("Authorization"), "Bearer aiTana-julIA")
+			derived, err := testManager.Derived(ctx)
+			s.Require().NoErrorf(err, "expected no error when client credentials are ignored, got: %v", err)
+			s.Equal(derived, testManager.kubernetes, "expected original client when client credentials are ignored")
+		})
+	})
+}
+
+func (s *DerivedTestSuite) TestTransportReuse() {
+	mockServer := test.NewMockServer()
+	s.T().Cleanup(mockServer.Close)
+	var authorizationHeaders []string
+	mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/api/v1/pods" {
+			authorizationHeaders = append(authorizationHeaders, req.Header.Get("Authorization"))
+			test.WriteObject(w, &v1.PodList{})
+		}
+	}))
+	mockServer.Handle(test.NewDiscoveryClientHandler())
+	kubeconfigPath := mockServer.KubeconfigFile(s.T())
+	testStaticConfig := test.Must(config.ReadToml([]byte(`
+		kubeconfig = "` + strings.ReplaceAll(kubeconfigPath, `\`, `\\`) + `"
+	`)))
+	testManager, err := NewKubeconfigManager(testStaticConfig, "")
+	s.Require().NoErrorf(err, "failed to create test manager: %v", err)
+
+	derived1, err := testManager.Derived(context.WithValue(s.T().Context(), HeaderKey("Authorization"), "Bearer token-1"))
+	s.Require().NoErrorf(err, "failed to create derived kubernetes: %v", err)
+	derived2, err := testManager.Derived(context.WithValue(s.T().Context(), HeaderKey("Authorization"), "Bearer token-2"))
+	s.Require().NoErrorf(err, "failed to create derived kubernetes: %v", err)
+
+	s.Run("derived clients for the same target reuse the underlying transport", func() {
+		s.Require().NotNil(derived1.baseTransport, "expected derived client to have a base transport")
+		s.Same(derived1.baseTransport, derived2.baseTransport, "expected same underlying transport for the same target")
+	})
+	s.Run("derived clients inject their own Authorization header", func() {
+		_, err := derived1.CoreV1().Pods("").List(s.T().Context(), metav1.ListOptions{})
+		s.Require().NoError(err, "failed to list pods with derived1")
+		_, err = derived2.CoreV1().Pods("").List(s.T().Context(), metav1.ListOptions{})
+		s.Require().NoError(err, "failed to list pods with derived2")
+		_, err = derived1.CoreV1().Pods("").List(s.T().Context(), metav1.ListOptions{})
+		s.Require().NoError(err, "failed to list pods with derived1")
+		s.Equal([]string{"Bearer token-1", "Bearer token-2", "Bearer token-1"}, authorizationHeaders)
+	})
+	s.Run("base (non-derived) client doesn't use the shared transport", func() {
+		s.Nil(testManager.kubernetes.baseTransport)
+	})
+}
+
+func (s *DerivedTestSuite) TestAuthorizationHostGuard() {
+	mockServer := test.NewMockServer()
+	s.T().Cleanup(mockServer.Close)
+	mockServer.Handle(test.NewDiscoveryClientHandler())
+	var otherHostAuthorization []string
+	otherHost := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		otherHostAuthorization = append(otherHostAuthorization, req.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusOK)
+	}))
+	s.T().Cleanup(otherHost.Close)
+	kubeconfigPath := mockServer.KubeconfigFile(s.T())
+	ctx := context.WithValue(s.T().Context(), HeaderKey("Authorization"), "Bearer aiTana-julIA")
+
+	s.Run("request to a non-cluster host does not carry the propagated token", func() {
+		otherHostAuthorization = nil
+		testStaticConfig := test.Must(config.ReadToml([]byte(`
+			kubeconfig = "` + strings.ReplaceAll(kubeconfigPath, `\`, `\\`) + `"
+		`)))
+		testManager, err := NewKubeconfigManager(testStaticConfig, "")
+		s.Require().NoErrorf(err, "failed to create test manager: %v", err)
+		derived, err := testManager.Derived(ctx)
+		s.Require().NoErrorf(err, "failed to create derived kubernetes: %v", err)
+		httpClient, err := derived.httpClient(0)
+		s.Require().NoErrorf(err, "failed to create derived http client: %v", err)
+		resp, err := httpClient.Get(otherHost.URL + "/healthz")
+		s.Require().NoErrorf(err, "failed to request other host: %v", err)
+		_ = resp.Body.Close()
+		s.Equal([]string{""}, otherHostAuthorization, "expected no Authorization header in request to other host")
+	})
+	s.Run("request to an allowed host carries the propagated token", func() {
+		otherHostAuthorization = nil
+		testStaticConfig := test.Must(config.ReadToml([]byte(`
+			kubeconfig = "` + strings.ReplaceAll(kubeconfigPath, `\`, `\\`) + `"
+			auth_header_propagation_allowed_hosts = ["` + strings.TrimPrefix(otherHost.URL, "http://") + `"]
+		`)))
+		testManager, err := NewKubeconfigManager(testStaticConfig, "")
+		s.Require().NoErrorf(err, "failed to create test manager: %v", err)
+		derived, err := testManager.Derived(ctx)
+		s.Require().NoErrorf(err, "failed to create derived kubernetes: %v", err)
+		httpClient, err := derived.httpClient(0)
+		s.Require().NoErrorf(err, "failed to create derived http client: %v", err)
+		resp, err := httpClient.Get(otherHost.URL + "/healthz")
+		s.Require().NoErrorf(err, "failed to request other host: %v", err)
+		_ = resp.Body.Close()
+		s.Equal([]string{"Bearer aiTana-julIA"}, otherHostAuthorization, "expected Authorization header in request to allowed host")
+	})
+}
+
+func BenchmarkDerived(b *testing.B) {
+	mockServer := test.NewMockServer()
+	b.Cleanup(mockServer.Close)
+	mockServer.Handle(test.NewDiscoveryClientHandler())
+	kubeconfigPath := filepath.Join(b.TempDir(), "config")
+	if err := clientcmd.WriteToFile(*mockServer.Kubeconfig(), kubeconfigPath); err != nil {
+		b.Fatalf("failed to write kubeconfig: %v", err)
+	}
+	testStaticConfig := test.Must(config.ReadToml([]byte(`
+		kubeconfig = "` + strings.ReplaceAll(kubeconfigPath, `\`, `\\`) + `"
+	`)))
+	testManager, err := NewKubeconfigManager(testStaticConfig, "")
+	if err != nil {
+		b.Fatalf("failed to create test manager: %v", err)
+	}
+	ctx := context.WithValue(b.Context(), HeaderKey("Authorization"), "Bearer aiTana-julIA")
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := testManager.Derived(ctx); err != nil {
+			b.Fatalf("failed to create derived kubernetes: %v", err)
+		}
+	}
 }
 
 func TestDerived(t *testing.T) {
@@ -0,0 +1,86 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// FieldManagerEntry describes the fields of a resource owned by a single field manager (metadata.managedFields entry).
+type FieldManagerEntry struct {
+	Manager     string
+	Operation   string
+	Subresource string `json:",omitempty"`
+	Time        string `json:",omitempty"`
+	Fields      []string
+}
+
+// ResourcesLastModified returns the field managers of the provided resource, the most recent first, together with the
+// field groups (e.g. spec.replicas, metadata.labels) each of them last modified.
+func (c *Core) ResourcesLastModified(ctx context.Context, gvk *schema.GroupVersionKind, namespace, name string) ([]FieldManagerEntry, error) {
+	obj, err := c.ResourcesGet(ctx, gvk, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	managedFields := obj.GetManagedFields()
+	slices.SortStableFunc(managedFields, func(a, b metav1.ManagedFieldsEntry) int {
+		return managedFieldsTime(b).Compare(managedFieldsTime(a))
+	})
+	entries := make([]FieldManagerEntry, 0, len(managedFields))
+	for _, mf := range managedFields {
+		entry := FieldManagerEntry{
+			Manager:     mf.Manager,
+			Operation:   string(mf.Operation),
+			Subresource: mf.Subresource,
+			Fields:      fieldGroups(mf.FieldsV1),
+		}
+		if mf.Time != nil {
+			entry.Time = mf.Time.UTC().Format(time.RFC3339)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func managedFieldsTime(mf metav1.ManagedFieldsEntry) time.Time {
+	if mf.Time == nil {
+		return time.Time{}
+	}
+	return mf.Time.Time
+}
+
+// fieldGroups summarizes a FieldsV1 set into its top-level field groups, descending one level into named fields
+// (e.g. {"f:spec":{"f:replicas":{}}} -> spec.replicas).
+func fieldGroups(fieldsV1 *metav1.FieldsV1) []string {
+	if fieldsV1 == nil {
+		return nil
+	}
+	var fields map[string]map[string]any
+	if err := json.Unmarshal(fieldsV1.Raw, &fields); err != nil {
+		return nil
+	}
+	var groups []string
+	for key, children := range fields {
+		group, ok := strings.CutPrefix(key, "f:")
+		if !ok {
+			continue
+		}
+		hasNamedChildren := false
+		for child := range children {
+			if childName, named := strings.CutPrefix(child, "f:"); named {
+				groups = append(groups, group+"."+childName)
+				hasNamedChildren = true
+			}
+		}
+		if !hasNamedChildren {
+			groups = append(groups, group)
+		}
+	}
+	slices.Sort(groups)
+	return groups
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/url"
 	"os"
 	"strconv"
 	"strings"
@@ -29,13 +31,20 @@ var (
 )
 
 func NewKubeconfigManager(config api.BaseConfig, kubeconfigContext string) (*Manager, error) {
+	return newKubeconfigManagerForPath(config, config.GetKubeConfigPath(), kubeconfigContext)
+}
+
+// newKubeconfigManagerForPath creates a Manager for the provided kubeconfig context loaded from the kubeconfig file at kubeconfigPath.
+// If kubeconfigPath is empty, the default kubeconfig loading rules apply.
+// The file is never merged with other kubeconfig files, so the context is resolved against its own clusters and users.
+func newKubeconfigManagerForPath(config api.BaseConfig, kubeconfigPath, kubeconfigContext string) (*Manager, error) {
 	if IsInCluster(config) {
 		return nil, ErrorKubeconfigInClusterNotAllowed
 	}
 
 	pathOptions := clientcmd.NewDefaultPathOptions()
-	if config.GetKubeConfigPath() != "" {
-		pathOptions.LoadingRules.ExplicitPath = config.GetKubeConfigPath()
+	if kubeconfigPath != "" {
+		pathOptions.LoadingRules.ExplicitPath = kubeconfigPath
 	}
 	clientCmdConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
 		pathOptions.LoadingRules,
@@ -52,6 +61,15 @@ func NewKubeconfigManager(config api.BaseConfig, kubeconfigContext string) (*Man
 	return NewManager(config, restConfig, clientCmdConfig)
 }
 
+// kubeConfigPaths returns the configured kubeconfig file followed by the additional kubeconfig files (if any).
+func kubeConfigPaths(config api.ClusterProvider) []string {
+	var paths []string
+	if config.GetKubeConfigPath() != "" {
+		paths = append(paths, config.GetKubeConfigPath())
+	}
+	return append(paths, config.GetKubeConfigPaths()...)
+}
+
 func NewInClusterManager(config api.BaseConfig) (*Manager, error) {
 	if config.GetKubeConfigPath() != "" {
 		return nil, fmt.Errorf("kubeconfig file %s cannot be used with the in-cluster deployments: %v", config.GetKubeConfigPath(), ErrorKubeconfigInClusterNotAllowed)
@@ -78,6 +96,8 @@ func NewInClusterManager(config api.BaseConfig) (*Manager, error) {
 	clientCmdConfig.Contexts[inClusterKubeConfigDefaultContext] = &clientcmdapi.Context{
 		Cluster:  "cluster",
 		AuthInfo: "user",
+		// Derived clients inherit the context, so the configured namespace applies to them too
+		Namespace: config.GetDefaultNamespace(),
 	}
 	clientCmdConfig.CurrentContext = inClusterKubeConfigDefaultContext
 
@@ -98,6 +118,15 @@ func NewManager(config api.BaseConfig, restConfig *rest.Config, clientCmdConfig
 	// Apply QPS and Burst from environment variables if set (primarily for testing)
 	applyRateLimitFromEnv(restConfig)
 
+	// The configured proxy takes precedence over the kubeconfig (proxy-url) and environment (HTTP_PROXY) ones
+	if proxyURL := config.GetProxyURL(); proxyURL != "" {
+		u, err := url.Parse(proxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL %s: %w", proxyURL, err)
+		}
+		restConfig.Proxy = http.ProxyURL(u)
+	}
+
 	k8s := &Manager{
 		config: config,
 	}
@@ -114,6 +143,10 @@ func NewManager(config api.BaseConfig, restConfig *rest.Config, clientCmdConfig
 }
 
 func (m *Manager) Derived(ctx context.Context) (*Kubernetes, error) {
+	// The server's own credentials are used regardless of the client (OAuth) credentials (ignore_client_credentials)
+	if m.config.IsClientCredentialsIgnored() {
+		return m.kubernetes, nil
+	}
 	authorization, ok := ctx.Value(OAuthAuthorizationHeader).(string)
 	if !ok || !strings.HasPrefix(authorization, "Bearer ") {
 		if m.config.IsRequireOAuth() {
@@ -121,11 +154,13 @@ func (m *Manager) Derived(ctx context.Context) (*Kubernetes, error) {
 		}
 		return m.kubernetes, nil
 	}
-	klog.V(5).Infof("%s header found (Bearer), using provided bearer token", OAuthAuthorizationHeader)
+	logger := klog.FromContext(ctx)
+	logger.V(5).Info(fmt.Sprintf("%s header found (Bearer), using provided bearer token", OAuthAuthorizationHeader))
 	derivedCfg := &rest.Config{
 		Host:          m.kubernetes.RESTConfig().Host,
 		APIPath:       m.kubernetes.RESTConfig().APIPath,
 		WrapTransport: m.kubernetes.RESTConfig().WrapTransport,
+		Proxy:         m.kubernetes.RESTConfig().Proxy,
 		// Copy only server verification TLS settings (CA bundle and server name)
 		TLSClientConfig: rest.TLSClientConfig{
 			Insecure:   m.kubernetes.RESTConfig().Insecure,
@@ -141,19 +176,27 @@ func (m *Manager) Derived(ctx context.Context) (*Kubernetes, error) {
 		Timeout:     m.kubernetes.RESTConfig().Timeout,
 		Impersonate: rest.ImpersonationConfig{},
 	}
+	// Make sure the propagated token is only sent to the cluster API server (or the explicitly allowed hosts)
+	derivedCfg.Wrap(newAuthorizationHostGuard(derivedCfg.Host, m.config.GetAuthHeaderPropagationAllowedHosts()))
 	clientCmdApiConfig, err := m.kubernetes.clientCmdConfig.RawConfig()
 	if err != nil {
 		if m.config.IsRequireOAuth() {
-			klog.Errorf("failed to get kubeconfig: %v", err)
+			logger.Error(err, "failed to get kubeconfig")
 			return nil, fmt.Errorf("failed to get kubeconfig: %w", err)
 		}
 		return m.kubernetes, nil
 	}
 	clientCmdApiConfig.AuthInfos = make(map[string]*clientcmdapi.AuthInfo)
-	derived, err := NewKubernetes(m.config, clientcmd.NewDefaultClientConfig(clientCmdApiConfig, nil), derivedCfg)
+	// Reuse the underlying transport (connections) for the target, credentials are layered on top for each derived client
+	baseTransport, err := derivedTransports.get(derivedCfg)
+	if err != nil {
+		logger.V(3).Info(fmt.Sprintf("unable to reuse transport for derived client, creating a new one: %v", err))
+		baseTransport = nil
+	}
+	derived, err := newKubernetes(m.config, clientcmd.NewDefaultClientConfig(clientCmdApiConfig, nil), derivedCfg, baseTransport)
 	if err != nil {
 		if m.config.IsRequireOAuth() {
-			klog.Errorf("failed to create derived client: %v", err)
+			logger.Error(err, "failed to create derived client")
 			return nil, fmt.Errorf("failed to create derived client: %w", err)
 		}
 		return m.kubernetes, nil
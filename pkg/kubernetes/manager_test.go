@@ -1,6 +1,9 @@
 package kubernetes
 
 import (
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -228,6 +231,53 @@ func (s *ManagerTestSuite) TestNewManager() {
 	})
 }
 
+func (s *ManagerTestSuite) TestNewManagerWithProxyURL() {
+	s.mockServer.Handle(test.NewDiscoveryClientHandler())
+	proxied := make(chan string, 10)
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		proxied <- req.URL.String()
+		req.RequestURI = ""
+		res, err := http.DefaultTransport.RoundTrip(req)
+		if err != nil {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		defer func() { _ = res.Body.Close() }()
+		for k, v := range res.Header {
+			w.Header()[k] = v
+		}
+		w.WriteHeader(res.StatusCode)
+		_, _ = io.Copy(w, res.Body)
+	}))
+	s.T().Cleanup(proxy.Close)
+	manager, err := NewKubeconfigManager(&config.StaticConfig{
+		KubeConfig: s.mockServer.KubeconfigFile(s.T()),
+		ProxyURL:   proxy.URL,
+	}, "")
+	s.Require().NoError(err)
+	s.Run("sets the proxy in the rest config", func() {
+		s.NotNil(manager.kubernetes.RESTConfig().Proxy)
+	})
+	s.Run("routes the requests through the proxy", func() {
+		_, err := manager.kubernetes.DiscoveryClient().ServerGroups()
+		s.Require().NoError(err)
+		select {
+		case u := <-proxied:
+			s.Contains(u, s.mockServer.Config().Host)
+		default:
+			s.Fail("expected the request to be routed through the proxy")
+		}
+	})
+	s.Run("with invalid proxy URL returns error", func() {
+		_, err := NewKubeconfigManager(&config.StaticConfig{
+			KubeConfig: s.mockServer.KubeconfigFile(s.T()),
+			ProxyURL:   "http://invalid proxy:3128",
+		}, "")
+		s.Require().Error(err)
+		s.ErrorContains(err, "invalid proxy URL")
+	})
+}
+
 func TestManager(t *testing.T) {
 	suite.Run(t, new(ManagerTestSuite))
 }
@@ -0,0 +1,66 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/metrics/pkg/apis/metrics"
+)
+
+// metricsGroupVersion returns the group/version of the metrics API to query: the configured one, or the
+// discovery-preferred version of the metrics.k8s.io group if none is configured.
+// Returns ErrMetricsUnavailable if the metrics API is not served by the cluster.
+func (c *Core) metricsGroupVersion() (schema.GroupVersion, error) {
+	if configured := c.MetricsGroupVersion(); configured != "" {
+		gv, err := schema.ParseGroupVersion(configured)
+		if err != nil {
+			return schema.GroupVersion{}, fmt.Errorf("invalid metrics group version %s: %w", configured, err)
+		}
+		if !c.supportsGroupVersion(gv.String()) {
+			return schema.GroupVersion{}, fmt.Errorf("%w (%s)", ErrMetricsUnavailable, gv.String())
+		}
+		return gv, nil
+	}
+	groups, err := c.DiscoveryClient().ServerGroups()
+	if err != nil {
+		return schema.GroupVersion{}, ErrMetricsUnavailable
+	}
+	for _, group := range groups.Groups {
+		if group.Name == metrics.GroupName && group.PreferredVersion.Version != "" {
+			return schema.GroupVersion{Group: metrics.GroupName, Version: group.PreferredVersion.Version}, nil
+		}
+	}
+	return schema.GroupVersion{}, ErrMetricsUnavailable
+}
+
+// metricsResource returns the dynamic client for the provided metrics resource (pods or nodes) of the provided group/version.
+func (c *Core) metricsResource(gv schema.GroupVersion, resource, namespace string) dynamic.ResourceInterface {
+	resourceInterface := c.DynamicClient().Resource(gv.WithResource(resource))
+	if namespace != "" {
+		return resourceInterface.Namespace(namespace)
+	}
+	return resourceInterface
+}
+
+// metricsGet retrieves a single metrics object and converts it into the provided (versioned) metrics type.
+// The metrics types are structurally identical across the metrics API versions.
+func (c *Core) metricsGet(ctx context.Context, gv schema.GroupVersion, resource, namespace, name string, into any) error {
+	obj, err := c.metricsResource(gv, resource, namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), into)
+}
+
+// metricsList lists the metrics objects and converts them into the provided (versioned) metrics list type.
+func (c *Core) metricsList(ctx context.Context, gv schema.GroupVersion, resource, namespace string, options metav1.ListOptions, into any) error {
+	list, err := c.metricsResource(gv, resource, namespace).List(ctx, options)
+	if err != nil {
+		return err
+	}
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(list.UnstructuredContent(), into)
+}
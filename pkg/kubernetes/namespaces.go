@@ -2,8 +2,13 @@ package kubernetes
 
 import (
 	"context"
+	"errors"
+	"slices"
+	"sort"
+	"strings"
 
 	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
@@ -19,3 +24,92 @@ func (c *Core) ProjectsList(ctx context.Context, options api.ListOptions) (runti
 		Group: "project.openshift.io", Version: "v1", Kind: "Project",
 	}, "", options)
 }
+
+// NamespacesDiffEntry is the inventory of a resource kind in the two compared namespaces.
+type NamespacesDiffEntry struct {
+	APIVersion string
+	Kind       string
+	CountA     int
+	CountB     int
+	// OnlyInA are the names of the resources present in namespace A but not in namespace B
+	OnlyInA []string
+	// OnlyInB are the names of the resources present in namespace B but not in namespace A
+	OnlyInB []string
+}
+
+// NamespacesDiff compares the resource inventories of the two provided namespaces.
+// Every namespaced resource kind (preferred version) that can be listed is enumerated, denied resources are skipped.
+// Only the kinds with at least one resource in either namespace are returned, along with the kinds that couldn't be listed.
+func (c *Core) NamespacesDiff(ctx context.Context, namespaceA, namespaceB string) ([]NamespacesDiffEntry, []string, error) {
+	resourceLists, err := c.DiscoveryClient().ServerPreferredNamespacedResources()
+	if err != nil && len(resourceLists) == 0 {
+		return nil, nil, err
+	}
+	ret := make([]NamespacesDiffEntry, 0)
+	var skipped []string
+	for _, resourceList := range resourceLists {
+		gv, err := schema.ParseGroupVersion(resourceList.GroupVersion)
+		if err != nil {
+			continue
+		}
+		for _, resource := range resourceList.APIResources {
+			// Skip subresources, non-listable resources, and events (they are ephemeral and would only add noise)
+			if strings.Contains(resource.Name, "/") || !slices.Contains(resource.Verbs, "list") || resource.Kind == "Event" {
+				continue
+			}
+			gvr := gv.WithResource(resource.Name)
+			namesA, errA := c.resourceNames(ctx, gvr, namespaceA)
+			namesB, errB := c.resourceNames(ctx, gvr, namespaceB)
+			if errors.Is(errA, ErrResourceNotAllowed) || errors.Is(errB, ErrResourceNotAllowed) {
+				continue
+			}
+			if errA != nil || errB != nil {
+				skipped = append(skipped, gv.WithKind(resource.Kind).String())
+				continue
+			}
+			if len(namesA) == 0 && len(namesB) == 0 {
+				continue
+			}
+			ret = append(ret, NamespacesDiffEntry{
+				APIVersion: gv.String(),
+				Kind:       resource.Kind,
+				CountA:     len(namesA),
+				CountB:     len(namesB),
+				OnlyInA:    difference(namesA, namesB),
+				OnlyInB:    difference(namesB, namesA),
+			})
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].APIVersion != ret[j].APIVersion {
+			return ret[i].APIVersion < ret[j].APIVersion
+		}
+		return ret[i].Kind < ret[j].Kind
+	})
+	return ret, skipped, nil
+}
+
+// resourceNames returns the sorted names of the resources of the provided type in the provided namespace.
+func (c *Core) resourceNames(ctx context.Context, gvr schema.GroupVersionResource, namespace string) ([]string, error) {
+	list, err := c.DynamicClient().Resource(gvr).Namespace(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(list.Items))
+	for _, item := range list.Items {
+		names = append(names, item.GetName())
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// difference returns the elements of a that are not in b.
+func difference(a, b []string) []string {
+	ret := make([]string, 0)
+	for _, s := range a {
+		if !slices.Contains(b, s) {
+			ret = append(ret, s)
+		}
+	}
+	return ret
+}
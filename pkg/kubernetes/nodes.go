@@ -2,11 +2,14 @@ package kubernetes
 
 import (
 	"context"
-	"errors"
+	"encoding/json"
 	"fmt"
 
 	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/metrics/pkg/apis/metrics"
 	metricsv1beta1api "k8s.io/metrics/pkg/apis/metrics/v1beta1"
 )
@@ -70,25 +73,127 @@ func (c *Core) NodesStatsSummary(ctx context.Context, name string) (string, erro
 	return string(rawData), nil
 }
 
+// NodesConfig returns the active configuration of the kubelet of the provided node, queried from the kubelet's
+// /configz endpoint via the node proxy.
+func (c *Core) NodesConfig(ctx context.Context, name string) (map[string]any, error) {
+	if _, err := c.CoreV1().Nodes().Get(ctx, name, metav1.GetOptions{}); err != nil {
+		return nil, fmt.Errorf("failed to get node %s: %w", name, err)
+	}
+
+	result := c.CoreV1().RESTClient().
+		Get().
+		AbsPath("api", "v1", "nodes", name, "proxy", "configz").
+		Do(ctx)
+	if err := result.Error(); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("the kubelet configuration endpoint (/configz) is not available on node %s, "+
+				"it might be disabled (enableDebuggingHandlers: false): %w", name, err)
+		}
+		return nil, fmt.Errorf("failed to get node configuration: %w", err)
+	}
+
+	rawData, err := result.Raw()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read node configuration response: %w", err)
+	}
+	configz := struct {
+		KubeletConfig map[string]any `json:"kubeletconfig"`
+	}{}
+	if err = json.Unmarshal(rawData, &configz); err != nil {
+		return nil, fmt.Errorf("failed to parse node configuration response: %w", err)
+	}
+	if configz.KubeletConfig == nil {
+		return nil, fmt.Errorf("failed to parse node configuration response: missing kubeletconfig")
+	}
+	return configz.KubeletConfig, nil
+}
+
 func (c *Core) NodesTop(ctx context.Context, options api.NodesTopOptions) (*metrics.NodeMetricsList, error) {
 	// TODO, maybe move to mcp Tools setup and omit in case metrics aren't available in the target cluster
-	if !c.supportsGroupVersion(metrics.GroupName + "/" + metricsv1beta1api.SchemeGroupVersion.Version) {
-		return nil, errors.New("metrics API is not available")
+	gv, err := c.metricsGroupVersion()
+	if err != nil {
+		return nil, err
 	}
 	versionedMetrics := &metricsv1beta1api.NodeMetricsList{}
-	var err error
 	if options.Name != "" {
-		m, err := c.MetricsV1beta1Client().NodeMetricses().Get(ctx, options.Name, metav1.GetOptions{})
-		if err != nil {
+		m := metricsv1beta1api.NodeMetrics{}
+		if err = c.metricsGet(ctx, gv, "nodes", "", options.Name, &m); err != nil {
 			return nil, fmt.Errorf("failed to get metrics for node %s: %w", options.Name, err)
 		}
-		versionedMetrics.Items = []metricsv1beta1api.NodeMetrics{*m}
-	} else {
-		versionedMetrics, err = c.MetricsV1beta1Client().NodeMetricses().List(ctx, options.ListOptions)
-		if err != nil {
-			return nil, fmt.Errorf("failed to list node metrics: %w", err)
-		}
+		versionedMetrics.Items = []metricsv1beta1api.NodeMetrics{m}
+	} else if err = c.metricsList(ctx, gv, "nodes", "", options.ListOptions, versionedMetrics); err != nil {
+		return nil, fmt.Errorf("failed to list node metrics: %w", err)
 	}
 	convertedMetrics := &metrics.NodeMetricsList{}
 	return convertedMetrics, metricsv1beta1api.Convert_v1beta1_NodeMetricsList_To_metrics_NodeMetricsList(versionedMetrics, convertedMetrics, nil)
 }
+
+// NodesDescribe returns an aggregated view of the provided node including its conditions, taints,
+// capacity and allocatable resources, system info, and the pods scheduled on it.
+// If listPods is false, only the number of scheduled pods is included.
+func (c *Core) NodesDescribe(ctx context.Context, name string, listPods bool) (map[string]any, error) {
+	node, err := c.CoreV1().Nodes().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get node %s: %w", name, err)
+	}
+	pods, err := c.CoreV1().Pods("").List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.nodeName", name).String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods for node %s: %w", name, err)
+	}
+	conditions := make([]map[string]any, 0, len(node.Status.Conditions))
+	for _, condition := range node.Status.Conditions {
+		conditions = append(conditions, map[string]any{
+			"Type":               string(condition.Type),
+			"Status":             string(condition.Status),
+			"Reason":             condition.Reason,
+			"Message":            condition.Message,
+			"LastTransitionTime": condition.LastTransitionTime.String(),
+		})
+	}
+	taints := make([]string, 0, len(node.Spec.Taints))
+	for _, taint := range node.Spec.Taints {
+		taints = append(taints, taint.ToString())
+	}
+	podsSection := map[string]any{
+		"Count": len(pods.Items),
+	}
+	if listPods {
+		podItems := make([]map[string]any, 0, len(pods.Items))
+		for _, pod := range pods.Items {
+			podItems = append(podItems, map[string]any{
+				"Namespace": pod.Namespace,
+				"Name":      pod.Name,
+				"Phase":     string(pod.Status.Phase),
+			})
+		}
+		podsSection["Items"] = podItems
+	}
+	return map[string]any{
+		"Name":          node.Name,
+		"Labels":        node.Labels,
+		"Unschedulable": node.Spec.Unschedulable,
+		"Taints":        taints,
+		"Conditions":    conditions,
+		"Capacity":      resourceListToStrings(node.Status.Capacity),
+		"Allocatable":   resourceListToStrings(node.Status.Allocatable),
+		"NodeInfo": map[string]string{
+			"Architecture":            node.Status.NodeInfo.Architecture,
+			"ContainerRuntimeVersion": node.Status.NodeInfo.ContainerRuntimeVersion,
+			"KernelVersion":           node.Status.NodeInfo.KernelVersion,
+			"KubeletVersion":          node.Status.NodeInfo.KubeletVersion,
+			"OperatingSystem":         node.Status.NodeInfo.OperatingSystem,
+			"OSImage":                 node.Status.NodeInfo.OSImage,
+		},
+		"Pods": podsSection,
+	}, nil
+}
+
+func resourceListToStrings(resources v1.ResourceList) map[string]string {
+	ret := make(map[string]string, len(resources))
+	for name, quantity := range resources {
+		ret[string(name)] = quantity.String()
+	}
+	return ret
+}
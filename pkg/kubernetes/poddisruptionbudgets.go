@@ -0,0 +1,60 @@
+package kubernetes
+
+import (
+	"context"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// PodDisruptionBudgetStatus is a summary of the disruption status of a PodDisruptionBudget.
+type PodDisruptionBudgetStatus struct {
+	Namespace string
+	Name      string
+	// MinAvailable is the minimum number (or percentage) of available Pods, empty if not set
+	MinAvailable string
+	// MaxUnavailable is the maximum number (or percentage) of unavailable Pods, empty if not set
+	MaxUnavailable     string
+	CurrentHealthy     int32
+	DesiredHealthy     int32
+	ExpectedPods       int32
+	DisruptionsAllowed int32
+}
+
+// PodDisruptionBudgetsList returns the min available or max unavailable setting, the current and desired healthy Pods,
+// and the allowed disruptions of the PodDisruptionBudgets in the provided namespace (or all namespaces).
+func (c *Core) PodDisruptionBudgetsList(ctx context.Context, namespace string, allNamespaces bool) ([]PodDisruptionBudgetStatus, error) {
+	pdbs, err := c.ResourcesList(ctx, &schema.GroupVersionKind{
+		Group: "policy", Version: "v1", Kind: "PodDisruptionBudget",
+	}, c.listNamespace(namespace, allNamespaces), api.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]PodDisruptionBudgetStatus, 0)
+	err = pdbs.EachListItem(func(obj runtime.Object) error {
+		pdb := &policyv1.PodDisruptionBudget{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.(*unstructured.Unstructured).Object, pdb); err != nil {
+			return err
+		}
+		status := PodDisruptionBudgetStatus{
+			Namespace:          pdb.Namespace,
+			Name:               pdb.Name,
+			CurrentHealthy:     pdb.Status.CurrentHealthy,
+			DesiredHealthy:     pdb.Status.DesiredHealthy,
+			ExpectedPods:       pdb.Status.ExpectedPods,
+			DisruptionsAllowed: pdb.Status.DisruptionsAllowed,
+		}
+		if pdb.Spec.MinAvailable != nil {
+			status.MinAvailable = pdb.Spec.MinAvailable.String()
+		}
+		if pdb.Spec.MaxUnavailable != nil {
+			status.MaxUnavailable = pdb.Spec.MaxUnavailable.String()
+		}
+		ret = append(ret, status)
+		return nil
+	})
+	return ret, err
+}
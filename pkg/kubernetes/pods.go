@@ -5,21 +5,30 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	labelutil "k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/httpstream"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/remotecommand"
 	"k8s.io/metrics/pkg/apis/metrics"
 	metricsv1beta1api "k8s.io/metrics/pkg/apis/metrics/v1beta1"
 	"k8s.io/utils/ptr"
 
+	"golang.org/x/sync/errgroup"
+
 	"github.com/containers/kubernetes-mcp-server/pkg/api"
 	"github.com/containers/kubernetes-mcp-server/pkg/version"
 )
@@ -27,6 +36,14 @@ import (
 // DefaultTailLines is the default number of lines to retrieve from the end of the logs
 const DefaultTailLines = int64(100)
 
+// ErrPreviousContainerNotFound is returned when the logs of the previous terminated container instance are requested
+// but the container has not been restarted (terminated) yet
+var ErrPreviousContainerNotFound = errors.New("previous terminated container not found")
+
+// DefaultContainerAnnotation is the annotation used (e.g. by kubectl) to select the default container of a Pod
+// when no container is specified
+const DefaultContainerAnnotation = "kubectl.kubernetes.io/default-container"
+
 func (c *Core) PodsListInAllNamespaces(ctx context.Context, options api.ListOptions) (runtime.Unstructured, error) {
 	return c.ResourcesList(ctx, &schema.GroupVersionKind{
 		Group: "", Version: "v1", Kind: "Pod",
@@ -91,23 +108,34 @@ func (c *Core) PodsDelete(ctx context.Context, namespace, name string) (string,
 func (c *Core) PodsLog(ctx context.Context, namespace, name, container string, previous bool, tail int64) (string, error) {
 	pods := c.CoreV1().Pods(c.NamespaceOrDefault(namespace))
 
+	// Honor the default container annotation, otherwise let the API server validate the (empty) container
+	if container == "" {
+		if pod, err := pods.Get(ctx, name, metav1.GetOptions{}); err == nil {
+			container = podDefaultContainer(pod)
+		}
+	}
+
 	logOptions := &v1.PodLogOptions{
 		Container: container,
 		Previous:  previous,
 	}
 
-	// Only set tailLines if a value is provided (non-zero)
+	// Only set tailLines if a value is provided (non-zero), negative values retrieve all the logs
 	if tail > 0 {
 		logOptions.TailLines = &tail
-	} else {
+	} else if tail == 0 {
 		// Default to DefaultTailLines lines when not specified
 		logOptions.TailLines = ptr.To(DefaultTailLines)
 	}
 
 	req := pods.GetLogs(name, logOptions)
 	res := req.Do(ctx)
-	if res.Error() != nil {
-		return "", res.Error()
+	if err := res.Error(); err != nil {
+		// The API server responds with a 400 "previous terminated container "x" in pod "y" not found" error
+		if previous && apierrors.IsBadRequest(err) && strings.Contains(err.Error(), "previous terminated container") {
+			return "", fmt.Errorf("%w: %v", ErrPreviousContainerNotFound, err)
+		}
+		return "", err
 	}
 	rawData, err := res.Raw()
 	if err != nil {
@@ -198,8 +226,9 @@ func (c *Core) PodsRun(ctx context.Context, namespace, name, image string, port
 
 func (c *Core) PodsTop(ctx context.Context, options api.PodsTopOptions) (*metrics.PodMetricsList, error) {
 	// TODO, maybe move to mcp Tools setup and omit in case metrics aren't available in the target cluster
-	if !c.supportsGroupVersion(metrics.GroupName + "/" + metricsv1beta1api.SchemeGroupVersion.Version) {
-		return nil, errors.New("metrics API is not available")
+	gv, err := c.metricsGroupVersion()
+	if err != nil {
+		return nil, err
 	}
 	namespace := options.Namespace
 	if options.AllNamespaces && namespace == "" {
@@ -207,24 +236,114 @@ func (c *Core) PodsTop(ctx context.Context, options api.PodsTopOptions) (*metric
 	} else {
 		namespace = c.NamespaceOrDefault(namespace)
 	}
-	var err error
 	versionedMetrics := &metricsv1beta1api.PodMetricsList{}
 	if options.Name != "" {
-		m, err := c.MetricsV1beta1Client().PodMetricses(namespace).Get(ctx, options.Name, metav1.GetOptions{})
-		if err != nil {
+		m := metricsv1beta1api.PodMetrics{}
+		if err = c.metricsGet(ctx, gv, "pods", namespace, options.Name, &m); err != nil {
 			return nil, fmt.Errorf("failed to get metrics for pod %s/%s: %w", namespace, options.Name, err)
 		}
-		versionedMetrics.Items = []metricsv1beta1api.PodMetrics{*m}
-	} else {
-		versionedMetrics, err = c.MetricsV1beta1Client().PodMetricses(namespace).List(ctx, options.ListOptions)
+		versionedMetrics.Items = []metricsv1beta1api.PodMetrics{m}
+	} else if namespace == "" && options.Concurrency > 0 {
+		versionedMetrics, err = c.podsTopAllNamespaces(ctx, gv, options)
 		if err != nil {
-			return nil, fmt.Errorf("failed to list pod metrics in namespace %s: %w", namespace, err)
+			return nil, err
 		}
+	} else if err = c.metricsList(ctx, gv, "pods", namespace, options.ListOptions, versionedMetrics); err != nil {
+		return nil, fmt.Errorf("failed to list pod metrics in namespace %s: %w", namespace, err)
 	}
 	convertedMetrics := &metrics.PodMetricsList{}
 	return convertedMetrics, metricsv1beta1api.Convert_v1beta1_PodMetricsList_To_metrics_PodMetricsList(versionedMetrics, convertedMetrics, nil)
 }
 
+// podsTopAllNamespaces lists the pod metrics of every namespace with one request per namespace,
+// performing at most options.Concurrency requests at a time.
+// The results are aggregated in the order of the namespaces list regardless of the completion order.
+func (c *Core) podsTopAllNamespaces(ctx context.Context, gv schema.GroupVersion, options api.PodsTopOptions) (*metricsv1beta1api.PodMetricsList, error) {
+	namespaces, err := c.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list namespaces: %w", err)
+	}
+	results := make([][]metricsv1beta1api.PodMetrics, len(namespaces.Items))
+	g, gCtx := errgroup.WithContext(ctx)
+	g.SetLimit(options.Concurrency)
+	for i, namespace := range namespaces.Items {
+		g.Go(func() error {
+			m := &metricsv1beta1api.PodMetricsList{}
+			if err := c.metricsList(gCtx, gv, "pods", namespace.Name, options.ListOptions, m); err != nil {
+				return fmt.Errorf("failed to list pod metrics in namespace %s: %w", namespace.Name, err)
+			}
+			results[i] = m.Items
+			return nil
+		})
+	}
+	if err = g.Wait(); err != nil {
+		return nil, err
+	}
+	ret := &metricsv1beta1api.PodMetricsList{}
+	for _, items := range results {
+		ret.Items = append(ret.Items, items...)
+	}
+	return ret, nil
+}
+
+// ContainerResourceUsage is the current resource usage of a Pod container alongside its resource requests and limits.
+type ContainerResourceUsage struct {
+	Namespace string
+	Pod       string
+	Container string
+	// Usage is nil if there are no metrics available for the container
+	Usage    v1.ResourceList
+	Requests v1.ResourceList
+	Limits   v1.ResourceList
+}
+
+// PodsUsageVsRequests returns the current resource usage (from the metrics API) of the containers of the matching Pods
+// alongside the resource requests and limits defined in their spec.
+func (c *Core) PodsUsageVsRequests(ctx context.Context, options api.PodsTopOptions) ([]ContainerResourceUsage, error) {
+	podMetrics, err := c.PodsTop(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+	namespace := options.Namespace
+	if !options.AllNamespaces || namespace != "" {
+		namespace = c.NamespaceOrDefault(namespace)
+	}
+	var pods []v1.Pod
+	if options.Name != "" {
+		pod, err := c.CoreV1().Pods(namespace).Get(ctx, options.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pod %s/%s: %w", namespace, options.Name, err)
+		}
+		pods = []v1.Pod{*pod}
+	} else {
+		podList, err := c.CoreV1().Pods(namespace).List(ctx, options.ListOptions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list pods in namespace %s: %w", namespace, err)
+		}
+		pods = podList.Items
+	}
+	usages := make(map[string]v1.ResourceList)
+	for _, m := range podMetrics.Items {
+		for _, container := range m.Containers {
+			usages[m.Namespace+"/"+m.Name+"/"+container.Name] = container.Usage
+		}
+	}
+	ret := make([]ContainerResourceUsage, 0, len(pods))
+	for _, pod := range pods {
+		for _, container := range pod.Spec.Containers {
+			ret = append(ret, ContainerResourceUsage{
+				Namespace: pod.Namespace,
+				Pod:       pod.Name,
+				Container: container.Name,
+				Usage:     usages[pod.Namespace+"/"+pod.Name+"/"+container.Name],
+				Requests:  container.Resources.Requests,
+				Limits:    container.Resources.Limits,
+			})
+		}
+	}
+	return ret, nil
+}
+
 func (c *Core) PodsExec(ctx context.Context, namespace, name, container string, command []string) (string, error) {
 	namespace = c.NamespaceOrDefault(namespace)
 	pods := c.CoreV1().Pods(namespace)
@@ -236,6 +355,9 @@ func (c *Core) PodsExec(ctx context.Context, namespace, name, container string,
 	if pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed {
 		return "", fmt.Errorf("cannot exec into a container in a completed pod; current phase is %s", pod.Status.Phase)
 	}
+	if container == "" {
+		container = podDefaultContainer(pod)
+	}
 	if container == "" {
 		container = pod.Spec.Containers[0].Name
 	}
@@ -287,3 +409,854 @@ func (c *Core) PodsExec(ctx context.Context, namespace, name, container string,
 	}
 	return "", nil
 }
+
+// DefaultDebugImage is the default image used for the ephemeral debug containers
+const DefaultDebugImage = "busybox"
+
+// podsDebugRunningTimeout is the maximum time to wait for an ephemeral debug container to be running before executing a command in it
+const podsDebugRunningTimeout = 1 * time.Minute
+
+// PodsDebug adds an ephemeral debug container with the provided image to the provided Pod.
+// If targetContainer is provided, the debug container shares the process namespace of the target container.
+// If command is provided, once the debug container is running, the command is executed in it and its output returned.
+// Returns the name of the debug container and the output of the command (if any).
+func (c *Core) PodsDebug(ctx context.Context, namespace, name, image, targetContainer string, command []string) (string, string, error) {
+	namespace = c.NamespaceOrDefault(namespace)
+	if image == "" {
+		image = DefaultDebugImage
+	}
+	pods := c.CoreV1().Pods(namespace)
+	pod, err := pods.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", "", err
+	}
+	if pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed {
+		return "", "", fmt.Errorf("cannot debug a completed pod; current phase is %s", pod.Status.Phase)
+	}
+	debugContainer := v1.EphemeralContainer{EphemeralContainerCommon: v1.EphemeralContainerCommon{
+		Name:                     "debugger-" + rand.String(5),
+		Image:                    image,
+		ImagePullPolicy:          v1.PullIfNotPresent,
+		Stdin:                    true,
+		TTY:                      true,
+		TerminationMessagePolicy: v1.TerminationMessageReadFile,
+	}, TargetContainerName: targetContainer}
+	pod.Spec.EphemeralContainers = append(pod.Spec.EphemeralContainers, debugContainer)
+	_, err = pods.UpdateEphemeralContainers(ctx, name, pod, metav1.UpdateOptions{})
+	if apierrors.IsNotFound(err) || apierrors.IsMethodNotSupported(err) {
+		return "", "", fmt.Errorf("ephemeral containers are disabled or not supported in this cluster: %w", err)
+	} else if err != nil {
+		return "", "", err
+	}
+	if len(command) == 0 {
+		return debugContainer.Name, "", nil
+	}
+	err = wait.PollUntilContextTimeout(ctx, time.Second, podsDebugRunningTimeout, true, func(ctx context.Context) (bool, error) {
+		p, err := pods.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		for _, status := range p.Status.EphemeralContainerStatuses {
+			if status.Name == debugContainer.Name && status.State.Terminated != nil {
+				return false, fmt.Errorf("debug container %s terminated: %s", debugContainer.Name, status.State.Terminated.Reason)
+			}
+			if status.Name == debugContainer.Name && status.State.Running != nil {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+	if err != nil {
+		return debugContainer.Name, "", fmt.Errorf("debug container %s is not running: %w", debugContainer.Name, err)
+	}
+	output, err := c.PodsExec(ctx, namespace, name, debugContainer.Name, command)
+	return debugContainer.Name, output, err
+}
+
+// PodsScheduling returns the QoS class and the scheduling details of the Pod with the provided name, including the node
+// it's scheduled on, its node selector, affinity rules, tolerations, and conditions.
+// For Pods that haven't been scheduled yet, the reason and message of the PodScheduled condition explain why.
+func (c *Core) PodsScheduling(ctx context.Context, namespace, name string) (map[string]any, error) {
+	pod, err := c.CoreV1().Pods(c.NamespaceOrDefault(namespace)).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	qosClass := pod.Status.QOSClass
+	if qosClass == "" {
+		qosClass = podQOSClass(pod)
+	}
+	tolerations := make([]string, 0, len(pod.Spec.Tolerations))
+	for _, toleration := range pod.Spec.Tolerations {
+		tolerations = append(tolerations, tolerationToString(toleration))
+	}
+	conditions := make([]map[string]string, 0, len(pod.Status.Conditions))
+	for _, condition := range pod.Status.Conditions {
+		conditions = append(conditions, map[string]string{
+			"Type":    string(condition.Type),
+			"Status":  string(condition.Status),
+			"Reason":  condition.Reason,
+			"Message": condition.Message,
+		})
+	}
+	scheduling := map[string]any{
+		"Name":         pod.Name,
+		"Namespace":    pod.Namespace,
+		"Phase":        string(pod.Status.Phase),
+		"QoSClass":     string(qosClass),
+		"NodeName":     pod.Spec.NodeName,
+		"NodeSelector": pod.Spec.NodeSelector,
+		"Affinity":     affinityToStrings(pod.Spec.Affinity),
+		"Tolerations":  tolerations,
+		"Conditions":   conditions,
+	}
+	if pod.Spec.SchedulerName != "" {
+		scheduling["SchedulerName"] = pod.Spec.SchedulerName
+	}
+	if pod.Spec.PriorityClassName != "" {
+		scheduling["PriorityClassName"] = pod.Spec.PriorityClassName
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == v1.PodScheduled && condition.Status != v1.ConditionTrue {
+			scheduling["Unschedulable"] = map[string]string{"Reason": condition.Reason, "Message": condition.Message}
+		}
+	}
+	return scheduling, nil
+}
+
+// ContainerProbes are the liveness, readiness, and startup probes configured for a container of a Pod.
+type ContainerProbes struct {
+	Container string
+	// InitContainer is true for the (sidecar) init containers
+	InitContainer bool `json:",omitempty"`
+	Ready         bool
+	RestartCount  int32
+	Liveness      *ProbeSummary `json:",omitempty"`
+	Readiness     *ProbeSummary `json:",omitempty"`
+	Startup       *ProbeSummary `json:",omitempty"`
+}
+
+// ProbeSummary summarizes the handler and the thresholds of a container probe.
+type ProbeSummary struct {
+	// Type is the type of the probe handler (HTTPGet, TCPSocket, Exec, or GRPC)
+	Type string
+	// Handler describes what the probe checks (e.g. "GET http://:8080/healthz", "tcp://:5432", or "cat /tmp/healthy")
+	Handler             string
+	InitialDelaySeconds int32
+	PeriodSeconds       int32
+	TimeoutSeconds      int32
+	SuccessThreshold    int32
+	FailureThreshold    int32
+}
+
+// PodsProbes returns the liveness, readiness, and startup probes of the containers of the Pod with the provided name,
+// along with their readiness and restart count. Init containers are only included if they define any probe (sidecars).
+func (c *Core) PodsProbes(ctx context.Context, namespace, name string) ([]ContainerProbes, error) {
+	pod, err := c.CoreV1().Pods(c.NamespaceOrDefault(namespace)).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	statuses := make(map[string]v1.ContainerStatus, len(pod.Status.ContainerStatuses)+len(pod.Status.InitContainerStatuses))
+	for _, status := range slices.Concat(pod.Status.ContainerStatuses, pod.Status.InitContainerStatuses) {
+		statuses[status.Name] = status
+	}
+	ret := make([]ContainerProbes, 0, len(pod.Spec.Containers))
+	for _, container := range pod.Spec.InitContainers {
+		if container.LivenessProbe == nil && container.ReadinessProbe == nil && container.StartupProbe == nil {
+			continue
+		}
+		ret = append(ret, containerProbes(container, statuses[container.Name], true))
+	}
+	for _, container := range pod.Spec.Containers {
+		ret = append(ret, containerProbes(container, statuses[container.Name], false))
+	}
+	return ret, nil
+}
+
+func containerProbes(container v1.Container, status v1.ContainerStatus, initContainer bool) ContainerProbes {
+	return ContainerProbes{
+		Container:     container.Name,
+		InitContainer: initContainer,
+		Ready:         status.Ready,
+		RestartCount:  status.RestartCount,
+		Liveness:      probeSummary(container.LivenessProbe),
+		Readiness:     probeSummary(container.ReadinessProbe),
+		Startup:       probeSummary(container.StartupProbe),
+	}
+}
+
+// probeSummary summarizes the provided probe, applying the API server defaults to the unset thresholds
+func probeSummary(probe *v1.Probe) *ProbeSummary {
+	if probe == nil {
+		return nil
+	}
+	ret := &ProbeSummary{
+		InitialDelaySeconds: probe.InitialDelaySeconds,
+		PeriodSeconds:       defaultIfZero(probe.PeriodSeconds, 10),
+		TimeoutSeconds:      defaultIfZero(probe.TimeoutSeconds, 1),
+		SuccessThreshold:    defaultIfZero(probe.SuccessThreshold, 1),
+		FailureThreshold:    defaultIfZero(probe.FailureThreshold, 3),
+	}
+	switch {
+	case probe.HTTPGet != nil:
+		ret.Type = "HTTPGet"
+		scheme := strings.ToLower(string(probe.HTTPGet.Scheme))
+		if scheme == "" {
+			scheme = "http"
+		}
+		ret.Handler = fmt.Sprintf("GET %s://%s:%s%s", scheme, probe.HTTPGet.Host, probe.HTTPGet.Port.String(), probe.HTTPGet.Path)
+	case probe.TCPSocket != nil:
+		ret.Type = "TCPSocket"
+		ret.Handler = fmt.Sprintf("tcp://%s:%s", probe.TCPSocket.Host, probe.TCPSocket.Port.String())
+	case probe.Exec != nil:
+		ret.Type = "Exec"
+		ret.Handler = strings.Join(probe.Exec.Command, " ")
+	case probe.GRPC != nil:
+		ret.Type = "GRPC"
+		ret.Handler = fmt.Sprintf("grpc://:%d", probe.GRPC.Port)
+		if service := ptr.Deref(probe.GRPC.Service, ""); service != "" {
+			ret.Handler += " service=" + service
+		}
+	}
+	return ret
+}
+
+func defaultIfZero(value, defaultValue int32) int32 {
+	if value == 0 {
+		return defaultValue
+	}
+	return value
+}
+
+// PodSecurityContext is the effective security context of a Pod and its containers.
+type PodSecurityContext struct {
+	HostNetwork        bool    `json:",omitempty"`
+	HostPID            bool    `json:",omitempty"`
+	HostIPC            bool    `json:",omitempty"`
+	FSGroup            *int64  `json:",omitempty"`
+	SupplementalGroups []int64 `json:",omitempty"`
+	Containers         []ContainerSecurityContext
+}
+
+// ContainerSecurityContext is the effective security context of a container, the container-level settings take
+// precedence over the Pod-level ones. Settings unset at both levels are omitted (the container runtime defaults apply).
+type ContainerSecurityContext struct {
+	Container string
+	// InitContainer is true for the init containers
+	InitContainer            bool   `json:",omitempty"`
+	RunAsUser                *int64 `json:",omitempty"`
+	RunAsGroup               *int64 `json:",omitempty"`
+	RunAsNonRoot             *bool  `json:",omitempty"`
+	Privileged               bool
+	AllowPrivilegeEscalation *bool `json:",omitempty"`
+	ReadOnlyRootFilesystem   bool
+	CapabilitiesAdded        []v1.Capability `json:",omitempty"`
+	CapabilitiesDropped      []v1.Capability `json:",omitempty"`
+	// SeccompProfile is the type of the seccomp profile (RuntimeDefault, Unconfined, or Localhost/<profile>)
+	SeccompProfile string `json:",omitempty"`
+}
+
+// PodsSecurityContext returns the effective security context of the Pod with the provided name and of each of its
+// (init) containers, merging the Pod-level security context into the container-level ones.
+func (c *Core) PodsSecurityContext(ctx context.Context, namespace, name string) (*PodSecurityContext, error) {
+	pod, err := c.CoreV1().Pods(c.NamespaceOrDefault(namespace)).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	podSecurityContext := ptr.Deref(pod.Spec.SecurityContext, v1.PodSecurityContext{})
+	ret := &PodSecurityContext{
+		HostNetwork:        pod.Spec.HostNetwork,
+		HostPID:            pod.Spec.HostPID,
+		HostIPC:            pod.Spec.HostIPC,
+		FSGroup:            podSecurityContext.FSGroup,
+		SupplementalGroups: podSecurityContext.SupplementalGroups,
+		Containers:         make([]ContainerSecurityContext, 0, len(pod.Spec.InitContainers)+len(pod.Spec.Containers)),
+	}
+	for _, container := range pod.Spec.InitContainers {
+		ret.Containers = append(ret.Containers, containerSecurityContext(podSecurityContext, container, true))
+	}
+	for _, container := range pod.Spec.Containers {
+		ret.Containers = append(ret.Containers, containerSecurityContext(podSecurityContext, container, false))
+	}
+	return ret, nil
+}
+
+func containerSecurityContext(podSecurityContext v1.PodSecurityContext, container v1.Container, initContainer bool) ContainerSecurityContext {
+	securityContext := ptr.Deref(container.SecurityContext, v1.SecurityContext{})
+	ret := ContainerSecurityContext{
+		Container:                container.Name,
+		InitContainer:            initContainer,
+		RunAsUser:                podSecurityContext.RunAsUser,
+		RunAsGroup:               podSecurityContext.RunAsGroup,
+		RunAsNonRoot:             podSecurityContext.RunAsNonRoot,
+		Privileged:               ptr.Deref(securityContext.Privileged, false),
+		AllowPrivilegeEscalation: securityContext.AllowPrivilegeEscalation,
+		ReadOnlyRootFilesystem:   ptr.Deref(securityContext.ReadOnlyRootFilesystem, false),
+		SeccompProfile:           seccompProfile(podSecurityContext.SeccompProfile),
+	}
+	if securityContext.RunAsUser != nil {
+		ret.RunAsUser = securityContext.RunAsUser
+	}
+	if securityContext.RunAsGroup != nil {
+		ret.RunAsGroup = securityContext.RunAsGroup
+	}
+	if securityContext.RunAsNonRoot != nil {
+		ret.RunAsNonRoot = securityContext.RunAsNonRoot
+	}
+	if securityContext.SeccompProfile != nil {
+		ret.SeccompProfile = seccompProfile(securityContext.SeccompProfile)
+	}
+	if securityContext.Capabilities != nil {
+		ret.CapabilitiesAdded = securityContext.Capabilities.Add
+		ret.CapabilitiesDropped = securityContext.Capabilities.Drop
+	}
+	return ret
+}
+
+func seccompProfile(profile *v1.SeccompProfile) string {
+	if profile == nil {
+		return ""
+	}
+	if profile.Type == v1.SeccompProfileTypeLocalhost {
+		return fmt.Sprintf("%s/%s", profile.Type, ptr.Deref(profile.LocalhostProfile, ""))
+	}
+	return string(profile.Type)
+}
+
+// PodDiagnosis is the diagnostic bundle of a Pod, aggregating the information usually collected to triage a failing Pod.
+type PodDiagnosis struct {
+	// Status contains the phase, conditions, and container statuses (including the last restart reason) of the Pod
+	Status map[string]any
+	// Events are the events of the Pod in chronological order (oldest first)
+	Events []map[string]any
+	// EventsError describes why the events couldn't be retrieved (e.g. Events are denied)
+	EventsError string
+	Logs        []PodDiagnosisLogs
+}
+
+// PodDiagnosisLogs is the tail of the logs of a Pod container.
+type PodDiagnosisLogs struct {
+	Container string
+	// Previous is true if the logs belong to the previous terminated instance of the container
+	Previous bool
+	Logs     string
+	// Error describes why the logs couldn't be retrieved (e.g. the container hasn't started yet)
+	Error string
+}
+
+// PodsDiagnose gathers a diagnostic bundle for the Pod with the provided name: its status and conditions, the last
+// restart reason of its containers, its events, and the tail of the logs of its containers (also of the previous
+// terminated instance of the restarted containers).
+// The events and logs are best-effort, failures to retrieve them are reported in the bundle.
+func (c *Core) PodsDiagnose(ctx context.Context, namespace, name string, tail int64) (*PodDiagnosis, error) {
+	namespace = c.NamespaceOrDefault(namespace)
+	podGvk := &schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Pod"}
+	obj, err := c.ResourcesGet(ctx, podGvk, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	pod := &v1.Pod{}
+	if err = runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, pod); err != nil {
+		return nil, err
+	}
+	diagnosis := &PodDiagnosis{Status: podDiagnosisStatus(pod)}
+	if diagnosis.Events, err = c.ResourcesEvents(ctx, podGvk, namespace, name); err != nil {
+		diagnosis.EventsError = err.Error()
+	}
+	restartCounts := make(map[string]int32, len(pod.Status.ContainerStatuses))
+	for _, status := range pod.Status.ContainerStatuses {
+		restartCounts[status.Name] = status.RestartCount
+	}
+	for _, container := range pod.Spec.Containers {
+		diagnosis.Logs = append(diagnosis.Logs, c.podDiagnosisLogs(ctx, namespace, name, container.Name, false, tail))
+		if restartCounts[container.Name] > 0 {
+			diagnosis.Logs = append(diagnosis.Logs, c.podDiagnosisLogs(ctx, namespace, name, container.Name, true, tail))
+		}
+	}
+	return diagnosis, nil
+}
+
+func (c *Core) podDiagnosisLogs(ctx context.Context, namespace, name, container string, previous bool, tail int64) PodDiagnosisLogs {
+	logs := PodDiagnosisLogs{Container: container, Previous: previous}
+	ret, err := c.PodsLog(ctx, namespace, name, container, previous, tail)
+	if err != nil {
+		logs.Error = err.Error()
+	} else {
+		logs.Logs = ret
+	}
+	return logs
+}
+
+func podDiagnosisStatus(pod *v1.Pod) map[string]any {
+	conditions := make([]map[string]string, 0, len(pod.Status.Conditions))
+	for _, condition := range pod.Status.Conditions {
+		conditions = append(conditions, map[string]string{
+			"Type":    string(condition.Type),
+			"Status":  string(condition.Status),
+			"Reason":  condition.Reason,
+			"Message": condition.Message,
+		})
+	}
+	status := map[string]any{
+		"Name":       pod.Name,
+		"Namespace":  pod.Namespace,
+		"Phase":      string(pod.Status.Phase),
+		"NodeName":   pod.Spec.NodeName,
+		"Conditions": conditions,
+		"Containers": containerStatusesSummary(pod.Status.ContainerStatuses),
+	}
+	if pod.Status.Reason != "" {
+		status["Reason"] = pod.Status.Reason
+	}
+	if pod.Status.Message != "" {
+		status["Message"] = pod.Status.Message
+	}
+	if len(pod.Status.InitContainerStatuses) > 0 {
+		status["InitContainers"] = containerStatusesSummary(pod.Status.InitContainerStatuses)
+	}
+	return status
+}
+
+// containerStatusesSummary summarizes the state and the last termination (restart reason) of the provided containers.
+func containerStatusesSummary(statuses []v1.ContainerStatus) []map[string]any {
+	ret := make([]map[string]any, 0, len(statuses))
+	for _, status := range statuses {
+		summary := map[string]any{
+			"Name":         status.Name,
+			"Ready":        status.Ready,
+			"RestartCount": status.RestartCount,
+			"State":        containerStateToString(status.State),
+		}
+		if terminated := status.LastTerminationState.Terminated; terminated != nil {
+			summary["LastTermination"] = containerStateToString(status.LastTerminationState)
+			if !terminated.FinishedAt.IsZero() {
+				summary["LastTerminationTime"] = terminated.FinishedAt.UTC().Format(time.RFC3339)
+			}
+		}
+		ret = append(ret, summary)
+	}
+	return ret
+}
+
+// containerStateToString summarizes a ContainerState (e.g. "Waiting: CrashLoopBackOff", "Terminated: Error (exit code 1)")
+func containerStateToString(state v1.ContainerState) string {
+	var ret string
+	switch {
+	case state.Running != nil:
+		return "Running"
+	case state.Waiting != nil:
+		ret = "Waiting: " + state.Waiting.Reason
+		if state.Waiting.Message != "" {
+			ret += " - " + state.Waiting.Message
+		}
+	case state.Terminated != nil:
+		ret = fmt.Sprintf("Terminated: %s (exit code %d)", state.Terminated.Reason, state.Terminated.ExitCode)
+		if state.Terminated.Message != "" {
+			ret += " - " + state.Terminated.Message
+		}
+	default:
+		ret = "Unknown"
+	}
+	return ret
+}
+
+// PodRestarts is the restart count of a Pod and of each of its (init) containers.
+type PodRestarts struct {
+	Namespace  string
+	Name       string
+	Restarts   int32
+	Containers []ContainerRestarts
+}
+
+// ContainerRestarts is the restart count of a Pod container and the details of its last termination.
+type ContainerRestarts struct {
+	Name     string
+	Restarts int32
+	// LastTermination is the reason and exit code of the last termination of the container (e.g. "OOMKilled (exit code 137)"),
+	// empty if the container has never been terminated
+	LastTermination     string
+	LastTerminationTime time.Time
+}
+
+// PodsRestarts returns the restart counts of the Pods in all namespaces, the provided namespace, or the current
+// namespace, sorted by restart count (the most restarted first), with the restart count and the last termination
+// of each of their containers.
+func (c *Core) PodsRestarts(ctx context.Context, namespace string, allNamespaces bool, labelSelector string) ([]PodRestarts, error) {
+	list, err := c.ResourcesList(ctx, &schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Pod"},
+		c.listNamespace(namespace, allNamespaces), api.ListOptions{ListOptions: metav1.ListOptions{LabelSelector: labelSelector}})
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]PodRestarts, 0)
+	err = list.EachListItem(func(obj runtime.Object) error {
+		pod := &v1.Pod{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.(*unstructured.Unstructured).Object, pod); err != nil {
+			return err
+		}
+		podRestarts := PodRestarts{Namespace: pod.Namespace, Name: pod.Name}
+		for _, status := range append(append([]v1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...) {
+			containerRestarts := ContainerRestarts{Name: status.Name, Restarts: status.RestartCount}
+			if terminated := status.LastTerminationState.Terminated; terminated != nil {
+				containerRestarts.LastTermination = fmt.Sprintf("%s (exit code %d)", terminated.Reason, terminated.ExitCode)
+				containerRestarts.LastTerminationTime = terminated.FinishedAt.Time
+			}
+			podRestarts.Restarts += status.RestartCount
+			podRestarts.Containers = append(podRestarts.Containers, containerRestarts)
+		}
+		ret = append(ret, podRestarts)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		return ret[i].Restarts > ret[j].Restarts
+	})
+	return ret, nil
+}
+
+// PodOwnerKinds are the kinds of the owners supported by PodsByOwner (Deployments own their Pods through ReplicaSets).
+var PodOwnerKinds = []string{"Deployment", "ReplicaSet", "StatefulSet", "DaemonSet", "Job"}
+
+// OwnedPod is a Pod controlled by the owner provided to PodsByOwner.
+type OwnedPod struct {
+	Name string
+	// Owner is the controller of the Pod (e.g. ReplicaSet/web-5d8f9c7b4)
+	Owner    string
+	Status   string
+	Ready    string
+	Restarts int32
+	Node     string
+}
+
+// PodsByOwner returns the Pods controlled by the owner of the provided kind and name, resolved via their
+// ownerReferences. For Deployments, the Pods controlled by the ReplicaSets of the Deployment are returned.
+func (c *Core) PodsByOwner(ctx context.Context, kind, namespace, name string) ([]OwnedPod, error) {
+	if !slices.Contains(PodOwnerKinds, kind) {
+		return nil, fmt.Errorf("unsupported owner kind %s, supported kinds are: %s", kind, strings.Join(PodOwnerKinds, ", "))
+	}
+	gvk := WorkloadKinds[kind]
+	namespace = c.NamespaceOrDefault(namespace)
+	owner, err := c.ResourcesGet(ctx, &gvk, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	// UIDs of the controllers of the Pods mapped to their Kind/Name
+	controllers := map[types.UID]string{owner.GetUID(): kind + "/" + name}
+	if kind == "Deployment" {
+		replicaSetGvk := WorkloadKinds["ReplicaSet"]
+		replicaSets, err := c.ResourcesList(ctx, &replicaSetGvk, namespace, api.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		controllers = make(map[types.UID]string)
+		err = replicaSets.EachListItem(func(obj runtime.Object) error {
+			replicaSet := obj.(*unstructured.Unstructured)
+			if ref := metav1.GetControllerOf(replicaSet); ref != nil && ref.UID == owner.GetUID() {
+				controllers[replicaSet.GetUID()] = "ReplicaSet/" + replicaSet.GetName()
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	pods, err := c.ResourcesList(ctx, &schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Pod"}, namespace, api.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]OwnedPod, 0)
+	err = pods.EachListItem(func(obj runtime.Object) error {
+		ref := metav1.GetControllerOf(obj.(*unstructured.Unstructured))
+		if ref == nil {
+			return nil
+		}
+		controller, ok := controllers[ref.UID]
+		if !ok {
+			return nil
+		}
+		pod := &v1.Pod{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.(*unstructured.Unstructured).Object, pod); err != nil {
+			return err
+		}
+		ownedPod := OwnedPod{Name: pod.Name, Owner: controller, Status: podStatus(pod), Node: pod.Spec.NodeName}
+		ready := 0
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.Ready {
+				ready++
+			}
+			ownedPod.Restarts += status.RestartCount
+		}
+		ownedPod.Ready = fmt.Sprintf("%d/%d", ready, len(pod.Spec.Containers))
+		ret = append(ret, ownedPod)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		return ret[i].Name < ret[j].Name
+	})
+	return ret, nil
+}
+
+// PodsDistribution is the distribution of a set of Pods across the nodes of the cluster.
+type PodsDistribution struct {
+	// Nodes are the nodes running at least one of the Pods, the most loaded first
+	Nodes []NodePods
+	// Unscheduled is the number of Pods not scheduled to any node yet
+	Unscheduled int
+	// MaxSkew is the difference between the number of Pods of the most and the least loaded nodes
+	MaxSkew int
+}
+
+// NodePods are the Pods scheduled to a node.
+type NodePods struct {
+	Node string
+	// Pods are the namespace/name of the Pods scheduled to the node
+	Pods []string
+}
+
+// PodsDistribution returns how the Pods matching the provided label selector in all namespaces, the provided namespace,
+// or the current namespace are distributed across the nodes. Completed (Succeeded or Failed) Pods are not taken into account.
+func (c *Core) PodsDistribution(ctx context.Context, namespace string, allNamespaces bool, labelSelector string) (*PodsDistribution, error) {
+	list, err := c.ResourcesList(ctx, &schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Pod"},
+		c.listNamespace(namespace, allNamespaces), api.ListOptions{ListOptions: metav1.ListOptions{LabelSelector: labelSelector}})
+	if err != nil {
+		return nil, err
+	}
+	ret := &PodsDistribution{Nodes: make([]NodePods, 0)}
+	nodePods := make(map[string][]string)
+	err = list.EachListItem(func(obj runtime.Object) error {
+		pod := &v1.Pod{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.(*unstructured.Unstructured).Object, pod); err != nil {
+			return err
+		}
+		if pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed {
+			return nil
+		}
+		if pod.Spec.NodeName == "" {
+			ret.Unscheduled++
+			return nil
+		}
+		nodePods[pod.Spec.NodeName] = append(nodePods[pod.Spec.NodeName], pod.Namespace+"/"+pod.Name)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for node, pods := range nodePods {
+		slices.Sort(pods)
+		ret.Nodes = append(ret.Nodes, NodePods{Node: node, Pods: pods})
+	}
+	sort.SliceStable(ret.Nodes, func(i, j int) bool {
+		if len(ret.Nodes[i].Pods) != len(ret.Nodes[j].Pods) {
+			return len(ret.Nodes[i].Pods) > len(ret.Nodes[j].Pods)
+		}
+		return ret.Nodes[i].Node < ret.Nodes[j].Node
+	})
+	if len(ret.Nodes) > 0 {
+		ret.MaxSkew = len(ret.Nodes[0].Pods) - len(ret.Nodes[len(ret.Nodes)-1].Pods)
+	}
+	return ret, nil
+}
+
+// PodPhases are the phases supported by PodsByPhase.
+var PodPhases = []string{"Pending", "Running", "Succeeded", "Failed", "Unknown"}
+
+// PhasePod is a Pod in the phase provided to PodsByPhase, with the reason it's in that phase.
+type PhasePod struct {
+	Namespace string
+	Name      string
+	Node      string
+	// Reason is the machine-readable reason of the phase (e.g. Unschedulable, ImagePullBackOff, Evicted), empty if unknown
+	Reason  string
+	Message string
+}
+
+// PodsByPhase returns the Pods in the provided phase in all namespaces, the provided namespace, or the current namespace,
+// with the reason and message explaining the phase (e.g. why a Pod is Pending or Failed).
+func (c *Core) PodsByPhase(ctx context.Context, phase, namespace string, allNamespaces bool) ([]PhasePod, error) {
+	if !slices.Contains(PodPhases, phase) {
+		return nil, fmt.Errorf("unsupported phase %s, supported phases are: %s", phase, strings.Join(PodPhases, ", "))
+	}
+	list, err := c.ResourcesList(ctx, &schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Pod"},
+		c.listNamespace(namespace, allNamespaces), api.ListOptions{ListOptions: metav1.ListOptions{FieldSelector: "status.phase=" + phase}})
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]PhasePod, 0)
+	err = list.EachListItem(func(obj runtime.Object) error {
+		pod := &v1.Pod{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.(*unstructured.Unstructured).Object, pod); err != nil {
+			return err
+		}
+		reason, message := podPhaseReason(pod)
+		ret = append(ret, PhasePod{Namespace: pod.Namespace, Name: pod.Name, Node: pod.Spec.NodeName, Reason: reason, Message: message})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// podPhaseReason returns the reason and message explaining the phase of the Pod: the Pod status reason (e.g. Evicted),
+// the scheduling failure (e.g. Unschedulable), or the first waiting or failed (init) container
+func podPhaseReason(pod *v1.Pod) (string, string) {
+	if pod.Status.Reason != "" {
+		return pod.Status.Reason, pod.Status.Message
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == v1.PodScheduled && condition.Status == v1.ConditionFalse {
+			return condition.Reason, condition.Message
+		}
+	}
+	for _, status := range append(append([]v1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...) {
+		if waiting := status.State.Waiting; waiting != nil && waiting.Reason != "" {
+			message := "container " + status.Name + " is waiting"
+			if waiting.Message != "" {
+				message += ": " + waiting.Message
+			}
+			return waiting.Reason, message
+		}
+		if terminated := status.State.Terminated; terminated != nil && terminated.ExitCode != 0 {
+			message := fmt.Sprintf("container %s exited with code %d", status.Name, terminated.ExitCode)
+			if terminated.Message != "" {
+				message += ": " + terminated.Message
+			}
+			return terminated.Reason, message
+		}
+	}
+	return "", ""
+}
+
+// podStatus returns the status of the Pod as displayed by kubectl get pods (e.g. Running, CrashLoopBackOff, Terminating)
+func podStatus(pod *v1.Pod) string {
+	if pod.DeletionTimestamp != nil {
+		return "Terminating"
+	}
+	status := string(pod.Status.Phase)
+	if pod.Status.Reason != "" {
+		status = pod.Status.Reason
+	}
+	for _, containerStatus := range pod.Status.ContainerStatuses {
+		if containerStatus.State.Waiting != nil && containerStatus.State.Waiting.Reason != "" {
+			return containerStatus.State.Waiting.Reason
+		}
+		if containerStatus.State.Terminated != nil && containerStatus.State.Terminated.Reason != "" {
+			status = containerStatus.State.Terminated.Reason
+		}
+	}
+	if status == "" {
+		return string(v1.PodUnknown)
+	}
+	return status
+}
+
+// podDefaultContainer returns the container selected by the DefaultContainerAnnotation of the provided Pod,
+// or an empty string if the annotation is not set or doesn't match any of the Pod containers.
+func podDefaultContainer(pod *v1.Pod) string {
+	name := pod.Annotations[DefaultContainerAnnotation]
+	if name == "" {
+		return ""
+	}
+	for _, container := range pod.Spec.Containers {
+		if container.Name == name {
+			return name
+		}
+	}
+	return ""
+}
+
+// podQOSClass computes the QoS class of the provided Pod from its container resources
+// (used when the QoS class is not reported in the Pod status).
+func podQOSClass(pod *v1.Pod) v1.PodQOSClass {
+	containers := append(append([]v1.Container{}, pod.Spec.InitContainers...), pod.Spec.Containers...)
+	guaranteed, bestEffort := true, true
+	for _, container := range containers {
+		for _, resource := range []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory} {
+			request, hasRequest := container.Resources.Requests[resource]
+			limit, hasLimit := container.Resources.Limits[resource]
+			if hasRequest || hasLimit {
+				bestEffort = false
+			}
+			if !hasLimit || (hasRequest && request.Cmp(limit) != 0) {
+				guaranteed = false
+			}
+		}
+	}
+	switch {
+	case bestEffort:
+		return v1.PodQOSBestEffort
+	case guaranteed:
+		return v1.PodQOSGuaranteed
+	default:
+		return v1.PodQOSBurstable
+	}
+}
+
+// tolerationToString returns a human-readable representation of the provided toleration (as in kubectl describe).
+func tolerationToString(toleration v1.Toleration) string {
+	ret := toleration.Key
+	if toleration.Key == "" && toleration.Operator == v1.TolerationOpExists {
+		ret = "op=Exists"
+	} else if toleration.Value != "" {
+		ret += "=" + toleration.Value
+	}
+	if toleration.Effect != "" {
+		ret += ":" + string(toleration.Effect)
+	}
+	if toleration.TolerationSeconds != nil {
+		ret += fmt.Sprintf(" for %ds", *toleration.TolerationSeconds)
+	}
+	return ret
+}
+
+// affinityToStrings returns a human-readable summary of the node affinity, pod affinity, and pod anti-affinity rules.
+func affinityToStrings(affinity *v1.Affinity) []string {
+	ret := make([]string, 0)
+	if affinity == nil {
+		return ret
+	}
+	if na := affinity.NodeAffinity; na != nil {
+		if na.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+			for _, term := range na.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms {
+				ret = append(ret, "NodeAffinity (required): "+nodeSelectorTermToString(term))
+			}
+		}
+		for _, term := range na.PreferredDuringSchedulingIgnoredDuringExecution {
+			ret = append(ret, fmt.Sprintf("NodeAffinity (preferred, weight %d): %s", term.Weight, nodeSelectorTermToString(term.Preference)))
+		}
+	}
+	podAffinityTerms := func(kind string, required []v1.PodAffinityTerm, preferred []v1.WeightedPodAffinityTerm) {
+		for _, term := range required {
+			ret = append(ret, fmt.Sprintf("%s (required): %s", kind, podAffinityTermToString(term)))
+		}
+		for _, term := range preferred {
+			ret = append(ret, fmt.Sprintf("%s (preferred, weight %d): %s", kind, term.Weight, podAffinityTermToString(term.PodAffinityTerm)))
+		}
+	}
+	if pa := affinity.PodAffinity; pa != nil {
+		podAffinityTerms("PodAffinity", pa.RequiredDuringSchedulingIgnoredDuringExecution, pa.PreferredDuringSchedulingIgnoredDuringExecution)
+	}
+	if paa := affinity.PodAntiAffinity; paa != nil {
+		podAffinityTerms("PodAntiAffinity", paa.RequiredDuringSchedulingIgnoredDuringExecution, paa.PreferredDuringSchedulingIgnoredDuringExecution)
+	}
+	return ret
+}
+
+func nodeSelectorTermToString(term v1.NodeSelectorTerm) string {
+	requirements := make([]string, 0, len(term.MatchExpressions)+len(term.MatchFields))
+	for _, requirement := range append(append([]v1.NodeSelectorRequirement{}, term.MatchExpressions...), term.MatchFields...) {
+		requirements = append(requirements, strings.TrimSpace(fmt.Sprintf("%s %s %s", requirement.Key, requirement.Operator, strings.Join(requirement.Values, ","))))
+	}
+	return strings.Join(requirements, ", ")
+}
+
+func podAffinityTermToString(term v1.PodAffinityTerm) string {
+	ret := "topologyKey=" + term.TopologyKey
+	if term.LabelSelector != nil {
+		ret += " selector=" + metav1.FormatLabelSelector(term.LabelSelector)
+	}
+	return ret
+}
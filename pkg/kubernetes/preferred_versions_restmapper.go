@@ -0,0 +1,61 @@
+package kubernetes
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// newPreferredVersionsRESTMapper returns a meta.ResettableRESTMapper that resolves the mappings for the API groups
+// in preferredVersions using the configured version instead of the discovery-preferred one.
+// Explicitly requested versions are honored only if they include the configured version, otherwise the mapping fails.
+// The delegate is returned as is if no preferred versions are configured.
+func newPreferredVersionsRESTMapper(delegate meta.ResettableRESTMapper, preferredVersions map[string]string) meta.ResettableRESTMapper {
+	if len(preferredVersions) == 0 {
+		return delegate
+	}
+	return &preferredVersionsRESTMapper{
+		ResettableRESTMapper: delegate,
+		preferredVersions:    preferredVersions,
+	}
+}
+
+type preferredVersionsRESTMapper struct {
+	meta.ResettableRESTMapper
+	preferredVersions map[string]string
+}
+
+var _ meta.ResettableRESTMapper = (*preferredVersionsRESTMapper)(nil)
+
+func (m *preferredVersionsRESTMapper) RESTMapping(gk schema.GroupKind, versions ...string) (*meta.RESTMapping, error) {
+	versions, err := m.versionsFor(gk.Group, versions)
+	if err != nil {
+		return nil, err
+	}
+	return m.ResettableRESTMapper.RESTMapping(gk, versions...)
+}
+
+func (m *preferredVersionsRESTMapper) RESTMappings(gk schema.GroupKind, versions ...string) ([]*meta.RESTMapping, error) {
+	versions, err := m.versionsFor(gk.Group, versions)
+	if err != nil {
+		return nil, err
+	}
+	return m.ResettableRESTMapper.RESTMappings(gk, versions...)
+}
+
+func (m *preferredVersionsRESTMapper) versionsFor(group string, versions []string) ([]string, error) {
+	preferredVersion, ok := m.preferredVersions[group]
+	if !ok || preferredVersion == "" {
+		return versions, nil
+	}
+	// Ignore the empty versions (the delegate treats them as not provided)
+	versions = slices.DeleteFunc(slices.Clone(versions), func(version string) bool { return version == "" })
+	if len(versions) > 0 && !slices.Contains(versions, preferredVersion) {
+		return nil, fmt.Errorf("version %s of API group %q is not supported, the group is pinned to version %s (preferred_versions)",
+			strings.Join(versions, ", "), group, preferredVersion)
+	}
+	return []string{preferredVersion}, nil
+}
@@ -0,0 +1,87 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+type PreferredVersionsRESTMapperTestSuite struct {
+	suite.Suite
+	delegate meta.ResettableRESTMapper
+}
+
+// resettableRESTMapper is a meta.ResettableRESTMapper for a static meta.RESTMapper
+type resettableRESTMapper struct {
+	meta.RESTMapper
+}
+
+func (r *resettableRESTMapper) Reset() {}
+
+func (s *PreferredVersionsRESTMapperTestSuite) SetupTest() {
+	// v1 is the preferred version for the example.com group (first in the list)
+	mapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{
+		{Group: "example.com", Version: "v1"},
+		{Group: "example.com", Version: "v1beta1"},
+	})
+	mapper.Add(schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Widget"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Group: "example.com", Version: "v1beta1", Kind: "Widget"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Gadget"}, meta.RESTScopeNamespace)
+	s.delegate = &resettableRESTMapper{RESTMapper: mapper}
+}
+
+func (s *PreferredVersionsRESTMapperTestSuite) TestWithoutPreferredVersions() {
+	s.Run("returns delegate", func() {
+		s.Same(s.delegate, newPreferredVersionsRESTMapper(s.delegate, nil))
+	})
+	s.Run("resolves discovery-preferred version", func() {
+		mapping, err := newPreferredVersionsRESTMapper(s.delegate, nil).RESTMapping(schema.GroupKind{Group: "example.com", Kind: "Widget"})
+		s.Require().NoError(err)
+		s.Equal("v1", mapping.Resource.Version)
+	})
+}
+
+func (s *PreferredVersionsRESTMapperTestSuite) TestWithPreferredVersions() {
+	mapper := newPreferredVersionsRESTMapper(s.delegate, map[string]string{"example.com": "v1beta1"})
+	s.Run("resolves pinned version when no version is requested", func() {
+		mapping, err := mapper.RESTMapping(schema.GroupKind{Group: "example.com", Kind: "Widget"})
+		s.Require().NoError(err)
+		s.Equal(schema.GroupVersionResource{Group: "example.com", Version: "v1beta1", Resource: "widgets"}, mapping.Resource)
+	})
+	s.Run("resolves pinned version when the pinned version is requested", func() {
+		mapping, err := mapper.RESTMapping(schema.GroupKind{Group: "example.com", Kind: "Widget"}, "v1beta1")
+		s.Require().NoError(err)
+		s.Equal("v1beta1", mapping.Resource.Version)
+	})
+	s.Run("fails when a different version is requested", func() {
+		_, err := mapper.RESTMapping(schema.GroupKind{Group: "example.com", Kind: "Widget"}, "v1")
+		s.Require().Error(err)
+		s.Equal(`version v1 of API group "example.com" is not supported, the group is pinned to version v1beta1 (preferred_versions)`, err.Error())
+	})
+	s.Run("fails when a different version is requested for RESTMappings", func() {
+		_, err := mapper.RESTMappings(schema.GroupKind{Group: "example.com", Kind: "Widget"}, "v1")
+		s.Error(err)
+	})
+	s.Run("resolves pinned version for RESTMappings", func() {
+		mappings, err := mapper.RESTMappings(schema.GroupKind{Group: "example.com", Kind: "Widget"})
+		s.Require().NoError(err)
+		s.Require().Len(mappings, 1)
+		s.Equal("v1beta1", mappings[0].Resource.Version)
+	})
+	s.Run("fails if the kind is not served in the pinned version", func() {
+		_, err := mapper.RESTMapping(schema.GroupKind{Group: "example.com", Kind: "Gadget"})
+		s.Error(err)
+	})
+	s.Run("resolves requested version for other groups", func() {
+		mapping, err := newPreferredVersionsRESTMapper(s.delegate, map[string]string{"other.example.com": "v2"}).
+			RESTMapping(schema.GroupKind{Group: "example.com", Kind: "Widget"}, "v1")
+		s.Require().NoError(err)
+		s.Equal("v1", mapping.Resource.Version)
+	})
+}
+
+func TestPreferredVersionsRESTMapper(t *testing.T) {
+	suite.Run(t, new(PreferredVersionsRESTMapperTestSuite))
+}
@@ -59,7 +59,7 @@ func resolveStrategy(cfg api.BaseConfig) string {
 		return cfg.GetClusterProviderStrategy()
 	}
 
-	if cfg.GetKubeConfigPath() != "" {
+	if cfg.GetKubeConfigPath() != "" || len(cfg.GetKubeConfigPaths()) > 0 {
 		return api.ClusterProviderKubeConfig
 	}
 
@@ -4,10 +4,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path"
+	"path/filepath"
 	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
 
 	"github.com/containers/kubernetes-mcp-server/pkg/api"
 	"github.com/containers/kubernetes-mcp-server/pkg/kubernetes/watcher"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 )
 
 // KubeConfigTargetParameterName is the parameter name used to specify
@@ -18,15 +27,29 @@ const KubeConfigTargetParameterName = "context"
 // Kubernetes clusters using different contexts from a kubeconfig file.
 // It lazily initializes managers for each context as they are requested.
 type kubeConfigClusterProvider struct {
-	config              api.BaseConfig
-	defaultContext      string
-	managers            map[string]*Manager
+	config         api.BaseConfig
+	defaultContext string
+	// managersMu guards the managers, targets and defaultContext (targets might be requested concurrently with a
+	// kubeconfig reload replacing them)
+	managersMu sync.Mutex
+	managers   map[string]*Manager
+	// targets maps each target to its kubeconfig file and context when multiple kubeconfig files are configured
+	targets map[string]kubeConfigTarget
+	// targetsConfig is the kubeconfig view of the targets (one context per target) returned by the RawConfig of the
+	// managers, nil if the managers use the RawConfig of their own kubeconfig file
+	targetsConfig       *clientcmdapi.Config
 	kubeconfigWatcher   *watcher.Kubeconfig
 	clusterStateWatcher *watcher.ClusterState
 }
 
 var _ Provider = &kubeConfigClusterProvider{}
 
+// kubeConfigTarget identifies a context within a specific kubeconfig file.
+type kubeConfigTarget struct {
+	path    string
+	context string
+}
+
 func init() {
 	RegisterProvider(api.ClusterProviderKubeConfig, newKubeConfigClusterProvider)
 }
@@ -44,6 +67,10 @@ func newKubeConfigClusterProvider(cfg api.BaseConfig) (Provider, error) {
 }
 
 func (p *kubeConfigClusterProvider) reset() error {
+	if len(p.config.GetKubeConfigPaths()) > 0 {
+		return p.resetMultipleKubeconfigs()
+	}
+
 	m, err := NewKubeconfigManager(p.config, "")
 	if err != nil {
 		if errors.Is(err, ErrorKubeconfigInClusterNotAllowed) {
@@ -57,7 +84,14 @@ func (p *kubeConfigClusterProvider) reset() error {
 		return err
 	}
 
-	p.managers = map[string]*Manager{
+	if p.isExcluded(rawConfig.CurrentContext) {
+		return fmt.Errorf("the current context %s can't be excluded, it's the default target", rawConfig.CurrentContext)
+	}
+
+	targetsConfig := p.withoutExcludedContexts(rawConfig)
+	withTargetsConfig(m, targetsConfig)
+
+	managers := map[string]*Manager{
 		rawConfig.CurrentContext: m, // we already initialized a manager for the default context, let's use it
 	}
 
@@ -65,40 +99,280 @@ func (p *kubeConfigClusterProvider) reset() error {
 		if name == rawConfig.CurrentContext {
 			continue // already initialized this, don't want to set it to nil
 		}
-		p.managers[name] = nil
+		if p.isExcluded(name) {
+			continue
+		}
+		managers[name] = nil
 	}
 
 	p.Close()
 	p.kubeconfigWatcher = watcher.NewKubeconfig(m.kubernetes.clientCmdConfig)
 	p.clusterStateWatcher = watcher.NewClusterState(m.kubernetes.DiscoveryClient())
+	p.managersMu.Lock()
+	defer p.managersMu.Unlock()
+	p.managers = managers
+	p.targets = nil
+	p.targetsConfig = targetsConfig
 	p.defaultContext = rawConfig.CurrentContext
 
 	return nil
 }
 
+// resetMultipleKubeconfigs initializes the provider targets from the union of the contexts across all the configured
+// kubeconfig files.
+// Each file is loaded on its own (never merged), so every context is resolved against the clusters and users of its
+// own file. Contexts whose name collides with a context of a previous file are prefixed with the file name
+// (e.g. "cluster-b:kubernetes-admin@kubernetes").
+// The default target is the current context of the first kubeconfig file.
+func (p *kubeConfigClusterProvider) resetMultipleKubeconfigs() error {
+	paths := kubeConfigPaths(p.config)
+	targets := make(map[string]kubeConfigTarget)
+	rawConfigs := make(map[string]*clientcmdapi.Config, len(paths))
+	defaultContext := ""
+	for i, path := range paths {
+		rawConfig, err := clientcmd.LoadFromFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to load kubeconfig file %s: %v", path, err)
+		}
+		rawConfigs[path] = rawConfig
+		contextNames := make([]string, 0, len(rawConfig.Contexts))
+		for name := range rawConfig.Contexts {
+			contextNames = append(contextNames, name)
+		}
+		sort.Strings(contextNames)
+		for _, name := range contextNames {
+			target := name
+			if _, exists := targets[target]; exists {
+				target = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path)) + ":" + name
+			}
+			if _, exists := targets[target]; exists {
+				return fmt.Errorf("context %s in kubeconfig file %s collides with an existing target %s", name, path, target)
+			}
+			if i == 0 && name == rawConfig.CurrentContext {
+				defaultContext = target
+			} else if p.isExcluded(target) {
+				continue
+			}
+			targets[target] = kubeConfigTarget{path: path, context: name}
+		}
+	}
+	if defaultContext == "" {
+		return fmt.Errorf("kubeconfig file %s has no current context", paths[0])
+	}
+	if p.isExcluded(defaultContext) {
+		return fmt.Errorf("the current context %s can't be excluded, it's the default target", defaultContext)
+	}
+
+	m, err := newKubeconfigManagerForPath(p.config, targets[defaultContext].path, targets[defaultContext].context)
+	if err != nil {
+		if errors.Is(err, ErrorKubeconfigInClusterNotAllowed) {
+			return fmt.Errorf("kubeconfig ClusterProviderStrategy is invalid for in-cluster deployments: %v", err)
+		}
+		return err
+	}
+
+	targetsConfig := newTargetsConfig(paths, targets, rawConfigs, defaultContext)
+	withTargetsConfig(m, targetsConfig)
+
+	managers := make(map[string]*Manager, len(targets))
+	for target := range targets {
+		managers[target] = nil
+	}
+	managers[defaultContext] = m
+
+	p.Close()
+	// A single watcher for all the kubeconfig files (GetLoadingPrecedence returns the Precedence paths)
+	p.kubeconfigWatcher = watcher.NewKubeconfig(clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		&clientcmd.ClientConfigLoadingRules{Precedence: paths}, &clientcmd.ConfigOverrides{}))
+	p.clusterStateWatcher = watcher.NewClusterState(m.kubernetes.DiscoveryClient())
+	p.managersMu.Lock()
+	defer p.managersMu.Unlock()
+	p.managers = managers
+	p.targets = targets
+	p.targetsConfig = targetsConfig
+	p.defaultContext = defaultContext
+
+	return nil
+}
+
 func (p *kubeConfigClusterProvider) managerForContext(context string) (*Manager, error) {
+	p.managersMu.Lock()
+	defer p.managersMu.Unlock()
+
+	if p.targets != nil && context == "" {
+		context = p.defaultContext
+	}
+
 	m, ok := p.managers[context]
 	if ok && m != nil {
 		return m, nil
 	}
 
+	if p.isExcluded(context) {
+		return nil, fmt.Errorf("context %q is excluded from the available targets", context)
+	}
+
+	if p.targets != nil {
+		target, found := p.targets[context]
+		if !found {
+			return nil, fmt.Errorf("context %q does not exist in the configured kubeconfig files", context)
+		}
+		m, err := newKubeconfigManagerForPath(p.config, target.path, target.context)
+		if err != nil {
+			return nil, err
+		}
+		withTargetsConfig(m, p.targetsConfig)
+		p.managers[context] = m
+		return m, nil
+	}
+
 	baseManager := p.managers[p.defaultContext]
 
 	m, err := NewKubeconfigManager(baseManager.config, context)
 	if err != nil {
 		return nil, err
 	}
+	withTargetsConfig(m, p.targetsConfig)
 
 	p.managers[context] = m
 
 	return m, nil
 }
 
+// newTargetsConfig builds the kubeconfig view of the targets loaded from multiple kubeconfig files: a context for each
+// target (named after the target) and the clusters and users it references.
+// Clusters and users whose name collides with one of a previous file are prefixed with the file name, the same as the
+// colliding context names.
+func newTargetsConfig(paths []string, targets map[string]kubeConfigTarget, rawConfigs map[string]*clientcmdapi.Config, defaultContext string) *clientcmdapi.Config {
+	targetsConfig := clientcmdapi.NewConfig()
+	targetsConfig.CurrentContext = defaultContext
+	clusterPaths := make(map[string]string)
+	authInfoPaths := make(map[string]string)
+	names := make([]string, 0, len(targets))
+	for name := range targets {
+		names = append(names, name)
+	}
+	// Sorted by kubeconfig file (in the configured order) and name, so that the names of the first file are kept
+	sort.SliceStable(names, func(i, j int) bool {
+		pi, pj := slices.Index(paths, targets[names[i]].path), slices.Index(paths, targets[names[j]].path)
+		if pi != pj {
+			return pi < pj
+		}
+		return names[i] < names[j]
+	})
+	for _, name := range names {
+		target := targets[name]
+		rawConfig := rawConfigs[target.path]
+		context := rawConfig.Contexts[target.context].DeepCopy()
+		if cluster, ok := rawConfig.Clusters[context.Cluster]; ok {
+			context.Cluster = addTargetsConfigEntry(targetsConfig.Clusters, clusterPaths, context.Cluster, target.path, cluster.DeepCopy())
+		}
+		if authInfo, ok := rawConfig.AuthInfos[context.AuthInfo]; ok {
+			context.AuthInfo = addTargetsConfigEntry(targetsConfig.AuthInfos, authInfoPaths, context.AuthInfo, target.path, authInfo.DeepCopy())
+		}
+		targetsConfig.Contexts[name] = context
+	}
+	return targetsConfig
+}
+
+// withoutExcludedContexts returns the kubeconfig view of the targets of a single kubeconfig file: the kubeconfig without
+// the excluded contexts and the clusters and users only they reference.
+// Returns nil if no context is excluded (the kubeconfig is shown as is).
+func (p *kubeConfigClusterProvider) withoutExcludedContexts(rawConfig clientcmdapi.Config) *clientcmdapi.Config {
+	targetsConfig := rawConfig.DeepCopy()
+	excluded := false
+	for name := range targetsConfig.Contexts {
+		if name != targetsConfig.CurrentContext && p.isExcluded(name) {
+			delete(targetsConfig.Contexts, name)
+			excluded = true
+		}
+	}
+	if !excluded {
+		return nil
+	}
+	clusters := make(map[string]bool)
+	authInfos := make(map[string]bool)
+	for _, context := range targetsConfig.Contexts {
+		clusters[context.Cluster] = true
+		authInfos[context.AuthInfo] = true
+	}
+	for _, context := range rawConfig.Contexts {
+		if !clusters[context.Cluster] {
+			delete(targetsConfig.Clusters, context.Cluster)
+		}
+		if !authInfos[context.AuthInfo] {
+			delete(targetsConfig.AuthInfos, context.AuthInfo)
+		}
+	}
+	return targetsConfig
+}
+
+// addTargetsConfigEntry adds the named cluster or user loaded from the kubeconfig file at path and returns the name it
+// was added with (prefixed with the file name if another file already added an entry with the same name).
+func addTargetsConfigEntry[T any](entries map[string]T, paths map[string]string, name, path string, entry T) string {
+	if existingPath, exists := paths[name]; exists && existingPath != path {
+		name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path)) + ":" + name
+	}
+	paths[name] = path
+	entries[name] = entry
+	return name
+}
+
+// targetsClientConfig is a clientcmd.ClientConfig whose RawConfig returns the kubeconfig view of the provider targets
+// (so that the excluded contexts and the contexts of the other kubeconfig files are handled the same as by GetTargets),
+// the rest of the methods are served by the ClientConfig of the target kubeconfig file.
+type targetsClientConfig struct {
+	clientConfig  clientcmd.ClientConfig
+	targetsConfig *clientcmdapi.Config
+}
+
+var _ clientcmd.ClientConfig = &targetsClientConfig{}
+
+func (c *targetsClientConfig) RawConfig() (clientcmdapi.Config, error) {
+	return *c.targetsConfig.DeepCopy(), nil
+}
+
+func (c *targetsClientConfig) ClientConfig() (*rest.Config, error) {
+	return c.clientConfig.ClientConfig()
+}
+
+func (c *targetsClientConfig) Namespace() (string, bool, error) {
+	return c.clientConfig.Namespace()
+}
+
+func (c *targetsClientConfig) ConfigAccess() clientcmd.ConfigAccess {
+	return c.clientConfig.ConfigAccess()
+}
+
+// withTargetsConfig makes the configuration (e.g. configuration_contexts_list, configuration_view) of the provided
+// Manager reflect the provider targets instead of its own kubeconfig file.
+func withTargetsConfig(m *Manager, targetsConfig *clientcmdapi.Config) {
+	if targetsConfig == nil {
+		return
+	}
+	m.kubernetes.clientCmdConfig = &targetsClientConfig{clientConfig: m.kubernetes.clientCmdConfig, targetsConfig: targetsConfig}
+}
+
+// isExcluded returns whether the provided context matches any of the configured excluded_contexts names or glob patterns.
+func (p *kubeConfigClusterProvider) isExcluded(context string) bool {
+	for _, pattern := range p.config.GetExcludedContexts() {
+		if matched, err := path.Match(pattern, context); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
 func (p *kubeConfigClusterProvider) IsOpenShift(ctx context.Context) bool {
-	return p.managers[p.defaultContext].IsOpenShift(ctx)
+	p.managersMu.Lock()
+	m := p.managers[p.defaultContext]
+	p.managersMu.Unlock()
+	return m.IsOpenShift(ctx)
 }
 
 func (p *kubeConfigClusterProvider) GetTargets(_ context.Context) ([]string, error) {
+	p.managersMu.Lock()
+	defer p.managersMu.Unlock()
 	contextNames := make([]string, 0, len(p.managers))
 	for contextName := range p.managers {
 		contextNames = append(contextNames, contextName)
@@ -120,6 +394,8 @@ func (p *kubeConfigClusterProvider) GetDerivedKubernetes(ctx context.Context, co
 }
 
 func (p *kubeConfigClusterProvider) GetDefaultTarget() string {
+	p.managersMu.Lock()
+	defer p.managersMu.Unlock()
 	return p.defaultContext
 }
 
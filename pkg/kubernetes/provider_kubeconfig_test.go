@@ -1,12 +1,18 @@
 package kubernetes
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
 	"testing"
 
 	"github.com/containers/kubernetes-mcp-server/internal/test"
 	"github.com/containers/kubernetes-mcp-server/pkg/config"
 	"github.com/stretchr/testify/suite"
+	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 )
 
@@ -67,6 +73,54 @@ func (s *ProviderKubeconfigTestSuite) TestGetTargets() {
 	})
 }
 
+func (s *ProviderKubeconfigTestSuite) TestExcludedContexts() {
+	kubeconfig := s.mockServer.Kubeconfig()
+	for i := 0; i < 10; i++ {
+		kubeconfig.Contexts[fmt.Sprintf("context-%d", i)] = kubeconfig.Contexts["fake-context"].DeepCopy()
+	}
+	kubeconfig.Clusters["production"] = clientcmdapi.NewCluster()
+	kubeconfig.Clusters["production"].Server = "https://production.example.com:6443"
+	kubeconfig.Contexts["context-9"].Cluster = "production"
+	kubeconfigFile := test.KubeconfigFile(s.T(), kubeconfig)
+	provider, err := NewProvider(&config.StaticConfig{
+		KubeConfig:       kubeconfigFile,
+		ExcludedContexts: []string{"context-1", "context-[2-4]", "context-9*"},
+	})
+	s.Require().NoError(err, "Expected no error creating provider with excluded contexts")
+	s.T().Cleanup(provider.Close)
+	s.Run("GetTargets doesn't return the excluded contexts", func() {
+		targets, err := provider.GetTargets(s.T().Context())
+		s.Require().NoError(err, "Expected no error from GetTargets")
+		s.ElementsMatch([]string{"fake-context", "context-0", "context-5", "context-6", "context-7", "context-8"}, targets)
+	})
+	s.Run("GetDerivedKubernetes returns error for excluded context", func() {
+		k8s, err := provider.GetDerivedKubernetes(s.T().Context(), "context-3")
+		s.Require().Error(err, "Expected error from GetDerivedKubernetes with excluded context")
+		s.ErrorContainsf(err, `context "context-3" is excluded`, "Expected context is excluded error, got: %v", err)
+		s.Nil(k8s, "Expected no Kubernetes from GetDerivedKubernetes with excluded context")
+	})
+	s.Run("GetDerivedKubernetes returns Kubernetes for not excluded context", func() {
+		k8s, err := provider.GetDerivedKubernetes(s.T().Context(), "context-5")
+		s.Require().NoError(err, "Expected no error from GetDerivedKubernetes with not excluded context")
+		s.NotNil(k8s, "Expected Kubernetes from GetDerivedKubernetes with not excluded context")
+	})
+	s.Run("RawConfig doesn't return the excluded contexts", func() {
+		k8s, err := provider.GetDerivedKubernetes(s.T().Context(), "context-5")
+		s.Require().NoError(err, "Expected no error from GetDerivedKubernetes")
+		rawConfig, err := k8s.ToRawKubeConfigLoader().RawConfig()
+		s.Require().NoError(err, "Expected no error reading raw config")
+		s.Len(rawConfig.Contexts, 6, "Expected only the not excluded contexts")
+		s.NotContains(rawConfig.Contexts, "context-3")
+		s.NotContains(rawConfig.Clusters, "production", "Expected the cluster of the excluded context to be removed")
+		s.Contains(rawConfig.Clusters, "fake", "Expected the cluster of the not excluded contexts to be kept")
+	})
+	s.Run("excluding the current context returns error", func() {
+		_, err := NewProvider(&config.StaticConfig{KubeConfig: kubeconfigFile, ExcludedContexts: []string{"fake-*"}})
+		s.Require().Error(err, "Expected error creating provider excluding the current context")
+		s.ErrorContains(err, "the current context fake-context can't be excluded")
+	})
+}
+
 func (s *ProviderKubeconfigTestSuite) TestGetDerivedKubernetes() {
 	s.Run("GetDerivedKubernetes returns Kubernetes for valid context", func() {
 		k8s, err := s.provider.GetDerivedKubernetes(s.T().Context(), "fake-context")
@@ -96,6 +150,206 @@ func (s *ProviderKubeconfigTestSuite) TestGetTargetParameterName() {
 	s.Equal("context", s.provider.GetTargetParameterName(), "Expected context as target parameter name")
 }
 
+func (s *ProviderKubeconfigTestSuite) TestMultipleKubeconfigFiles() {
+	kubeconfigDir := s.T().TempDir()
+	clusterA := s.mockServer.Kubeconfig()
+	clusterA.Contexts["cluster-a-admin"] = clusterA.Contexts["fake-context"].DeepCopy()
+	clusterAFile := filepath.Join(kubeconfigDir, "cluster-a.yaml")
+	s.Require().NoError(clientcmd.WriteToFile(*clusterA, clusterAFile))
+	clusterB := s.mockServer.Kubeconfig()
+	clusterB.Contexts["cluster-b-admin"] = clusterB.Contexts["fake-context"].DeepCopy()
+	clusterB.CurrentContext = "cluster-b-admin"
+	clusterBFile := filepath.Join(kubeconfigDir, "cluster-b.yaml")
+	s.Require().NoError(clientcmd.WriteToFile(*clusterB, clusterBFile))
+
+	provider, err := NewProvider(&config.StaticConfig{KubeConfigs: []string{clusterAFile, clusterBFile}})
+	s.Require().NoError(err, "Expected no error creating provider with multiple kubeconfig files")
+	s.T().Cleanup(provider.Close)
+
+	s.Run("uses kubeconfig provider", func() {
+		s.IsType(&kubeConfigClusterProvider{}, provider)
+	})
+	s.Run("GetTargets returns the union of contexts across files", func() {
+		targets, err := provider.GetTargets(s.T().Context())
+		s.Require().NoError(err, "Expected no error from GetTargets")
+		s.ElementsMatch([]string{"fake-context", "cluster-a-admin", "cluster-b-admin", "cluster-b:fake-context"}, targets,
+			"Expected contexts from both kubeconfig files, colliding contexts prefixed with the file name")
+	})
+	s.Run("GetDefaultTarget returns current-context of the first kubeconfig file", func() {
+		s.Equal("fake-context", provider.GetDefaultTarget(), "Expected fake-context as default target")
+	})
+	for _, target := range []string{"fake-context", "cluster-a-admin", "cluster-b-admin", "cluster-b:fake-context", ""} {
+		s.Run("GetDerivedKubernetes returns Kubernetes for target "+target, func() {
+			k8s, err := provider.GetDerivedKubernetes(s.T().Context(), target)
+			s.Require().NoError(err, "Expected no error from GetDerivedKubernetes with target %s", target)
+			s.NotNil(k8s, "Expected Kubernetes from GetDerivedKubernetes with target %s", target)
+		})
+	}
+	s.Run("GetDerivedKubernetes resolves prefixed target to the context of its kubeconfig file", func() {
+		m, err := provider.(*kubeConfigClusterProvider).managerForContext("cluster-b:fake-context")
+		s.Require().NoError(err, "Expected no error resolving prefixed target")
+		s.Require().IsType(&targetsClientConfig{}, m.kubernetes.clientCmdConfig)
+		rawConfig, err := m.kubernetes.clientCmdConfig.(*targetsClientConfig).clientConfig.RawConfig()
+		s.Require().NoError(err, "Expected no error reading raw config")
+		s.Contains(rawConfig.Contexts, "cluster-b-admin", "Expected manager to be loaded from the cluster-b kubeconfig file")
+		s.NotContains(rawConfig.Contexts, "cluster-a-admin", "Expected manager not to be merged with the cluster-a kubeconfig file")
+	})
+	s.Run("RawConfig returns a context for each target", func() {
+		k8s, err := provider.GetDerivedKubernetes(s.T().Context(), "cluster-b-admin")
+		s.Require().NoError(err, "Expected no error from GetDerivedKubernetes")
+		rawConfig, err := k8s.ToRawKubeConfigLoader().RawConfig()
+		s.Require().NoError(err, "Expected no error reading raw config")
+		s.Len(rawConfig.Contexts, 4, "Expected a context for each target")
+		for _, target := range []string{"fake-context", "cluster-a-admin", "cluster-b-admin", "cluster-b:fake-context"} {
+			s.Containsf(rawConfig.Contexts, target, "Expected context for target %s", target)
+		}
+		s.Equal("fake-context", rawConfig.CurrentContext, "Expected the default target as current context")
+	})
+	s.Run("returns error for missing kubeconfig file", func() {
+		_, err := NewProvider(&config.StaticConfig{KubeConfigs: []string{clusterAFile, filepath.Join(kubeconfigDir, "missing.yaml")}})
+		s.Require().Error(err, "Expected error for missing kubeconfig file")
+		s.ErrorContains(err, "failed to load kubeconfig file")
+	})
+	s.Run("GetDerivedKubernetes returns error for invalid context", func() {
+		k8s, err := provider.GetDerivedKubernetes(s.T().Context(), "invalid-context")
+		s.Require().Error(err, "Expected error from GetDerivedKubernetes with invalid context")
+		s.ErrorContainsf(err, `context "invalid-context" does not exist`, "Expected context does not exist error, got: %v", err)
+		s.Nil(k8s, "Expected no Kubernetes from GetDerivedKubernetes with invalid context")
+	})
+}
+
+func (s *ProviderKubeconfigTestSuite) TestMultipleKubeconfigFilesWithCollidingNames() {
+	// Both files use the same context, cluster and user names (like the kubeadm defaults) for different clusters
+	mockServerB := test.NewMockServer()
+	s.T().Cleanup(mockServerB.Close)
+	kubeconfigDir := s.T().TempDir()
+	clusterA := s.mockServer.Kubeconfig()
+	clusterA.AuthInfos["fake"].Token = "token-a"
+	clusterAFile := filepath.Join(kubeconfigDir, "cluster-a.yaml")
+	s.Require().NoError(clientcmd.WriteToFile(*clusterA, clusterAFile))
+	clusterB := mockServerB.Kubeconfig()
+	clusterB.AuthInfos["fake"].Token = "token-b"
+	clusterBFile := filepath.Join(kubeconfigDir, "cluster-b.yaml")
+	s.Require().NoError(clientcmd.WriteToFile(*clusterB, clusterBFile))
+
+	provider, err := NewProvider(&config.StaticConfig{KubeConfigs: []string{clusterAFile, clusterBFile}})
+	s.Require().NoError(err, "Expected no error creating provider with multiple kubeconfig files")
+	s.T().Cleanup(provider.Close)
+
+	s.Run("GetTargets prefixes the colliding context with the file name", func() {
+		targets, err := provider.GetTargets(s.T().Context())
+		s.Require().NoError(err, "Expected no error from GetTargets")
+		s.ElementsMatch([]string{"fake-context", "cluster-b:fake-context"}, targets)
+	})
+	s.Run("context of the first file uses the cluster and user of the first file", func() {
+		m, err := provider.(*kubeConfigClusterProvider).managerForContext("fake-context")
+		s.Require().NoError(err, "Expected no error resolving target fake-context")
+		s.Equal(s.mockServer.Config().Host, m.kubernetes.restConfig.Host, "Expected the cluster-a server")
+		rawConfig, err := m.kubernetes.clientCmdConfig.RawConfig()
+		s.Require().NoError(err, "Expected no error reading raw config")
+		s.Equal("token-a", rawConfig.AuthInfos[rawConfig.Contexts["fake-context"].AuthInfo].Token, "Expected the cluster-a credentials")
+		s.Equal(s.mockServer.Config().Host, rawConfig.Clusters[rawConfig.Contexts["fake-context"].Cluster].Server, "Expected the cluster-a server")
+	})
+	s.Run("prefixed context uses the cluster and user of its own file", func() {
+		m, err := provider.(*kubeConfigClusterProvider).managerForContext("cluster-b:fake-context")
+		s.Require().NoError(err, "Expected no error resolving target cluster-b:fake-context")
+		s.Equal(mockServerB.Config().Host, m.kubernetes.restConfig.Host, "Expected the cluster-b server")
+		rawConfig, err := m.kubernetes.clientCmdConfig.RawConfig()
+		s.Require().NoError(err, "Expected no error reading raw config")
+		s.Equal("token-b", rawConfig.AuthInfos[rawConfig.Contexts["cluster-b:fake-context"].AuthInfo].Token, "Expected the cluster-b credentials")
+		s.Equal(mockServerB.Config().Host, rawConfig.Clusters[rawConfig.Contexts["cluster-b:fake-context"].Cluster].Server, "Expected the cluster-b server")
+		s.Equal("cluster-b:fake", rawConfig.Contexts["cluster-b:fake-context"].AuthInfo, "Expected the colliding user prefixed with the file name")
+	})
+}
+
+func (s *ProviderKubeconfigTestSuite) TestWarmUpTargets() {
+	var discoveryRequests atomic.Int32
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/api" {
+			discoveryRequests.Add(1)
+		}
+	}))
+	s.mockServer.Handle(test.NewDiscoveryClientHandler())
+	kubeconfig := s.mockServer.Kubeconfig()
+	kubeconfig.Contexts["another-context"] = kubeconfig.Contexts["fake-context"].DeepCopy()
+	kubeconfig.Clusters["unreachable"] = clientcmdapi.NewCluster()
+	kubeconfig.Clusters["unreachable"].Server = "http://127.0.0.1:1"
+	kubeconfig.Contexts["unreachable-context"] = clientcmdapi.NewContext()
+	kubeconfig.Contexts["unreachable-context"].Cluster = "unreachable"
+	kubeconfig.Contexts["unreachable-context"].AuthInfo = "fake"
+	provider, err := NewProvider(&config.StaticConfig{KubeConfig: test.KubeconfigFile(s.T(), kubeconfig)})
+	s.Require().NoError(err, "Expected no error creating provider with kubeconfig")
+	s.T().Cleanup(provider.Close)
+
+	errs := WarmUpTargets(s.T().Context(), provider, 0)
+
+	s.Run("reports the failing target", func() {
+		s.Require().Len(errs, 1, "Expected only the unreachable target to fail, got: %v", errs)
+		s.Contains(errs, "unreachable-context")
+	})
+	s.Run("pre-initializes all the targets", func() {
+		managers := provider.(*kubeConfigClusterProvider).managers
+		for _, target := range []string{"fake-context", "another-context", "unreachable-context"} {
+			s.NotNilf(managers[target], "Expected manager for target %s to be initialized", target)
+		}
+	})
+	s.Run("fills the discovery cache of the reachable targets", func() {
+		requests := discoveryRequests.Load()
+		s.GreaterOrEqual(requests, int32(2), "Expected discovery requests for each reachable target")
+		for _, target := range []string{"fake-context", "another-context"} {
+			k, err := provider.GetDerivedKubernetes(s.T().Context(), target)
+			s.Require().NoError(err)
+			_, _, err = k.DiscoveryClient().ServerGroupsAndResources()
+			s.Require().NoError(err)
+		}
+		s.Equal(requests, discoveryRequests.Load(), "Expected discovery to be served from the cache after warm-up")
+	})
+}
+
+func (s *ProviderKubeconfigTestSuite) TestWarmUpTargetsCancelled() {
+	ctx, cancel := context.WithCancel(s.T().Context())
+	cancel()
+
+	errs := WarmUpTargets(ctx, s.provider, 0)
+
+	s.Run("skips all the targets", func() {
+		s.Len(errs, 11, "Expected every target to be skipped")
+		for target, err := range errs {
+			s.ErrorIsf(err, context.Canceled, "Expected target %s to be skipped", target)
+		}
+	})
+	s.Run("doesn't initialize the targets", func() {
+		s.Nil(s.provider.(*kubeConfigClusterProvider).managers["context-0"])
+	})
+}
+
+func (s *ProviderKubeconfigTestSuite) TestConcurrentTargetsReset() {
+	p := s.provider.(*kubeConfigClusterProvider)
+	s.T().Cleanup(p.Close)
+	var wg sync.WaitGroup
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		_ = WarmUpTargets(s.T().Context(), p, 0)
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 10; i++ {
+			s.NoError(p.reset())
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 10; i++ {
+			targets, err := p.GetTargets(s.T().Context())
+			s.NoError(err)
+			s.Len(targets, 11)
+			s.Equal("fake-context", p.GetDefaultTarget())
+		}
+	}()
+	wg.Wait()
+}
+
 func TestProviderKubeconfig(t *testing.T) {
 	suite.Run(t, new(ProviderKubeconfigTestSuite))
 }
@@ -1,6 +1,7 @@
 package kubernetes
 
 import (
+	"context"
 	"testing"
 
 	"github.com/containers/kubernetes-mcp-server/internal/test"
@@ -79,6 +80,33 @@ func (s *ProviderSingleTestSuite) TestGetDerivedKubernetes() {
 	})
 }
 
+func (s *ProviderSingleTestSuite) TestDefaultNamespace() {
+	s.Run("without default_namespace, the default namespace is used", func() {
+		k8s, err := s.provider.GetDerivedKubernetes(s.T().Context(), "")
+		s.Require().NoError(err, "Expected no error from GetDerivedKubernetes")
+		s.Equal("default", k8s.NamespaceOrDefault(""))
+	})
+	provider, err := NewProvider(&config.StaticConfig{DefaultNamespace: "overridden-namespace"})
+	s.Require().NoError(err, "Expected no error creating provider with default_namespace")
+	s.T().Cleanup(provider.Close)
+	s.Run("with default_namespace, the overridden namespace is used", func() {
+		k8s, err := provider.GetDerivedKubernetes(s.T().Context(), "")
+		s.Require().NoError(err, "Expected no error from GetDerivedKubernetes")
+		s.Equal("overridden-namespace", k8s.NamespaceOrDefault(""))
+	})
+	s.Run("with default_namespace, the overridden namespace is used by derived clients", func() {
+		ctx := context.WithValue(s.T().Context(), OAuthAuthorizationHeader, "Bearer a-token")
+		k8s, err := provider.GetDerivedKubernetes(ctx, "")
+		s.Require().NoError(err, "Expected no error from GetDerivedKubernetes")
+		s.Equal("overridden-namespace", k8s.NamespaceOrDefault(""))
+	})
+	s.Run("with default_namespace, the provided namespace takes precedence", func() {
+		k8s, err := provider.GetDerivedKubernetes(s.T().Context(), "")
+		s.Require().NoError(err, "Expected no error from GetDerivedKubernetes")
+		s.Equal("provided-namespace", k8s.NamespaceOrDefault("provided-namespace"))
+	})
+}
+
 func (s *ProviderSingleTestSuite) TestGetDefaultTarget() {
 	s.Run("GetDefaultTarget returns empty string", func() {
 		s.Empty(s.provider.GetDefaultTarget(), "Expected fake-context as default target")
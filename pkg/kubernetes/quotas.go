@@ -0,0 +1,13 @@
+package kubernetes
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// QuotaUsage returns the ResourceQuotas in the provided namespace (or the configured namespace if not provided).
+func (c *Core) QuotaUsage(ctx context.Context, namespace string) (*v1.ResourceQuotaList, error) {
+	return c.CoreV1().ResourceQuotas(c.NamespaceOrDefault(namespace)).List(ctx, metav1.ListOptions{})
+}
@@ -0,0 +1,157 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+// SubjectBinding is a ClusterRoleBinding or RoleBinding referencing a subject, with the rules granted by its role.
+type SubjectBinding struct {
+	Kind      string
+	Name      string
+	Namespace string `json:",omitempty"`
+	RoleRef   string
+	// RoleNotFound is true if the referenced Role or ClusterRole doesn't exist (the binding grants nothing)
+	RoleNotFound bool `json:",omitempty"`
+	Rules        []string
+}
+
+// RbacSubjectKinds are the supported kinds of RBAC subjects.
+var RbacSubjectKinds = []string{rbacv1.UserKind, rbacv1.GroupKind, rbacv1.ServiceAccountKind}
+
+// RbacForSubject returns the ClusterRoleBindings and RoleBindings referencing the subject of the provided kind and name,
+// together with a summary of the rules granted by their roles.
+// For ServiceAccounts, the namespace is the namespace of the ServiceAccount (the configured one if empty) and the
+// RoleBindings of all namespaces are inspected. For Users and Groups, the namespace restricts the inspected RoleBindings.
+func (c *Core) RbacForSubject(ctx context.Context, kind, name, namespace string) ([]SubjectBinding, error) {
+	subject := rbacv1.Subject{Kind: kind, Name: name}
+	roleBindingsNamespace := namespace
+	switch kind {
+	case rbacv1.ServiceAccountKind:
+		subject.Namespace = c.NamespaceOrDefault(namespace)
+		roleBindingsNamespace = ""
+	case rbacv1.UserKind, rbacv1.GroupKind:
+	default:
+		return nil, fmt.Errorf("unsupported subject kind %s, supported kinds are: %s", kind, strings.Join(RbacSubjectKinds, ", "))
+	}
+	ret := make([]SubjectBinding, 0)
+	bindingKinds := []struct {
+		kind      string
+		namespace string
+	}{
+		{kind: "ClusterRoleBinding"},
+		{kind: "RoleBinding", namespace: roleBindingsNamespace},
+	}
+	for _, bindingKind := range bindingKinds {
+		bindings, err := c.ResourcesList(ctx, &schema.GroupVersionKind{
+			Group: rbacv1.GroupName, Version: "v1", Kind: bindingKind.kind,
+		}, bindingKind.namespace, api.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		err = bindings.EachListItem(func(obj runtime.Object) error {
+			binding := &rbacv1.RoleBinding{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.(*unstructured.Unstructured).Object, binding); err != nil {
+				return err
+			}
+			if !bindingReferencesSubject(binding.Subjects, subject) {
+				return nil
+			}
+			subjectBinding, err := c.subjectBinding(ctx, bindingKind.kind, binding)
+			if err != nil {
+				return err
+			}
+			ret = append(ret, *subjectBinding)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+func bindingReferencesSubject(subjects []rbacv1.Subject, subject rbacv1.Subject) bool {
+	for _, s := range subjects {
+		if s.Kind == subject.Kind && s.Name == subject.Name &&
+			(subject.Kind != rbacv1.ServiceAccountKind || s.Namespace == subject.Namespace) {
+			return true
+		}
+	}
+	return false
+}
+
+// subjectBinding resolves the role referenced by the provided binding and summarizes its rules.
+func (c *Core) subjectBinding(ctx context.Context, kind string, binding *rbacv1.RoleBinding) (*SubjectBinding, error) {
+	ret := &SubjectBinding{
+		Kind:      kind,
+		Name:      binding.Name,
+		Namespace: binding.Namespace,
+		RoleRef:   binding.RoleRef.Kind + "/" + binding.RoleRef.Name,
+	}
+	roleNamespace := ""
+	if binding.RoleRef.Kind == "Role" {
+		roleNamespace = binding.Namespace
+	}
+	role, err := c.ResourcesGet(ctx, &schema.GroupVersionKind{
+		Group: rbacv1.GroupName, Version: "v1", Kind: binding.RoleRef.Kind,
+	}, roleNamespace, binding.RoleRef.Name)
+	if apierrors.IsNotFound(err) {
+		ret.RoleNotFound = true
+		return ret, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	rules, _, _ := unstructured.NestedSlice(role.Object, "rules")
+	for _, r := range rules {
+		rule := rbacv1.PolicyRule{}
+		if ruleMap, ok := r.(map[string]any); ok {
+			if err = runtime.DefaultUnstructuredConverter.FromUnstructured(ruleMap, &rule); err != nil {
+				return nil, fmt.Errorf("failed to parse rules of %s: %w", ret.RoleRef, err)
+			}
+		}
+		ret.Rules = append(ret.Rules, policyRuleToString(rule))
+	}
+	return ret, nil
+}
+
+// policyRuleToString summarizes a PolicyRule (e.g. "verbs=get,list resources=pods,deployments.apps")
+func policyRuleToString(rule rbacv1.PolicyRule) string {
+	parts := []string{"verbs=" + strings.Join(rule.Verbs, ",")}
+	if len(rule.Resources) > 0 {
+		groups := rule.APIGroups
+		if len(groups) == 0 {
+			groups = []string{""}
+		}
+		resources := make([]string, 0, len(rule.Resources)*len(groups))
+		for _, group := range groups {
+			for _, resource := range rule.Resources {
+				if group == "" {
+					resources = append(resources, resource)
+				} else {
+					resources = append(resources, resource+"."+group)
+				}
+			}
+		}
+		sort.Strings(resources)
+		parts = append(parts, "resources="+strings.Join(resources, ","))
+	}
+	if len(rule.ResourceNames) > 0 {
+		parts = append(parts, "resourceNames="+strings.Join(rule.ResourceNames, ","))
+	}
+	if len(rule.NonResourceURLs) > 0 {
+		parts = append(parts, "nonResourceURLs="+strings.Join(rule.NonResourceURLs, ","))
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,29 @@
+package kubernetes
+
+import (
+	"context"
+	"net/http"
+
+	"k8s.io/klog/v2"
+)
+
+// WithRequestID returns a copy of the provided context holding the request ID, and a logger (see klog.FromContext)
+// that includes the request ID in every log line.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	ctx = context.WithValue(ctx, RequestIDHeader, requestID)
+	return klog.NewContext(ctx, klog.LoggerWithValues(klog.FromContext(ctx), "requestID", requestID))
+}
+
+// requestIDRoundTripper propagates the request ID stored in the request context (if any) as a header of the
+// outgoing Kube API requests, allowing to correlate MCP tool calls with the resulting API server requests.
+type requestIDRoundTripper struct {
+	delegate http.RoundTripper
+}
+
+func (rt *requestIDRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if requestID, ok := req.Context().Value(RequestIDHeader).(string); ok && requestID != "" {
+		req = req.Clone(req.Context())
+		req.Header.Set(string(RequestIDHeader), requestID)
+	}
+	return rt.delegate.RoundTrip(req)
+}
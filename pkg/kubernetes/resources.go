@@ -2,8 +2,11 @@ package kubernetes
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/runtime"
@@ -12,11 +15,15 @@ import (
 	"github.com/containers/kubernetes-mcp-server/pkg/api"
 	"github.com/containers/kubernetes-mcp-server/pkg/version"
 	authv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 )
 
 const (
@@ -26,8 +33,11 @@ const (
 	AppKubernetesPartOf    = "app.kubernetes.io/part-of"
 )
 
+// listPageSize is the maximum number of items requested per page when the lists are paginated (same as kubectl's default chunk size)
+const listPageSize = 500
+
 func (c *Core) ResourcesList(ctx context.Context, gvk *schema.GroupVersionKind, namespace string, options api.ListOptions) (runtime.Unstructured, error) {
-	gvr, err := c.resourceFor(gvk)
+	gvr, gvk, err := c.preferredResourceFor(gvk)
 	if err != nil {
 		return nil, err
 	}
@@ -40,11 +50,41 @@ func (c *Core) ResourcesList(ctx context.Context, gvk *schema.GroupVersionKind,
 	if options.AsTable {
 		return c.resourcesListAsTable(ctx, gvk, gvr, namespace, options)
 	}
-	return c.DynamicClient().Resource(*gvr).Namespace(namespace).List(ctx, options.ListOptions)
+	// Unless a page size is explicitly requested, retrieve the list in pages and merge them (up to MaxListItems items)
+	paginate := options.Limit == 0
+	listOptions := options.ListOptions
+	if paginate {
+		listOptions.Limit = c.listPageLimit(0)
+	}
+	list, err := c.DynamicClient().Resource(*gvr).Namespace(namespace).List(ctx, listOptions)
+	if err != nil {
+		return list, err
+	}
+	for paginate && list.GetContinue() != "" && len(list.Items) < c.MaxListItems() {
+		listOptions.Continue = list.GetContinue()
+		listOptions.Limit = c.listPageLimit(len(list.Items))
+		page, err := c.DynamicClient().Resource(*gvr).Namespace(namespace).List(ctx, listOptions)
+		if err != nil {
+			return nil, err
+		}
+		list.Items = append(list.Items, page.Items...)
+		list.SetContinue(page.GetContinue())
+	}
+	if paginate && list.GetContinue() != "" {
+		klog.V(1).Infof("List of %s truncated to %d items (max_list_items)", gvr.String(), len(list.Items))
+	}
+	if namespace != "" {
+		return list, nil
+	}
+	// Filter out the resources in the excluded (system) namespaces when listing across all namespaces
+	list.Items = slices.DeleteFunc(list.Items, func(item unstructured.Unstructured) bool {
+		return c.IsNamespaceExcluded(item.GetNamespace())
+	})
+	return list, nil
 }
 
 func (c *Core) ResourcesGet(ctx context.Context, gvk *schema.GroupVersionKind, namespace, name string) (*unstructured.Unstructured, error) {
-	gvr, err := c.resourceFor(gvk)
+	gvr, gvk, err := c.preferredResourceFor(gvk)
 	if err != nil {
 		return nil, err
 	}
@@ -57,6 +97,99 @@ func (c *Core) ResourcesGet(ctx context.Context, gvk *schema.GroupVersionKind, n
 }
 
 func (c *Core) ResourcesCreateOrUpdate(ctx context.Context, resource string) ([]*unstructured.Unstructured, error) {
+	parsedResources, err := parseResources(resource)
+	if err != nil {
+		return nil, err
+	}
+	return c.resourcesCreateOrUpdate(ctx, parsedResources)
+}
+
+// ResourceValidation is the result of the validation of a single resource of a manifest.
+type ResourceValidation struct {
+	APIVersion string
+	Kind       string
+	Name       string
+	Namespace  string `json:",omitempty"`
+	Valid      bool
+	Errors     []string `json:",omitempty"`
+}
+
+// ResourcesValidate validates the resources of the provided YAML or JSON manifest against the cluster by performing
+// a server-side dry-run apply with strict field validation. Nothing is persisted in the cluster.
+// Validation failures are reported in the returned results, an error is only returned if the manifest can't be parsed
+// or if it contains a denied resource.
+func (c *Core) ResourcesValidate(ctx context.Context, resource string) ([]ResourceValidation, error) {
+	parsedResources, err := parseResources(resource)
+	if err != nil {
+		return nil, err
+	}
+	validations := make([]ResourceValidation, 0, len(parsedResources))
+	for _, obj := range parsedResources {
+		validation := ResourceValidation{
+			APIVersion: obj.GetAPIVersion(),
+			Kind:       obj.GetKind(),
+			Name:       obj.GetName(),
+			Namespace:  obj.GetNamespace(),
+		}
+		if validation.Errors, err = c.resourceValidate(ctx, obj); err != nil {
+			return nil, err
+		}
+		validation.Valid = len(validation.Errors) == 0
+		validations = append(validations, validation)
+	}
+	return validations, nil
+}
+
+func (c *Core) resourceValidate(ctx context.Context, obj *unstructured.Unstructured) ([]string, error) {
+	gvk := obj.GroupVersionKind()
+	if gvk.Version == "" || gvk.Kind == "" {
+		return []string{"apiVersion and kind are required"}, nil
+	}
+	if obj.GetName() == "" {
+		return []string{"metadata.name: Required value"}, nil
+	}
+	gvr, err := c.resourceFor(&gvk)
+	if err != nil {
+		return []string{err.Error()}, nil
+	}
+	namespace := obj.GetNamespace()
+	if namespaced, nsErr := c.isNamespaced(&gvk); nsErr == nil && namespaced {
+		namespace = c.NamespaceOrDefault(namespace)
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return []string{err.Error()}, nil
+	}
+	_, err = c.DynamicClient().Resource(*gvr).Namespace(namespace).Patch(ctx, obj.GetName(), types.ApplyPatchType, data, metav1.PatchOptions{
+		DryRun:          []string{metav1.DryRunAll},
+		FieldManager:    version.BinaryName,
+		FieldValidation: metav1.FieldValidationStrict,
+		Force:           ptr.To(true),
+	})
+	if err == nil {
+		return nil, nil
+	}
+	// Denied resources are not a validation failure of the manifest
+	if errors.Is(err, ErrResourceNotAllowed) {
+		return nil, err
+	}
+	var statusErr *apierrors.StatusError
+	if errors.As(err, &statusErr) && statusErr.ErrStatus.Details != nil && len(statusErr.ErrStatus.Details.Causes) > 0 {
+		causes := make([]string, 0, len(statusErr.ErrStatus.Details.Causes))
+		for _, cause := range statusErr.ErrStatus.Details.Causes {
+			if cause.Field == "" {
+				causes = append(causes, cause.Message)
+			} else {
+				causes = append(causes, fmt.Sprintf("%s: %s", cause.Field, cause.Message))
+			}
+		}
+		return causes, nil
+	}
+	return []string{err.Error()}, nil
+}
+
+// parseResources splits the provided YAML or JSON manifest into its individual resources.
+func parseResources(resource string) ([]*unstructured.Unstructured, error) {
 	separator := regexp.MustCompile(`\r?\n---\r?\n`)
 	resources := separator.Split(resource, -1)
 	var parsedResources []*unstructured.Unstructured
@@ -67,7 +200,7 @@ func (c *Core) ResourcesCreateOrUpdate(ctx context.Context, resource string) ([]
 		}
 		parsedResources = append(parsedResources, &obj)
 	}
-	return c.resourcesCreateOrUpdate(ctx, parsedResources)
+	return parsedResources, nil
 }
 
 func (c *Core) ResourcesDelete(ctx context.Context, gvk *schema.GroupVersionKind, namespace, name string) error {
@@ -125,6 +258,11 @@ func (c *Core) ResourcesScale(
 	return scale, nil
 }
 
+// listPageLimit returns the number of items to request for the next page of a list that already contains retrieved items.
+func (c *Core) listPageLimit(retrieved int) int64 {
+	return int64(min(listPageSize, c.MaxListItems()-retrieved))
+}
+
 // resourcesListAsTable retrieves a list of resources in a table format.
 // It's almost identical to the dynamic.DynamicClient implementation, but it uses a specific Accept header to request the table format.
 // dynamic.DynamicClient does not provide a way to set the HTTP header (TODO: create an issue to request this feature)
@@ -140,20 +278,52 @@ func (c *Core) resourcesListAsTable(ctx context.Context, gvk *schema.GroupVersio
 		url = append(url, "namespaces", namespace)
 	}
 	url = append(url, gvr.Resource)
-	var table metav1.Table
-	err := c.CoreV1().RESTClient().
-		Get().
-		SetHeader("Accept", strings.Join([]string{
-			fmt.Sprintf("application/json;as=Table;v=%s;g=%s", metav1.SchemeGroupVersion.Version, metav1.GroupName),
-			fmt.Sprintf("application/json;as=Table;v=%s;g=%s", metav1beta1.SchemeGroupVersion.Version, metav1beta1.GroupName),
-			"application/json",
-		}, ",")).
-		AbsPath(url...).
-		SpecificallyVersionedParams(&options.ListOptions, ParameterCodec, schema.GroupVersion{Version: "v1"}).
-		Do(ctx).Into(&table)
+	listTable := func(listOptions *metav1.ListOptions) (*metav1.Table, error) {
+		table := &metav1.Table{}
+		return table, c.CoreV1().RESTClient().
+			Get().
+			SetHeader("Accept", strings.Join([]string{
+				fmt.Sprintf("application/json;as=Table;v=%s;g=%s", metav1.SchemeGroupVersion.Version, metav1.GroupName),
+				fmt.Sprintf("application/json;as=Table;v=%s;g=%s", metav1beta1.SchemeGroupVersion.Version, metav1beta1.GroupName),
+				"application/json",
+			}, ",")).
+			AbsPath(url...).
+			SpecificallyVersionedParams(listOptions, ParameterCodec, schema.GroupVersion{Version: "v1"}).
+			Do(ctx).Into(table)
+	}
+	// Unless a page size is explicitly requested, retrieve the table in pages and merge them (up to MaxListItems rows)
+	paginate := options.Limit == 0
+	listOptions := options.ListOptions
+	if paginate {
+		listOptions.Limit = c.listPageLimit(0)
+	}
+	table, err := listTable(&listOptions)
 	if err != nil {
 		return nil, err
 	}
+	for paginate && table.Continue != "" && len(table.Rows) < c.MaxListItems() {
+		listOptions.Continue = table.Continue
+		listOptions.Limit = c.listPageLimit(len(table.Rows))
+		page, err := listTable(&listOptions)
+		if err != nil {
+			return nil, err
+		}
+		table.Rows = append(table.Rows, page.Rows...)
+		table.Continue = page.Continue
+	}
+	if paginate && table.Continue != "" {
+		klog.V(1).Infof("List of %s truncated to %d items (max_list_items)", gvr.String(), len(table.Rows))
+	}
+	// Filter out the rows of the resources in the excluded (system) namespaces when listing across all namespaces
+	if namespace == "" {
+		table.Rows = slices.DeleteFunc(table.Rows, func(row metav1.TableRow) bool {
+			metadata := metav1.PartialObjectMetadata{}
+			if len(row.Object.Raw) == 0 || json.Unmarshal(row.Object.Raw, &metadata) != nil {
+				return false
+			}
+			return c.IsNamespaceExcluded(metadata.Namespace)
+		})
+	}
 	// Add metav1.Table apiVersion and kind to the unstructured object (server may not return these fields)
 	table.SetGroupVersionKind(metav1.SchemeGroupVersion.WithKind("Table"))
 	// Add additional columns for fields that aren't returned by the server
@@ -168,7 +338,7 @@ func (c *Core) resourcesListAsTable(ctx context.Context, gvk *schema.GroupVersio
 			gvk.Kind,
 		}, row.Cells...)
 	}
-	unstructuredObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&table)
+	unstructuredObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(table)
 	return &unstructured.Unstructured{Object: unstructuredObject}, err
 }
 
@@ -207,6 +377,23 @@ func (c *Core) resourceFor(gvk *schema.GroupVersionKind) (*schema.GroupVersionRe
 	return &m.Resource, nil
 }
 
+// preferredResourceFor resolves the resource for the provided gvk, using the version pinned for its API group
+// (preferred_versions) instead of the requested one, and returns it along with the gvk for the resolved version.
+// It's used by the generic resource tools, whose (required) apiVersion would otherwise prevent the pin from applying.
+func (c *Core) preferredResourceFor(gvk *schema.GroupVersionKind) (*schema.GroupVersionResource, *schema.GroupVersionKind, error) {
+	if m, ok := c.RESTMapper().(*preferredVersionsRESTMapper); ok {
+		if version := m.preferredVersions[gvk.Group]; version != "" && version != gvk.Version {
+			klog.V(3).Infof("Using preferred version %s for API group %q instead of the requested %s", version, gvk.Group, gvk.Version)
+			gvk = &schema.GroupVersionKind{Group: gvk.Group, Version: version, Kind: gvk.Kind}
+		}
+	}
+	gvr, err := c.resourceFor(gvk)
+	if err != nil {
+		return nil, nil, err
+	}
+	return gvr, gvk, nil
+}
+
 func (c *Core) isNamespaced(gvk *schema.GroupVersionKind) (bool, error) {
 	apiResourceList, err := c.DiscoveryClient().ServerResourcesForGroupVersion(gvk.GroupVersion().String())
 	if err != nil {
@@ -220,6 +407,9 @@ func (c *Core) isNamespaced(gvk *schema.GroupVersionKind) (bool, error) {
 	return false, nil
 }
 
+// ErrMetricsUnavailable is returned when the metrics API (metrics.k8s.io) is not available in the cluster
+var ErrMetricsUnavailable = errors.New("metrics API is not available")
+
 func (c *Core) supportsGroupVersion(groupVersion string) bool {
 	if _, err := c.DiscoveryClient().ServerResourcesForGroupVersion(groupVersion); err != nil {
 		return false
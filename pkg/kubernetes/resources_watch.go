@@ -0,0 +1,91 @@
+package kubernetes
+
+import (
+	"context"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/utils/ptr"
+)
+
+// ResourcesWatchMaxDuration is the maximum duration of a bounded resource watch.
+const ResourcesWatchMaxDuration = 60 * time.Second
+
+// ResourceWatchEvent is a summary of an event received while watching resources.
+type ResourceWatchEvent struct {
+	Type            string         `json:"type"`
+	Time            string         `json:"time"`
+	Name            string         `json:"name"`
+	Namespace       string         `json:"namespace,omitempty"`
+	ResourceVersion string         `json:"resourceVersion,omitempty"`
+	Status          map[string]any `json:"status,omitempty"`
+}
+
+// ResourcesWatch watches the resources matching the provided criteria for (at most) the provided duration
+// and returns the ADDED, MODIFIED, and DELETED events received during that window.
+// The watch starts with a synthetic ADDED event for each of the already existing resources.
+func (c *Core) ResourcesWatch(ctx context.Context, gvk *schema.GroupVersionKind, namespace, name, labelSelector string, duration time.Duration) ([]ResourceWatchEvent, error) {
+	gvr, err := c.resourceFor(gvk)
+	if err != nil {
+		return nil, err
+	}
+	// The resolved version might differ from the requested one (preferred_versions)
+	gvk = &schema.GroupVersionKind{Group: gvk.Group, Version: gvr.Version, Kind: gvk.Kind}
+
+	if namespaced, nsErr := c.isNamespaced(gvk); nsErr == nil && namespaced && name != "" {
+		namespace = c.NamespaceOrDefault(namespace)
+	}
+	if duration <= 0 || duration > ResourcesWatchMaxDuration {
+		duration = ResourcesWatchMaxDuration
+	}
+	watchCtx, cancel := context.WithTimeout(ctx, duration)
+	defer cancel()
+
+	options := metav1.ListOptions{
+		LabelSelector:  labelSelector,
+		TimeoutSeconds: ptr.To(int64(duration.Seconds())),
+	}
+	if name != "" {
+		options.FieldSelector = fields.OneTermEqualSelector("metadata.name", name).String()
+	}
+	watcher, err := c.DynamicClient().Resource(*gvr).Namespace(namespace).Watch(watchCtx, options)
+	if err != nil {
+		return nil, err
+	}
+	defer watcher.Stop()
+
+	events := make([]ResourceWatchEvent, 0)
+	for {
+		select {
+		case <-watchCtx.Done():
+			return events, nil
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return events, nil
+			}
+			switch event.Type {
+			case watch.Error:
+				return events, apierrors.FromObject(event.Object)
+			case watch.Added, watch.Modified, watch.Deleted:
+				obj, isUnstructured := event.Object.(*unstructured.Unstructured)
+				if !isUnstructured {
+					continue
+				}
+				status, _, _ := unstructured.NestedMap(obj.Object, "status")
+				events = append(events, ResourceWatchEvent{
+					Type:            string(event.Type),
+					Time:            time.Now().UTC().Format(time.RFC3339),
+					Name:            obj.GetName(),
+					Namespace:       obj.GetNamespace(),
+					ResourceVersion: obj.GetResourceVersion(),
+					Status:          status,
+				})
+			}
+		}
+	}
+}
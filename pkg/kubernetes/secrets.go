@@ -0,0 +1,129 @@
+package kubernetes
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+const (
+	CertificateStatusValid        = "Valid"
+	CertificateStatusExpiringSoon = "ExpiringSoon"
+	CertificateStatusExpired      = "Expired"
+	CertificateStatusInvalid      = "Invalid"
+)
+
+// SecretCertificate describes the certificate (tls.crt) of a TLS Secret. The private key (tls.key) is never read.
+type SecretCertificate struct {
+	Namespace     string
+	Name          string
+	Subject       string
+	Issuer        string
+	DNSNames      []string `json:",omitempty"`
+	NotBefore     string
+	NotAfter      string
+	DaysRemaining int
+	Status        string
+	// Error describes why the certificate couldn't be parsed (Status is Invalid)
+	Error string `json:",omitempty"`
+}
+
+var secretGvk = &schema.GroupVersionKind{Group: "", Version: "v1", Kind: "Secret"}
+
+// SecretsCertExpiry parses the certificate (tls.crt) of the TLS Secrets (or of the Secret with the provided name) and
+// returns its subject, issuer, and validity, the soonest to expire first. Certificates expiring within the provided
+// duration are flagged as ExpiringSoon.
+func (c *Core) SecretsCertExpiry(ctx context.Context, namespace string, allNamespaces bool, name string, expiringWithin time.Duration) ([]SecretCertificate, error) {
+	var secrets []unstructured.Unstructured
+	if name != "" {
+		secret, err := c.ResourcesGet(ctx, secretGvk, namespace, name)
+		if err != nil {
+			return nil, err
+		}
+		secrets = append(secrets, *secret)
+	} else {
+		list, err := c.ResourcesList(ctx, secretGvk, c.listNamespace(namespace, allNamespaces), api.ListOptions{
+			ListOptions: metav1.ListOptions{FieldSelector: fields.OneTermEqualSelector("type", string(corev1.SecretTypeTLS)).String()},
+		})
+		if err != nil {
+			return nil, err
+		}
+		err = list.EachListItem(func(obj runtime.Object) error {
+			// Field selectors are not supported by every API server (or proxy), make sure only the TLS Secrets are returned
+			if secret := obj.(*unstructured.Unstructured); secret.Object["type"] == string(corev1.SecretTypeTLS) {
+				secrets = append(secrets, *secret)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	now := time.Now()
+	ret := make([]SecretCertificate, 0, len(secrets))
+	for _, secret := range secrets {
+		certificate := SecretCertificate{Namespace: secret.GetNamespace(), Name: secret.GetName()}
+		cert, err := secretCertificate(&secret)
+		if err != nil {
+			certificate.Status = CertificateStatusInvalid
+			certificate.Error = err.Error()
+			ret = append(ret, certificate)
+			continue
+		}
+		certificate.Subject = cert.Subject.String()
+		certificate.Issuer = cert.Issuer.String()
+		certificate.DNSNames = cert.DNSNames
+		certificate.NotBefore = cert.NotBefore.UTC().Format(time.RFC3339)
+		certificate.NotAfter = cert.NotAfter.UTC().Format(time.RFC3339)
+		certificate.DaysRemaining = int(math.Floor(cert.NotAfter.Sub(now).Hours() / 24))
+		switch {
+		case now.After(cert.NotAfter):
+			certificate.Status = CertificateStatusExpired
+		case now.Add(expiringWithin).After(cert.NotAfter):
+			certificate.Status = CertificateStatusExpiringSoon
+		default:
+			certificate.Status = CertificateStatusValid
+		}
+		ret = append(ret, certificate)
+	}
+	// The soonest to expire first, the invalid certificates last
+	sort.SliceStable(ret, func(i, j int) bool {
+		if invalidI, invalidJ := ret[i].Status == CertificateStatusInvalid, ret[j].Status == CertificateStatusInvalid; invalidI != invalidJ {
+			return invalidJ
+		}
+		return ret[i].NotAfter < ret[j].NotAfter
+	})
+	return ret, nil
+}
+
+// secretCertificate parses the first (leaf) certificate of the tls.crt entry of the provided Secret.
+func secretCertificate(secret *unstructured.Unstructured) (*x509.Certificate, error) {
+	encoded, found, err := unstructured.NestedString(secret.Object, "data", corev1.TLSCertKey)
+	if err != nil || !found || encoded == "" {
+		return nil, fmt.Errorf("secret has no %s entry", corev1.TLSCertKey)
+	}
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", corev1.TLSCertKey, err)
+	}
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type == "CERTIFICATE" {
+			return x509.ParseCertificate(block.Bytes)
+		}
+	}
+	return nil, fmt.Errorf("no PEM encoded certificate found in %s", corev1.TLSCertKey)
+}
@@ -0,0 +1,195 @@
+package kubernetes
+
+import (
+	"context"
+	"net"
+	"strconv"
+
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ServicesDescribe returns a summary of the Service with the provided name and its backing endpoints
+// (resolved from the EndpointSlices of the Service).
+func (c *Core) ServicesDescribe(ctx context.Context, namespace, name string) (map[string]any, error) {
+	namespace = c.NamespaceOrDefault(namespace)
+	service, err := c.CoreV1().Services(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	ports := make([]map[string]any, 0, len(service.Spec.Ports))
+	for _, port := range service.Spec.Ports {
+		p := map[string]any{
+			"Name":       port.Name,
+			"Protocol":   string(port.Protocol),
+			"Port":       port.Port,
+			"TargetPort": port.TargetPort.String(),
+		}
+		if port.NodePort != 0 {
+			p["NodePort"] = port.NodePort
+		}
+		ports = append(ports, p)
+	}
+	description := map[string]any{
+		"Name":      service.Name,
+		"Namespace": service.Namespace,
+		"Type":      string(service.Spec.Type),
+		"ClusterIP": service.Spec.ClusterIP,
+		"Ports":     ports,
+		"Selector":  service.Spec.Selector,
+	}
+	if len(service.Spec.ExternalIPs) > 0 {
+		description["ExternalIPs"] = service.Spec.ExternalIPs
+	}
+	if service.Spec.ExternalName != "" {
+		description["ExternalName"] = service.Spec.ExternalName
+	}
+	endpointSlices, err := c.DiscoveryV1().EndpointSlices(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: discoveryv1.LabelServiceName + "=" + service.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ready := make([]map[string]string, 0)
+	notReady := make([]map[string]string, 0)
+	for _, endpointSlice := range endpointSlices.Items {
+		for _, endpoint := range endpointSlice.Endpoints {
+			for _, address := range endpoint.Addresses {
+				e := map[string]string{"Address": address}
+				if endpoint.TargetRef != nil {
+					e["TargetRef"] = endpoint.TargetRef.Kind + "/" + endpoint.TargetRef.Name
+				}
+				if endpoint.NodeName != nil {
+					e["NodeName"] = *endpoint.NodeName
+				}
+				// A nil ready condition should be interpreted as ready (unknown state)
+				if endpoint.Conditions.Ready == nil || *endpoint.Conditions.Ready {
+					ready = append(ready, e)
+				} else {
+					notReady = append(notReady, e)
+				}
+			}
+		}
+	}
+	description["Endpoints"] = map[string]any{
+		"Ready":    ready,
+		"NotReady": notReady,
+	}
+	return description, nil
+}
+
+// ServiceEndpoint is the address a Service can be reached at.
+type ServiceEndpoint struct {
+	Name      string
+	Namespace string
+	Type      string
+	// Addresses are the reachable host:port addresses of the Service ports (the hostname for ExternalName Services)
+	Addresses []string
+	// Note explains how the addresses can be reached (e.g. only from within the cluster)
+	Note string `json:",omitempty"`
+}
+
+// ServicesEndpoint returns the reachable address of the Service with the provided name: the LoadBalancer ingress
+// IPs or hostnames, the node addresses with the NodePorts, or the ClusterIP (reachable from within the cluster only).
+func (c *Core) ServicesEndpoint(ctx context.Context, namespace, name string) (*ServiceEndpoint, error) {
+	namespace = c.NamespaceOrDefault(namespace)
+	service, err := c.CoreV1().Services(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	ret := &ServiceEndpoint{Name: service.Name, Namespace: service.Namespace, Type: string(service.Spec.Type), Addresses: make([]string, 0)}
+	switch service.Spec.Type {
+	case v1.ServiceTypeExternalName:
+		ret.Addresses = append(ret.Addresses, service.Spec.ExternalName)
+		ret.Note = "ExternalName Services are a DNS alias (CNAME) of the external hostname, no proxying is involved"
+		return ret, nil
+	case v1.ServiceTypeLoadBalancer:
+		for _, ingress := range service.Status.LoadBalancer.Ingress {
+			host := ingress.IP
+			if ingress.Hostname != "" {
+				host = ingress.Hostname
+			}
+			ret.Addresses = append(ret.Addresses, servicePortAddresses(host, service.Spec.Ports, false)...)
+		}
+		if len(ret.Addresses) > 0 {
+			break
+		}
+		ret.Note = "The LoadBalancer ingress isn't provisioned yet"
+		if !hasNodePorts(service.Spec.Ports) {
+			break
+		}
+		ret.Note += ", the Service is reachable through the NodePorts in the meantime"
+		fallthrough
+	case v1.ServiceTypeNodePort:
+		nodes, err := c.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes.Items {
+			if address := nodeAddress(&node); address != "" {
+				ret.Addresses = append(ret.Addresses, servicePortAddresses(address, service.Spec.Ports, true)...)
+			}
+		}
+		if ret.Note == "" {
+			ret.Note = "NodePort Services are reachable on the NodePorts of the cluster nodes"
+			if service.Spec.ExternalTrafficPolicy == v1.ServiceExternalTrafficPolicyLocal {
+				ret.Note += ", with externalTrafficPolicy Local only the nodes running a ready endpoint accept the traffic"
+			}
+		}
+	default:
+		if service.Spec.ClusterIP == v1.ClusterIPNone {
+			ret.Note = "Headless Services have no ClusterIP, their DNS name " + service.Name + "." + service.Namespace +
+				".svc resolves to the Pod IPs and is only reachable from within the cluster"
+			break
+		}
+		ret.Addresses = append(ret.Addresses, servicePortAddresses(service.Spec.ClusterIP, service.Spec.Ports, false)...)
+		ret.Note = "ClusterIP Services are only reachable from within the cluster, use port forwarding " +
+			"(e.g. kubectl port-forward service/" + service.Name + " <local-port>:<port>) or an Ingress or Route to reach it from outside"
+	}
+	for _, externalIP := range service.Spec.ExternalIPs {
+		ret.Addresses = append(ret.Addresses, servicePortAddresses(externalIP, service.Spec.Ports, false)...)
+	}
+	return ret, nil
+}
+
+// servicePortAddresses returns the host:port/protocol addresses of the provided ports (or of their NodePorts)
+func servicePortAddresses(host string, ports []v1.ServicePort, nodePorts bool) []string {
+	addresses := make([]string, 0, len(ports))
+	for _, port := range ports {
+		number := port.Port
+		if nodePorts {
+			if port.NodePort == 0 {
+				continue
+			}
+			number = port.NodePort
+		}
+		addresses = append(addresses, net.JoinHostPort(host, strconv.Itoa(int(number)))+"/"+string(port.Protocol))
+	}
+	return addresses
+}
+
+func hasNodePorts(ports []v1.ServicePort) bool {
+	for _, port := range ports {
+		if port.NodePort != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// nodeAddress returns the external IP of the node, or its internal IP if it has no external IP
+func nodeAddress(node *v1.Node) string {
+	internalIP := ""
+	for _, address := range node.Status.Addresses {
+		switch address.Type {
+		case v1.NodeExternalIP:
+			return address.Address
+		case v1.NodeInternalIP:
+			if internalIP == "" {
+				internalIP = address.Address
+			}
+		}
+	}
+	return internalIP
+}
@@ -0,0 +1,63 @@
+package kubernetes
+
+import (
+	"context"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// IsDefaultStorageClassAnnotation marks the default StorageClass of the cluster
+	IsDefaultStorageClassAnnotation = "storageclass.kubernetes.io/is-default-class"
+	// IsDefaultStorageClassBetaAnnotation is the deprecated beta version of IsDefaultStorageClassAnnotation
+	IsDefaultStorageClassBetaAnnotation = "storageclass.beta.kubernetes.io/is-default-class"
+)
+
+// StorageClassSummary is a summary of the provisioning settings of a StorageClass.
+type StorageClassSummary struct {
+	Name                 string
+	Provisioner          string
+	ReclaimPolicy        string
+	VolumeBindingMode    string
+	AllowVolumeExpansion bool
+	Default              bool
+}
+
+// StorageClassesList returns the provisioner, reclaim policy, volume binding mode, volume expansion support,
+// and whether it's the default class of the StorageClasses matching the provided label selector and provisioner (if not empty).
+func (c *Core) StorageClassesList(ctx context.Context, labelSelector, provisioner string) ([]StorageClassSummary, error) {
+	storageClasses, err := c.ResourcesList(ctx, &schema.GroupVersionKind{
+		Group: "storage.k8s.io", Version: "v1", Kind: "StorageClass",
+	}, "", api.ListOptions{ListOptions: metav1.ListOptions{LabelSelector: labelSelector}})
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]StorageClassSummary, 0)
+	err = storageClasses.EachListItem(func(obj runtime.Object) error {
+		storageClass := &storagev1.StorageClass{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.(*unstructured.Unstructured).Object, storageClass); err != nil {
+			return err
+		}
+		if provisioner != "" && storageClass.Provisioner != provisioner {
+			return nil
+		}
+		ret = append(ret, StorageClassSummary{
+			Name:                 storageClass.Name,
+			Provisioner:          storageClass.Provisioner,
+			ReclaimPolicy:        string(ptr.Deref(storageClass.ReclaimPolicy, corev1.PersistentVolumeReclaimDelete)),
+			VolumeBindingMode:    string(ptr.Deref(storageClass.VolumeBindingMode, storagev1.VolumeBindingImmediate)),
+			AllowVolumeExpansion: ptr.Deref(storageClass.AllowVolumeExpansion, false),
+			Default: storageClass.Annotations[IsDefaultStorageClassAnnotation] == "true" ||
+				storageClass.Annotations[IsDefaultStorageClassBetaAnnotation] == "true",
+		})
+		return nil
+	})
+	return ret, err
+}
@@ -2,6 +2,7 @@ package kubernetes
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"strings"
 
@@ -26,6 +27,33 @@ func ExchangeTokenInContext(
 	}
 	subjectToken := strings.TrimPrefix(auth, "Bearer ")
 
+	if !tokenExchangeBreakers.allow(target, cfg.TokenExchangeFailureThreshold) {
+		klog.FromContext(ctx).V(4).Info(fmt.Sprintf("token exchange skipped for target %q, too many consecutive failures", target))
+		return ctx
+	}
+
+	exchangedCtx, err := exchangeTokenInContext(ctx, cfg, oidcProvider, httpClient, provider, target, subjectToken)
+	if err != nil {
+		klog.FromContext(ctx).Error(err, fmt.Sprintf("token exchange failed for target %q", target))
+		if tokenExchangeBreakers.failure(target, cfg.TokenExchangeFailureThreshold, cfg.GetTokenExchangeCooldown()) {
+			klog.FromContext(ctx).Info(fmt.Sprintf("token exchange for target %q failed %d consecutive times, skipping it for %s",
+				target, cfg.TokenExchangeFailureThreshold, cfg.GetTokenExchangeCooldown()))
+		}
+		return ctx
+	}
+	tokenExchangeBreakers.success(target)
+	return exchangedCtx
+}
+
+func exchangeTokenInContext(
+	ctx context.Context,
+	cfg *config.StaticConfig,
+	oidcProvider *oidc.Provider,
+	httpClient *http.Client,
+	provider Provider,
+	target string,
+	subjectToken string,
+) (context.Context, error) {
 	tep, ok := provider.(TokenExchangeProvider)
 	if !ok {
 		return stsExchangeTokenInContext(ctx, cfg, oidcProvider, httpClient, subjectToken)
@@ -38,18 +66,22 @@ func ExchangeTokenInContext(
 
 	exchanger, ok := tokenexchange.GetTokenExchanger(tep.GetTokenExchangeStrategy())
 	if !ok {
-		klog.Warningf("token exchange strategy %q not found in registry", tep.GetTokenExchangeStrategy())
+		klog.FromContext(ctx).Info(fmt.Sprintf("token exchange strategy %q not found in registry", tep.GetTokenExchangeStrategy()))
 		return stsExchangeTokenInContext(ctx, cfg, oidcProvider, httpClient, subjectToken)
 	}
 
+	exCfg, err := exCfg.ForTarget(target)
+	if err != nil {
+		return ctx, err
+	}
+
 	exchanged, err := exchanger.Exchange(ctx, exCfg, subjectToken)
 	if err != nil {
-		klog.Errorf("token exchange failed for target %q: %v", target, err)
-		return ctx
+		return ctx, err
 	}
 
-	klog.V(4).Infof("token exchanged successfully for target %q", target)
-	return context.WithValue(ctx, OAuthAuthorizationHeader, "Bearer "+exchanged.AccessToken)
+	klog.FromContext(ctx).V(4).Info(fmt.Sprintf("token exchanged successfully for target %q", target))
+	return context.WithValue(ctx, OAuthAuthorizationHeader, "Bearer "+exchanged.AccessToken), nil
 }
 
 // TODO(Cali0707): remove this method and move to using the rfc8693 token exchanger for the global token exchange
@@ -59,10 +91,10 @@ func stsExchangeTokenInContext(
 	oidcProvider *oidc.Provider,
 	httpClient *http.Client,
 	token string,
-) context.Context {
+) (context.Context, error) {
 	sts := NewFromConfig(cfg, oidcProvider)
 	if !sts.IsEnabled() {
-		return ctx
+		return ctx, nil
 	}
 
 	if httpClient != nil {
@@ -74,8 +106,8 @@ func stsExchangeTokenInContext(
 		TokenType:   "Bearer",
 	})
 	if err != nil {
-		return ctx
+		return ctx, err
 	}
 
-	return context.WithValue(ctx, OAuthAuthorizationHeader, "Bearer "+exchangedToken.AccessToken)
+	return context.WithValue(ctx, OAuthAuthorizationHeader, "Bearer "+exchangedToken.AccessToken), nil
 }
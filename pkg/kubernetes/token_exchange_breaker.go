@@ -0,0 +1,75 @@
+package kubernetes
+
+import (
+	"sync"
+	"time"
+)
+
+// tokenExchangeBreakers tracks the token exchange failures for each target so that the exchange is not attempted
+// (and the original token is used as-is) while the identity provider is failing.
+var tokenExchangeBreakers = &tokenExchangeBreaker{now: time.Now, targets: make(map[string]*tokenExchangeBreakerState)}
+
+type tokenExchangeBreaker struct {
+	mu      sync.Mutex
+	now     func() time.Time
+	targets map[string]*tokenExchangeBreakerState
+}
+
+type tokenExchangeBreakerState struct {
+	// failures is the number of consecutive failures
+	failures int
+	// openUntil is the time until which the exchange is short-circuited (zero if the breaker is closed)
+	openUntil time.Time
+	// probing is true while the single exchange allowed after the cooldown (recovery probe) is in progress
+	probing bool
+}
+
+// allow returns true if the token exchange should be attempted for the provided target.
+// Once the cooldown of an open breaker expires, a single exchange is allowed to probe whether the identity provider
+// recovered, the rest of the exchanges are short-circuited until the probe completes.
+func (b *tokenExchangeBreaker) allow(target string, threshold int) bool {
+	if threshold <= 0 {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	state, ok := b.targets[target]
+	if !ok || state.openUntil.IsZero() {
+		return true
+	}
+	if state.probing || b.now().Before(state.openUntil) {
+		return false
+	}
+	state.probing = true
+	return true
+}
+
+// success records a successful token exchange for the provided target, closing its breaker.
+func (b *tokenExchangeBreaker) success(target string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.targets, target)
+}
+
+// failure records a failed token exchange for the provided target.
+// The breaker opens for the cooldown period once the threshold of consecutive failures is reached (or the recovery probe fails).
+// Returns true if the breaker was opened.
+func (b *tokenExchangeBreaker) failure(target string, threshold int, cooldown time.Duration) bool {
+	if threshold <= 0 {
+		return false
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	state, ok := b.targets[target]
+	if !ok {
+		state = &tokenExchangeBreakerState{}
+		b.targets[target] = state
+	}
+	state.failures++
+	state.probing = false
+	if state.failures < threshold {
+		return false
+	}
+	state.openUntil = b.now().Add(cooldown)
+	return true
+}
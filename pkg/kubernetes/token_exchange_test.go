@@ -0,0 +1,160 @@
+package kubernetes
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/config"
+	"github.com/containers/kubernetes-mcp-server/pkg/tokenexchange"
+	"github.com/stretchr/testify/suite"
+)
+
+type tokenExchangeTestProvider struct {
+	Provider
+	tokenURL string
+	audience string
+}
+
+func (p *tokenExchangeTestProvider) GetTokenExchangeConfig(_ string) *tokenexchange.TargetTokenExchangeConfig {
+	return &tokenexchange.TargetTokenExchangeConfig{TokenURL: p.tokenURL, Audience: p.audience}
+}
+
+func (p *tokenExchangeTestProvider) GetTokenExchangeStrategy() string {
+	return tokenexchange.StrategyRFC8693
+}
+
+type TokenExchangeTestSuite struct {
+	suite.Suite
+	idpFailing  atomic.Bool
+	idpCalls    atomic.Int32
+	idpAudience atomic.Value
+	idp         *httptest.Server
+	provider    *tokenExchangeTestProvider
+	now         time.Time
+}
+
+func (s *TokenExchangeTestSuite) SetupTest() {
+	s.idpFailing.Store(false)
+	s.idpCalls.Store(0)
+	s.idpAudience.Store("")
+	s.idp = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.idpCalls.Add(1)
+		s.idpAudience.Store(r.FormValue(tokenexchange.FormKeyAudience))
+		if s.idpFailing.Load() {
+			http.Error(w, "identity provider unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"exchanged-token","token_type":"Bearer"}`))
+	}))
+	s.provider = &tokenExchangeTestProvider{tokenURL: s.idp.URL, audience: "target-audience"}
+	s.now = time.Now()
+	tokenExchangeBreakers = &tokenExchangeBreaker{
+		now:     func() time.Time { return s.now },
+		targets: make(map[string]*tokenExchangeBreakerState),
+	}
+}
+
+func (s *TokenExchangeTestSuite) TearDownTest() {
+	s.idp.Close()
+	tokenExchangeBreakers = &tokenExchangeBreaker{now: time.Now, targets: make(map[string]*tokenExchangeBreakerState)}
+}
+
+func (s *TokenExchangeTestSuite) exchange(cfg *config.StaticConfig, target string) string {
+	ctx := context.WithValue(s.T().Context(), OAuthAuthorizationHeader, "Bearer original-token")
+	ctx = ExchangeTokenInContext(ctx, cfg, nil, nil, s.provider, target)
+	return ctx.Value(OAuthAuthorizationHeader).(string)
+}
+
+func (s *TokenExchangeTestSuite) TestExchangesToken() {
+	cfg := &config.StaticConfig{TokenExchangeFailureThreshold: 3}
+	s.Equal("Bearer exchanged-token", s.exchange(cfg, "a-target"))
+	s.Equal(int32(1), s.idpCalls.Load())
+}
+
+func (s *TokenExchangeTestSuite) TestExchangesTokenWithLiteralAudience() {
+	s.Equal("Bearer exchanged-token", s.exchange(&config.StaticConfig{}, "a-target"))
+	s.Equal("target-audience", s.idpAudience.Load())
+}
+
+func (s *TokenExchangeTestSuite) TestExchangesTokenWithTemplatedAudience() {
+	s.provider.audience = "{{target}}"
+	s.Run("expands the audience to the target name", func() {
+		s.Equal("Bearer exchanged-token", s.exchange(&config.StaticConfig{}, "cluster-a"))
+		s.Equal("cluster-a", s.idpAudience.Load())
+	})
+	s.Run("expands the audience for each target", func() {
+		s.Equal("Bearer exchanged-token", s.exchange(&config.StaticConfig{}, "cluster-b"))
+		s.Equal("cluster-b", s.idpAudience.Load())
+	})
+	s.Run("expands the placeholder within a larger audience", func() {
+		s.provider.audience = "api://{{target}}/kubernetes"
+		s.Equal("Bearer exchanged-token", s.exchange(&config.StaticConfig{}, "cluster-a"))
+		s.Equal("api://cluster-a/kubernetes", s.idpAudience.Load())
+	})
+}
+
+func (s *TokenExchangeTestSuite) TestBreakerDisabledByDefault() {
+	s.idpFailing.Store(true)
+	cfg := &config.StaticConfig{}
+	for i := 0; i < 10; i++ {
+		s.Equal("Bearer original-token", s.exchange(cfg, "a-target"))
+	}
+	s.Equal(int32(10), s.idpCalls.Load(), "expected the exchange to be attempted on every call")
+}
+
+func (s *TokenExchangeTestSuite) TestBreakerOpensAndRecovers() {
+	s.idpFailing.Store(true)
+	cfg := &config.StaticConfig{TokenExchangeFailureThreshold: 3, TokenExchangeCooldownSeconds: 60}
+	s.Run("failures below the threshold keep the breaker closed", func() {
+		for i := 0; i < 2; i++ {
+			s.Equal("Bearer original-token", s.exchange(cfg, "a-target"))
+		}
+		s.Equal(int32(2), s.idpCalls.Load())
+		s.True(tokenExchangeBreakers.allow("a-target", cfg.TokenExchangeFailureThreshold))
+	})
+	s.Run("reaching the threshold opens the breaker", func() {
+		s.Equal("Bearer original-token", s.exchange(cfg, "a-target"))
+		s.Equal(int32(3), s.idpCalls.Load())
+	})
+	s.Run("open breaker short-circuits the exchange and returns the original token", func() {
+		for i := 0; i < 5; i++ {
+			s.Equal("Bearer original-token", s.exchange(cfg, "a-target"))
+		}
+		s.Equal(int32(3), s.idpCalls.Load(), "expected no calls to the identity provider while the breaker is open")
+	})
+	s.Run("open breaker doesn't affect other targets", func() {
+		s.Equal("Bearer original-token", s.exchange(cfg, "another-target"))
+		s.Equal(int32(4), s.idpCalls.Load())
+	})
+	s.Run("failed recovery probe after the cooldown reopens the breaker", func() {
+		s.now = s.now.Add(61 * time.Second)
+		s.Equal("Bearer original-token", s.exchange(cfg, "a-target"))
+		s.Equal(int32(5), s.idpCalls.Load(), "expected a single recovery probe")
+		s.Equal("Bearer original-token", s.exchange(cfg, "a-target"))
+		s.Equal(int32(5), s.idpCalls.Load(), "expected no calls to the identity provider while the breaker is open")
+	})
+	s.Run("successful recovery probe closes the breaker", func() {
+		s.idpFailing.Store(false)
+		s.now = s.now.Add(61 * time.Second)
+		s.Equal("Bearer exchanged-token", s.exchange(cfg, "a-target"))
+		s.Equal(int32(6), s.idpCalls.Load())
+		s.Equal("Bearer exchanged-token", s.exchange(cfg, "a-target"))
+		s.Equal(int32(7), s.idpCalls.Load())
+	})
+}
+
+func (s *TokenExchangeTestSuite) TestBreakerOnlyAllowsOneRecoveryProbe() {
+	tokenExchangeBreakers.failure("a-target", 1, time.Minute)
+	s.now = s.now.Add(2 * time.Minute)
+	s.True(tokenExchangeBreakers.allow("a-target", 1), "expected the recovery probe to be allowed")
+	s.False(tokenExchangeBreakers.allow("a-target", 1), "expected concurrent exchanges to be short-circuited while probing")
+}
+
+func TestTokenExchange(t *testing.T) {
+	suite.Run(t, new(TokenExchangeTestSuite))
+}
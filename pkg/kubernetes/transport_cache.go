@@ -0,0 +1,76 @@
+package kubernetes
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/client-go/rest"
+)
+
+// derivedTransports caches the underlying (credential-less) transports used by derived clients.
+// Transports are keyed by target server and server verification TLS settings so that connections
+// are reused across derived clients for the same target.
+// Credentials (e.g. the bearer token) are never part of the cached transport, they're layered on top
+// of it for each derived client.
+var derivedTransports = &transportCache{transports: make(map[string]http.RoundTripper)}
+
+type transportCache struct {
+	mu         sync.Mutex
+	transports map[string]http.RoundTripper
+}
+
+// get returns the cached transport for the provided rest.Config or creates a new one.
+// Only the host and server verification TLS settings of the rest.Config are considered.
+// Returns nil if the rest.Config can't be cached (e.g. custom dialer or proxy).
+func (c *transportCache) get(restConfig *rest.Config) (http.RoundTripper, error) {
+	if restConfig.Dial != nil || restConfig.Proxy != nil || restConfig.Transport != nil {
+		return nil, nil
+	}
+	key := transportCacheKey(restConfig)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if t, ok := c.transports[key]; ok {
+		return t, nil
+	}
+	tlsConfig, err := rest.TLSConfigFor(&rest.Config{
+		Host: restConfig.Host,
+		TLSClientConfig: rest.TLSClientConfig{
+			Insecure:   restConfig.Insecure,
+			ServerName: restConfig.ServerName,
+			CAFile:     restConfig.CAFile,
+			CAData:     restConfig.CAData,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create TLS config for %s: %w", restConfig.Host, err)
+	}
+	t := utilnet.SetTransportDefaults(&http.Transport{
+		Proxy:               http.ProxyFromEnvironment,
+		TLSHandshakeTimeout: 10 * time.Second,
+		TLSClientConfig:     tlsConfig,
+		MaxIdleConnsPerHost: 25,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+	})
+	c.transports[key] = t
+	return t, nil
+}
+
+func transportCacheKey(restConfig *rest.Config) string {
+	caData := sha256.Sum256(restConfig.CAData)
+	return fmt.Sprintf("%s|%t|%s|%s|%s",
+		restConfig.Host,
+		restConfig.Insecure,
+		restConfig.ServerName,
+		restConfig.CAFile,
+		hex.EncodeToString(caData[:]),
+	)
+}
@@ -0,0 +1,61 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/klog/v2"
+)
+
+// WarmUpTargets initializes the clients and fills the discovery cache of every target of the provided Provider concurrently
+// (at most concurrency targets at a time, see ForEachTarget), so that the first tool call to each target doesn't pay the
+// connection and discovery setup cost.
+// Failures are logged (and returned) per target, a failing target doesn't prevent the other targets from being warmed up.
+// Once the provided context is done, the targets that weren't warmed up yet are skipped.
+func WarmUpTargets(ctx context.Context, p Provider, concurrency int) map[string]error {
+	targets, err := p.GetTargets(ctx)
+	if err != nil {
+		klog.Warningf("Failed to warm up targets, unable to get targets: %v", err)
+		return map[string]error{"": err}
+	}
+	errs := make(map[string]error)
+	results := ForEachTarget(ctx, targets, concurrency, func(ctx context.Context, target string) (struct{}, error) {
+		return struct{}{}, warmUpTarget(ctx, p, target)
+	})
+	for _, result := range results {
+		if result.Err != nil {
+			klog.Warningf("Failed to warm up target %q: %v", result.Target, result.Err)
+			errs[result.Target] = result.Err
+			continue
+		}
+		klog.V(2).Infof("Warmed up target %q", result.Target)
+	}
+	return errs
+}
+
+func warmUpTarget(ctx context.Context, p Provider, target string) error {
+	// The warm-up was cancelled (e.g. shutdown or kubeconfig reload), don't initialize the remaining targets
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	k, err := p.GetDerivedKubernetes(ctx, target)
+	if err != nil {
+		return err
+	}
+	_, _, err = k.DiscoveryClient().ServerGroupsAndResources()
+	return err
+}
+
+// ValidateDefaultTarget checks that the default target of the provided Provider is reachable by performing a discovery
+// request (server version), a stale default target (e.g. kubeconfig current-context) makes every default-target call fail.
+func ValidateDefaultTarget(ctx context.Context, p Provider) error {
+	target := p.GetDefaultTarget()
+	k, err := p.GetDerivedKubernetes(ctx, target)
+	if err == nil {
+		_, err = k.DiscoveryClient().ServerVersion()
+	}
+	if err != nil {
+		return fmt.Errorf("default target %q is unreachable: %w", target, err)
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+const (
+	WebhookTypeValidating = "Validating"
+	WebhookTypeMutating   = "Mutating"
+	// webhookDefaultTimeoutSeconds is the timeout applied by the API server when none is set
+	webhookDefaultTimeoutSeconds int32 = 10
+)
+
+// AdmissionWebhook describes a single webhook of a ValidatingWebhookConfiguration or MutatingWebhookConfiguration.
+type AdmissionWebhook struct {
+	Configuration string
+	Type          string
+	Name          string
+	FailurePolicy string
+	SideEffects   string `json:",omitempty"`
+	// Target is the Service (namespace/name:port/path) or the URL the admission requests are sent to
+	Target            string
+	TimeoutSeconds    int32
+	NamespaceSelector string `json:",omitempty"`
+	ObjectSelector    string `json:",omitempty"`
+	Rules             []string
+}
+
+// WebhooksList returns the webhooks of the ValidatingWebhookConfigurations and MutatingWebhookConfigurations
+// (or only those of the provided type, if not empty) with their rules, failure policy, and target.
+func (c *Core) WebhooksList(ctx context.Context, webhookType string) ([]AdmissionWebhook, error) {
+	ret := make([]AdmissionWebhook, 0)
+	if webhookType == "" || webhookType == WebhookTypeValidating {
+		configurations, err := c.webhookConfigurations(ctx, "ValidatingWebhookConfiguration")
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range configurations {
+			configuration := &admissionregistrationv1.ValidatingWebhookConfiguration{}
+			if err = runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, configuration); err != nil {
+				return nil, err
+			}
+			for _, webhook := range configuration.Webhooks {
+				ret = append(ret, AdmissionWebhook{
+					Configuration:     configuration.Name,
+					Type:              WebhookTypeValidating,
+					Name:              webhook.Name,
+					FailurePolicy:     string(ptr.Deref(webhook.FailurePolicy, admissionregistrationv1.Fail)),
+					SideEffects:       string(ptr.Deref(webhook.SideEffects, "")),
+					Target:            webhookClientConfigToString(webhook.ClientConfig),
+					TimeoutSeconds:    ptr.Deref(webhook.TimeoutSeconds, webhookDefaultTimeoutSeconds),
+					NamespaceSelector: webhookSelectorToString(webhook.NamespaceSelector),
+					ObjectSelector:    webhookSelectorToString(webhook.ObjectSelector),
+					Rules:             webhookRulesToStrings(webhook.Rules),
+				})
+			}
+		}
+	}
+	if webhookType == "" || webhookType == WebhookTypeMutating {
+		configurations, err := c.webhookConfigurations(ctx, "MutatingWebhookConfiguration")
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range configurations {
+			configuration := &admissionregistrationv1.MutatingWebhookConfiguration{}
+			if err = runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, configuration); err != nil {
+				return nil, err
+			}
+			for _, webhook := range configuration.Webhooks {
+				ret = append(ret, AdmissionWebhook{
+					Configuration:     configuration.Name,
+					Type:              WebhookTypeMutating,
+					Name:              webhook.Name,
+					FailurePolicy:     string(ptr.Deref(webhook.FailurePolicy, admissionregistrationv1.Fail)),
+					SideEffects:       string(ptr.Deref(webhook.SideEffects, "")),
+					Target:            webhookClientConfigToString(webhook.ClientConfig),
+					TimeoutSeconds:    ptr.Deref(webhook.TimeoutSeconds, webhookDefaultTimeoutSeconds),
+					NamespaceSelector: webhookSelectorToString(webhook.NamespaceSelector),
+					ObjectSelector:    webhookSelectorToString(webhook.ObjectSelector),
+					Rules:             webhookRulesToStrings(webhook.Rules),
+				})
+			}
+		}
+	}
+	return ret, nil
+}
+
+func (c *Core) webhookConfigurations(ctx context.Context, kind string) ([]unstructured.Unstructured, error) {
+	list, err := c.ResourcesList(ctx, &schema.GroupVersionKind{
+		Group: admissionregistrationv1.GroupName, Version: "v1", Kind: kind,
+	}, "", api.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var ret []unstructured.Unstructured
+	err = list.EachListItem(func(obj runtime.Object) error {
+		ret = append(ret, *obj.(*unstructured.Unstructured))
+		return nil
+	})
+	return ret, err
+}
+
+// webhookClientConfigToString summarizes the target of a webhook (e.g. "Service cert-manager/cert-manager-webhook:443/validate")
+func webhookClientConfigToString(clientConfig admissionregistrationv1.WebhookClientConfig) string {
+	if clientConfig.Service == nil {
+		return "URL " + ptr.Deref(clientConfig.URL, "")
+	}
+	ret := fmt.Sprintf("Service %s/%s:%d", clientConfig.Service.Namespace, clientConfig.Service.Name, ptr.Deref(clientConfig.Service.Port, 443))
+	return ret + ptr.Deref(clientConfig.Service.Path, "")
+}
+
+// webhookSelectorToString formats a namespace or object selector, empty if the webhook applies to every object
+func webhookSelectorToString(selector *metav1.LabelSelector) string {
+	if formatted := metav1.FormatLabelSelector(selector); formatted != "<none>" {
+		return formatted
+	}
+	return ""
+}
+
+// webhookRulesToStrings summarizes the rules of a webhook (e.g. "operations=CREATE,UPDATE resources=deployments.apps/v1 scope=*")
+func webhookRulesToStrings(rules []admissionregistrationv1.RuleWithOperations) []string {
+	ret := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		operations := make([]string, 0, len(rule.Operations))
+		for _, operation := range rule.Operations {
+			operations = append(operations, string(operation))
+		}
+		resources := make([]string, 0, len(rule.Resources)*len(rule.APIGroups)*len(rule.APIVersions))
+		for _, group := range rule.APIGroups {
+			for _, version := range rule.APIVersions {
+				for _, resource := range rule.Resources {
+					if group == "" {
+						resources = append(resources, resource+"/"+version)
+					} else {
+						resources = append(resources, resource+"."+group+"/"+version)
+					}
+				}
+			}
+		}
+		ret = append(ret, fmt.Sprintf("operations=%s resources=%s scope=%s",
+			strings.Join(operations, ","), strings.Join(resources, ","), string(ptr.Deref(rule.Scope, admissionregistrationv1.AllScopes))))
+	}
+	return ret
+}
@@ -0,0 +1,229 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+)
+
+// WorkloadKinds are the kinds of the workloads supported by WorkloadPodsSummary mapped to their GroupVersionKind.
+var WorkloadKinds = map[string]schema.GroupVersionKind{
+	"Deployment":  {Group: "apps", Version: "v1", Kind: "Deployment"},
+	"StatefulSet": {Group: "apps", Version: "v1", Kind: "StatefulSet"},
+	"DaemonSet":   {Group: "apps", Version: "v1", Kind: "DaemonSet"},
+	"ReplicaSet":  {Group: "apps", Version: "v1", Kind: "ReplicaSet"},
+	"Job":         {Group: "batch", Version: "v1", Kind: "Job"},
+}
+
+// WorkloadPodsSummary returns the aggregated status of the Pods managed by the workload of the provided kind and name.
+// The Pods are resolved using the label selector of the workload and summarized by phase, including their total number
+// of container restarts and the containers that are in CrashLoopBackOff.
+func (c *Core) WorkloadPodsSummary(ctx context.Context, kind, namespace, name string) (map[string]any, error) {
+	gvk, ok := WorkloadKinds[kind]
+	if !ok {
+		return nil, fmt.Errorf("unsupported workload kind %s", kind)
+	}
+	namespace = c.NamespaceOrDefault(namespace)
+	workload, err := c.ResourcesGet(ctx, &gvk, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	selectorMap, found, err := unstructured.NestedMap(workload.Object, "spec", "selector")
+	if err != nil || !found {
+		return nil, fmt.Errorf("%s %s has no label selector", kind, name)
+	}
+	labelSelector := &metav1.LabelSelector{}
+	if err = runtime.DefaultUnstructuredConverter.FromUnstructured(selectorMap, labelSelector); err != nil {
+		return nil, fmt.Errorf("failed to parse label selector of %s %s: %w", kind, name, err)
+	}
+	selector, err := metav1.LabelSelectorAsSelector(labelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid label selector of %s %s: %w", kind, name, err)
+	}
+	pods, err := c.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods for %s %s: %w", kind, name, err)
+	}
+	phases := map[string]int{
+		string(v1.PodRunning):   0,
+		string(v1.PodPending):   0,
+		string(v1.PodSucceeded): 0,
+		string(v1.PodFailed):    0,
+	}
+	restarts := int32(0)
+	crashLooping := make([]string, 0)
+	for _, pod := range pods.Items {
+		phase := string(pod.Status.Phase)
+		if phase == "" {
+			phase = string(v1.PodUnknown)
+		}
+		phases[phase]++
+		for _, status := range append(append([]v1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...) {
+			restarts += status.RestartCount
+			if status.State.Waiting != nil && status.State.Waiting.Reason == "CrashLoopBackOff" {
+				crashLooping = append(crashLooping, pod.Name+"/"+status.Name)
+			}
+		}
+	}
+	sort.Strings(crashLooping)
+	return map[string]any{
+		"Kind":             kind,
+		"Name":             name,
+		"Namespace":        namespace,
+		"Selector":         selector.String(),
+		"Pods":             len(pods.Items),
+		"Phases":           phases,
+		"Restarts":         restarts,
+		"CrashLoopBackOff": crashLooping,
+	}, nil
+}
+
+// WorkloadConfigRef is a ConfigMap or Secret referenced by the Pod template of a workload.
+type WorkloadConfigRef struct {
+	Kind   string
+	Name   string
+	Exists bool
+	// Optional is true if all the references to the ConfigMap or Secret are optional
+	Optional bool `json:",omitempty"`
+	// MissingKeys are the referenced keys (env valueFrom) that don't exist in the ConfigMap or Secret
+	MissingKeys []string `json:",omitempty"`
+	// References describe where the ConfigMap or Secret is referenced (e.g. "container app env DB_HOST", "volume config")
+	References []string
+}
+
+// WorkloadConfigRefs resolves the ConfigMaps and Secrets referenced by the Pod template of the Deployment with the
+// provided name (env valueFrom, envFrom, volumes, and projected volumes) and checks whether they (and the referenced
+// keys) exist. The values of the ConfigMaps and Secrets are never returned.
+func (c *Core) WorkloadConfigRefs(ctx context.Context, namespace, name string) ([]WorkloadConfigRef, error) {
+	gvk := WorkloadKinds["Deployment"]
+	namespace = c.NamespaceOrDefault(namespace)
+	workload, err := c.ResourcesGet(ctx, &gvk, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	deployment := &appsv1.Deployment{}
+	if err = runtime.DefaultUnstructuredConverter.FromUnstructured(workload.Object, deployment); err != nil {
+		return nil, fmt.Errorf("failed to parse Deployment %s: %w", name, err)
+	}
+	refs := collectConfigRefs(&deployment.Spec.Template.Spec)
+	for _, ref := range refs {
+		if err = c.resolveConfigRef(ctx, namespace, ref); err != nil {
+			return nil, err
+		}
+	}
+	ret := make([]WorkloadConfigRef, 0, len(refs))
+	for _, ref := range refs {
+		ret = append(ret, ref.WorkloadConfigRef)
+	}
+	return ret, nil
+}
+
+type configRef struct {
+	WorkloadConfigRef
+	keys []string
+}
+
+// collectConfigRefs returns the ConfigMaps and Secrets referenced by the provided Pod spec sorted by kind and name.
+func collectConfigRefs(spec *v1.PodSpec) []*configRef {
+	refs := make(map[string]*configRef)
+	add := func(kind, name string, optional *bool, key, reference string) {
+		if name == "" {
+			return
+		}
+		ref, ok := refs[kind+"/"+name]
+		if !ok {
+			ref = &configRef{WorkloadConfigRef: WorkloadConfigRef{Kind: kind, Name: name, Optional: true}}
+			refs[kind+"/"+name] = ref
+		}
+		ref.Optional = ref.Optional && ptr.Deref(optional, false)
+		if key != "" && !slices.Contains(ref.keys, key) {
+			ref.keys = append(ref.keys, key)
+		}
+		ref.References = append(ref.References, reference)
+	}
+	containers := append(append([]v1.Container{}, spec.InitContainers...), spec.Containers...)
+	for _, container := range containers {
+		for _, envFrom := range container.EnvFrom {
+			if envFrom.ConfigMapRef != nil {
+				add("ConfigMap", envFrom.ConfigMapRef.Name, envFrom.ConfigMapRef.Optional, "", "container "+container.Name+" envFrom")
+			}
+			if envFrom.SecretRef != nil {
+				add("Secret", envFrom.SecretRef.Name, envFrom.SecretRef.Optional, "", "container "+container.Name+" envFrom")
+			}
+		}
+		for _, env := range container.Env {
+			if env.ValueFrom == nil {
+				continue
+			}
+			if ref := env.ValueFrom.ConfigMapKeyRef; ref != nil {
+				add("ConfigMap", ref.Name, ref.Optional, ref.Key, "container "+container.Name+" env "+env.Name)
+			}
+			if ref := env.ValueFrom.SecretKeyRef; ref != nil {
+				add("Secret", ref.Name, ref.Optional, ref.Key, "container "+container.Name+" env "+env.Name)
+			}
+		}
+	}
+	for _, volume := range spec.Volumes {
+		if volume.ConfigMap != nil {
+			add("ConfigMap", volume.ConfigMap.Name, volume.ConfigMap.Optional, "", "volume "+volume.Name)
+		}
+		if volume.Secret != nil {
+			add("Secret", volume.Secret.SecretName, volume.Secret.Optional, "", "volume "+volume.Name)
+		}
+		if volume.Projected == nil {
+			continue
+		}
+		for _, source := range volume.Projected.Sources {
+			if source.ConfigMap != nil {
+				add("ConfigMap", source.ConfigMap.Name, source.ConfigMap.Optional, "", "volume "+volume.Name)
+			}
+			if source.Secret != nil {
+				add("Secret", source.Secret.Name, source.Secret.Optional, "", "volume "+volume.Name)
+			}
+		}
+	}
+	ret := make([]*configRef, 0, len(refs))
+	for _, ref := range refs {
+		ret = append(ret, ref)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Kind != ret[j].Kind {
+			return ret[i].Kind < ret[j].Kind
+		}
+		return ret[i].Name < ret[j].Name
+	})
+	return ret
+}
+
+// resolveConfigRef checks whether the referenced ConfigMap or Secret and its referenced keys exist.
+// Only errors other than NotFound (e.g. denied resources) are returned.
+func (c *Core) resolveConfigRef(ctx context.Context, namespace string, ref *configRef) error {
+	obj, err := c.ResourcesGet(ctx, &schema.GroupVersionKind{Version: "v1", Kind: ref.Kind}, namespace, ref.Name)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	ref.Exists = true
+	data, _, _ := unstructured.NestedMap(obj.Object, "data")
+	binaryData, _, _ := unstructured.NestedMap(obj.Object, "binaryData")
+	for _, key := range ref.keys {
+		_, inData := data[key]
+		_, inBinaryData := binaryData[key]
+		if !inData && !inBinaryData {
+			ref.MissingKeys = append(ref.MissingKeys, key)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"k8s.io/klog/v2"
+)
+
+const redactedArgumentValue = "[REDACTED]"
+
+// defaultRedactedArgumentKeys are the tool argument keys that are always redacted from the audit log and the tool call logs.
+var defaultRedactedArgumentKeys = []string{"password", "secret", "token", "authorization"}
+
+// redactedArgumentKeys returns the (lower-cased) default redacted argument keys and the provided additional ones.
+func redactedArgumentKeys(additionalKeys []string) []string {
+	keys := make([]string, 0, len(defaultRedactedArgumentKeys)+len(additionalKeys))
+	for _, key := range slices.Concat(defaultRedactedArgumentKeys, additionalKeys) {
+		keys = append(keys, strings.ToLower(key))
+	}
+	return keys
+}
+
+// redactArguments returns a copy of the provided arguments with the values of the redacted keys (case-insensitive,
+// including those of nested objects) replaced.
+func redactArguments(arguments map[string]any, redactedKeys []string) map[string]any {
+	if arguments == nil {
+		return nil
+	}
+	redacted := make(map[string]any, len(arguments))
+	for key, value := range arguments {
+		if slices.Contains(redactedKeys, strings.ToLower(key)) {
+			redacted[key] = redactedArgumentValue
+			continue
+		}
+		if nested, ok := value.(map[string]any); ok {
+			redacted[key] = redactArguments(nested, redactedKeys)
+			continue
+		}
+		redacted[key] = value
+	}
+	return redacted
+}
+
+// auditLogEntry is a single JSON line written to the audit log for every tool call.
+type auditLogEntry struct {
+	Timestamp  string         `json:"timestamp"`
+	Tool       string         `json:"tool"`
+	RequestID  string         `json:"request_id,omitempty"`
+	Arguments  map[string]any `json:"arguments,omitempty"`
+	Target     string         `json:"target,omitempty"`
+	Outcome    string         `json:"outcome"`
+	Error      string         `json:"error,omitempty"`
+	DurationMs int64          `json:"duration_ms"`
+}
+
+// auditLogger writes tool call audit entries as JSON lines to an append-only file.
+// The file can be reopened (e.g. after an external rotation) by calling reopen.
+type auditLogger struct {
+	mu           sync.Mutex
+	path         string
+	file         *os.File
+	redactedKeys []string
+}
+
+func newAuditLogger(path string, redactedKeys []string) (*auditLogger, error) {
+	a := &auditLogger{}
+	if err := a.reopen(path, redactedKeys); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// reopen closes the current audit log file (if any) and opens the file at the provided path.
+// An empty path disables the audit log.
+func (a *auditLogger) reopen(path string, redactedKeys []string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.file != nil {
+		_ = a.file.Close()
+		a.file = nil
+	}
+	a.path = path
+	a.redactedKeys = redactedArgumentKeys(redactedKeys)
+	if path == "" {
+		return nil
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open audit log file %s: %w", path, err)
+	}
+	a.file = file
+	return nil
+}
+
+func (a *auditLogger) close() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.file != nil {
+		_ = a.file.Close()
+		a.file = nil
+	}
+}
+
+func (a *auditLogger) enabled() bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.file != nil
+}
+
+func (a *auditLogger) write(entry *auditLogEntry) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.file == nil {
+		return
+	}
+	entry.Arguments = redactArguments(entry.Arguments, a.redactedKeys)
+	line, err := json.Marshal(entry)
+	if err != nil {
+		klog.Errorf("failed to marshal audit log entry for tool %s: %v", entry.Tool, err)
+		return
+	}
+	if _, err = a.file.Write(append(line, '\n')); err != nil {
+		klog.Errorf("failed to write audit log entry to %s: %v", a.path, err)
+	}
+}
+
+func (s *Server) auditLogMiddleware(next mcp.MethodHandler) mcp.MethodHandler {
+	return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		params, ok := req.GetParams().(*mcp.CallToolParamsRaw)
+		if !ok || !s.auditLog.enabled() {
+			return next(ctx, method, req)
+		}
+		start := time.Now()
+		result, err := next(ctx, method, req)
+		entry := &auditLogEntry{
+			Timestamp:  start.UTC().Format(time.RFC3339Nano),
+			Tool:       params.Name,
+			RequestID:  requestID(ctx),
+			Outcome:    "success",
+			DurationMs: time.Since(start).Milliseconds(),
+		}
+		if toolCallRequest, parseErr := GoSdkToolCallParamsToToolCallRequest(params); parseErr == nil {
+			entry.Arguments = toolCallRequest.GetArguments()
+			if s.p != nil {
+				entry.Target = toolCallRequest.GetString(s.GetTargetParameterName(), s.p.GetDefaultTarget())
+			}
+		}
+		if err != nil {
+			entry.Outcome = "error"
+			entry.Error = err.Error()
+		} else if toolResult, ok := result.(*mcp.CallToolResult); ok && toolResult.IsError {
+			entry.Outcome = "error"
+			for _, content := range toolResult.Content {
+				if text, ok := content.(*mcp.TextContent); ok {
+					entry.Error = text.Text
+					break
+				}
+			}
+		}
+		s.auditLog.write(entry)
+		return result, err
+	}
+}
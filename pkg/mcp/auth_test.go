@@ -0,0 +1,210 @@
+package mcp
+
+import (
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	authv1 "k8s.io/api/authorization/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+)
+
+type AuthSuite struct {
+	BaseMcpSuite
+}
+
+func (s *AuthSuite) TestAuthCanIAllowed() {
+	s.InitMcpClient()
+	s.Run("auth_can_i(verb=nil)", func() {
+		toolResult, err := s.CallTool("auth_can_i", map[string]interface{}{"resource": "pods"})
+		s.Require().Nil(err, "call tool should not return error object")
+		s.True(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to check access, missing argument verb", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("auth_can_i(verb=nil, resource=nil)", func() {
+		toolResult, err := s.CallTool("auth_can_i", map[string]interface{}{"verb": "list"})
+		s.Require().Nil(err, "call tool should not return error object")
+		s.True(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to check access, missing argument resource", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("auth_can_i(verb=delete, resource=deployments.apps, namespace=ns-1)", func() {
+		toolResult, err := s.CallTool("auth_can_i", map[string]interface{}{
+			"verb":      "delete",
+			"resource":  "deployments.apps",
+			"namespace": "ns-1",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed %v", toolResult.Content)
+		})
+		var decoded authv1.SubjectAccessReviewStatus
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "invalid tool result content %v", err)
+		})
+		s.Run("is allowed", func() {
+			s.True(decoded.Allowed, "expected access to be allowed")
+		})
+		s.Run("has reason", func() {
+			s.Contains(decoded.Reason, "allow-all", "expected reason to reference the allow-all binding")
+		})
+	})
+}
+
+func (s *AuthSuite) TestAuthCanIDenied() {
+	s.InitMcpClient()
+	defer restoreAuth(s.T().Context())
+	client := kubernetes.NewForConfigOrDie(envTestRestConfig)
+	// Authorize user only to list pods in the default namespace
+	r, _ := client.RbacV1().Roles("default").Create(s.T().Context(), &rbacv1.Role{
+		ObjectMeta: metav1.ObjectMeta{Name: "allow-pods-list-auth"},
+		Rules: []rbacv1.PolicyRule{{
+			Verbs:     []string{"list"},
+			APIGroups: []string{""},
+			Resources: []string{"pods"},
+		}},
+	}, metav1.CreateOptions{})
+	_, _ = client.RbacV1().RoleBindings("default").Create(s.T().Context(), &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "allow-pods-list-auth"},
+		Subjects:   []rbacv1.Subject{{Kind: "User", Name: envTestUser.Name}},
+		RoleRef:    rbacv1.RoleRef{Kind: "Role", Name: r.Name},
+	}, metav1.CreateOptions{})
+	defer func() {
+		_ = client.RbacV1().RoleBindings("default").Delete(s.T().Context(), "allow-pods-list-auth", metav1.DeleteOptions{})
+		_ = client.RbacV1().Roles("default").Delete(s.T().Context(), "allow-pods-list-auth", metav1.DeleteOptions{})
+	}()
+	// Deny cluster by removing cluster rule
+	_ = client.RbacV1().ClusterRoles().Delete(s.T().Context(), "allow-all", metav1.DeleteOptions{})
+	s.Run("auth_can_i(verb=list, resource=pods, namespace=default)", func() {
+		toolResult, err := s.CallTool("auth_can_i", map[string]interface{}{
+			"verb":      "list",
+			"resource":  "pods",
+			"namespace": "default",
+		})
+		s.Require().Nilf(err, "call tool failed %v", err)
+		s.Require().Falsef(toolResult.IsError, "call tool failed %v", toolResult.Content)
+		var decoded authv1.SubjectAccessReviewStatus
+		s.Require().NoError(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded))
+		s.True(decoded.Allowed, "expected access to be allowed")
+	})
+	s.Run("auth_can_i(verb=delete, resource=pods, namespace=default)", func() {
+		toolResult, err := s.CallTool("auth_can_i", map[string]interface{}{
+			"verb":      "delete",
+			"resource":  "pods",
+			"namespace": "default",
+		})
+		s.Require().Nilf(err, "call tool failed %v", err)
+		s.Require().Falsef(toolResult.IsError, "call tool failed %v", toolResult.Content)
+		var decoded authv1.SubjectAccessReviewStatus
+		s.Require().NoError(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded))
+		s.False(decoded.Allowed, "expected access to be denied")
+	})
+	s.Run("auth_can_i(verb=list, resource=pods, namespace=ns-1)", func() {
+		toolResult, err := s.CallTool("auth_can_i", map[string]interface{}{
+			"verb":      "list",
+			"resource":  "pods",
+			"namespace": "ns-1",
+		})
+		s.Require().Nilf(err, "call tool failed %v", err)
+		s.Require().Falsef(toolResult.IsError, "call tool failed %v", toolResult.Content)
+		var decoded authv1.SubjectAccessReviewStatus
+		s.Require().NoError(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded))
+		s.False(decoded.Allowed, "expected access to be denied")
+	})
+}
+
+func (s *AuthSuite) TestRbacForSubject() {
+	client := kubernetes.NewForConfigOrDie(envTestRestConfig)
+	_, _ = client.RbacV1().Roles("ns-1").Create(s.T().Context(), &rbacv1.Role{
+		ObjectMeta: metav1.ObjectMeta{Name: "configmap-reader"},
+		Rules: []rbacv1.PolicyRule{{
+			Verbs:         []string{"get", "list"},
+			APIGroups:     []string{""},
+			Resources:     []string{"configmaps"},
+			ResourceNames: []string{"a-config"},
+		}},
+	}, metav1.CreateOptions{})
+	_, _ = client.RbacV1().RoleBindings("ns-1").Create(s.T().Context(), &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "configmap-reader"},
+		Subjects:   []rbacv1.Subject{{Kind: "ServiceAccount", Name: "a-service-account", Namespace: "ns-1"}},
+		RoleRef:    rbacv1.RoleRef{Kind: "Role", Name: "configmap-reader"},
+	}, metav1.CreateOptions{})
+	s.InitMcpClient()
+	s.Run("rbac_for_subject(kind=nil)", func() {
+		toolResult, err := s.CallTool("rbac_for_subject", map[string]interface{}{"name": "test:users"})
+		s.Require().Nil(err, "call tool should not return error object")
+		s.True(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to get RBAC bindings, missing argument kind", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("rbac_for_subject(kind=Robot)", func() {
+		toolResult, err := s.CallTool("rbac_for_subject", map[string]interface{}{"kind": "Robot", "name": "r2d2"})
+		s.Require().Nil(err, "call tool should not return error object")
+		s.True(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to get RBAC bindings for Robot r2d2: unsupported subject kind Robot, supported kinds are: User, Group, ServiceAccount",
+			toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("rbac_for_subject(kind=Group, name=test:users)", func() {
+		toolResult, err := s.CallTool("rbac_for_subject", map[string]interface{}{"kind": "Group", "name": envTestUser.Groups[0]})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed %v", toolResult.Content)
+		})
+		var decoded []map[string]any
+		s.Require().NoError(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded), "invalid tool result content")
+		s.Run("returns the allow-all ClusterRoleBinding", func() {
+			s.Require().Len(decoded, 1)
+			s.Equal("ClusterRoleBinding", decoded[0]["Kind"])
+			s.Equal("allow-all", decoded[0]["Name"])
+			s.Equal("ClusterRole/allow-all", decoded[0]["RoleRef"])
+		})
+		s.Run("summarizes the granted rules", func() {
+			s.Equal([]any{"verbs=* resources=*.*"}, decoded[0]["Rules"])
+		})
+	})
+	s.Run("rbac_for_subject(kind=ServiceAccount, name=a-service-account, namespace=ns-1)", func() {
+		toolResult, err := s.CallTool("rbac_for_subject", map[string]interface{}{"kind": "ServiceAccount", "name": "a-service-account", "namespace": "ns-1"})
+		s.Require().Nilf(err, "call tool failed %v", err)
+		s.Require().Falsef(toolResult.IsError, "call tool failed %v", toolResult.Content)
+		var decoded []map[string]any
+		s.Require().NoError(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded), "invalid tool result content")
+		s.Require().Len(decoded, 1)
+		s.Equal("RoleBinding", decoded[0]["Kind"])
+		s.Equal("configmap-reader", decoded[0]["Name"])
+		s.Equal("ns-1", decoded[0]["Namespace"])
+		s.Equal("Role/configmap-reader", decoded[0]["RoleRef"])
+		s.Equal([]any{"verbs=get,list resources=configmaps resourceNames=a-config"}, decoded[0]["Rules"])
+	})
+	s.Run("rbac_for_subject(kind=ServiceAccount, name=a-service-account, namespace=ns-2)", func() {
+		toolResult, err := s.CallTool("rbac_for_subject", map[string]interface{}{"kind": "ServiceAccount", "name": "a-service-account", "namespace": "ns-2"})
+		s.Require().Nilf(err, "call tool failed %v", err)
+		s.Require().Falsef(toolResult.IsError, "call tool failed %v", toolResult.Content)
+		s.Equal("# No RBAC bindings found for ServiceAccount a-service-account", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+}
+
+func (s *AuthSuite) TestRbacForSubjectDenied() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		denied_resources = [ { group = "rbac.authorization.k8s.io", version = "v1", kind = "ClusterRoleBinding" } ]
+	`), s.Cfg), "Expected to parse denied resources config")
+	s.InitMcpClient()
+	s.Run("rbac_for_subject (denied)", func() {
+		toolResult, err := s.CallTool("rbac_for_subject", map[string]interface{}{"kind": "Group", "name": envTestUser.Groups[0]})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes denial", func() {
+			msg := toolResult.Content[0].(mcp.TextContent).Text
+			expectedMessage := "failed to get RBAC bindings for Group test:users:(.+:)? resource not allowed: rbac.authorization.k8s.io/v1, Kind=ClusterRoleBinding"
+			s.Regexpf(expectedMessage, msg, "expected descriptive error '%s', got %v", expectedMessage, msg)
+		})
+	})
+}
+
+func TestAuth(t *testing.T) {
+	suite.Run(t, new(AuthSuite))
+}
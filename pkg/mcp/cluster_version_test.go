@@ -0,0 +1,113 @@
+package mcp
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type ClusterVersionSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+}
+
+func (s *ClusterVersionSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+}
+
+func (s *ClusterVersionSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+// handleCluster registers the handlers of the version, readiness, and component status endpoints
+func (s *ClusterVersionSuite) handleCluster() {
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/version":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"major": "1", "minor": "33", "gitVersion": "v1.33.1", "buildDate": "2025-05-15T08:19:30Z", "platform": "linux/amd64"}`))
+		case "/readyz":
+			_, _ = w.Write([]byte("ok"))
+		case "/api/v1/componentstatuses":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "ComponentStatusList", "items": [
+				{"metadata": {"name": "etcd-0"}, "conditions": [{"type": "Healthy", "status": "True", "message": "ok"}]},
+				{"metadata": {"name": "scheduler"}, "conditions": [{"type": "Healthy", "status": "False", "error": "connection refused"}]}
+			]}`))
+		}
+	}))
+}
+
+func (s *ClusterVersionSuite) TestClusterVersion() {
+	s.mockServer.Handle(test.NewDiscoveryClientHandler())
+	s.handleCluster()
+	s.InitMcpClient()
+	toolResult, err := s.CallTool("cluster_version", map[string]interface{}{})
+	s.Run("no error", func() {
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+	})
+	textContent := toolResult.Content[0].(mcp.TextContent).Text
+	s.Run("returns header", func() {
+		s.Regexp(`^# Cluster version \(YAML format\):\n`, textContent)
+	})
+	s.Run("returns the Kubernetes version", func() {
+		s.Contains(textContent, "KubernetesVersion: v1.33.1")
+		s.Contains(textContent, "Platform: linux/amd64")
+		s.Regexp(`BuildDate: "?2025-05-15T08:19:30Z"?\n`, textContent)
+	})
+	s.Run("returns the API server readiness", func() {
+		s.Contains(textContent, "APIServerReadiness: ok")
+	})
+	s.Run("returns the component health", func() {
+		s.Regexp(`- Healthy: true\n\s+Message: ok\n\s+Name: etcd-0`, textContent)
+		s.Regexp(`- Error: connection refused\n\s+Healthy: false\n\s+Name: scheduler`, textContent)
+	})
+	s.Run("omits the OpenShift version", func() {
+		s.NotContains(textContent, "OpenShiftVersion")
+	})
+}
+
+func (s *ClusterVersionSuite) TestClusterVersionOpenShift() {
+	s.mockServer.Handle(test.NewInOpenShiftHandler(metav1.APIResourceList{
+		GroupVersion: "config.openshift.io/v1",
+		APIResources: []metav1.APIResource{
+			{Name: "clusterversions", Kind: "ClusterVersion", Namespaced: false, Verbs: metav1.Verbs{"get", "list", "watch"}},
+		},
+	}))
+	s.handleCluster()
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/apis/config.openshift.io/v1/clusterversions/version" {
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"apiVersion": "config.openshift.io/v1", "kind": "ClusterVersion", "metadata": {"name": "version"},
+			"status": {"desired": {"version": "4.19.2"}}}`))
+	}))
+	s.InitMcpClient()
+	toolResult, err := s.CallTool("cluster_version", map[string]interface{}{})
+	s.Run("no error", func() {
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+	})
+	textContent := toolResult.Content[0].(mcp.TextContent).Text
+	s.Run("returns the Kubernetes version", func() {
+		s.Contains(textContent, "KubernetesVersion: v1.33.1")
+	})
+	s.Run("returns the OpenShift version", func() {
+		s.Contains(textContent, "OpenShiftVersion: 4.19.2")
+	})
+}
+
+func TestClusterVersion(t *testing.T) {
+	suite.Run(t, new(ClusterVersionSuite))
+}
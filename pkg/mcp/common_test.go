@@ -208,7 +208,7 @@ func (s *BaseMcpSuite) TearDownTest() {
 
 func (s *BaseMcpSuite) InitMcpClient(options ...transport.StreamableHTTPCOption) {
 	var err error
-	s.mcpServer, err = NewServer(Configuration{StaticConfig: s.Cfg}, nil, nil)
+	s.mcpServer, err = NewServer(s.T().Context(), Configuration{StaticConfig: s.Cfg}, nil, nil)
 	s.Require().NoError(err, "Expected no error creating MCP server")
 	s.McpClient = test.NewMcpClient(s.T(), s.mcpServer.ServeHTTP(), options...)
 }
@@ -2,11 +2,13 @@ package mcp
 
 import (
 	"fmt"
+	"path/filepath"
 	"testing"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/stretchr/testify/suite"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	v1 "k8s.io/client-go/tools/clientcmd/api/v1"
 	"sigs.k8s.io/yaml"
@@ -57,6 +59,152 @@ func (s *ConfigurationSuite) TestContextsList() {
 	})
 }
 
+func (s *ConfigurationSuite) TestExcludedContexts() {
+	s.Cfg.ExcludedContexts = []string{"cluster-[1-9]"}
+	s.InitMcpClient()
+	s.Run("configuration_contexts_list", func() {
+		toolResult, err := s.CallTool("configuration_contexts_list", map[string]interface{}{})
+		s.Require().Nilf(err, "call tool failed %v", err)
+		s.Require().Falsef(toolResult.IsError, "call tool failed %v", toolResult.Content)
+		text := toolResult.Content[0].(mcp.TextContent).Text
+		s.Run("contains the not excluded contexts", func() {
+			s.Regexpf(`^Available Kubernetes contexts \(2 total, default: fake-context\)`, text, "invalid tool result content %v", text)
+			s.Containsf(text, " cluster-0 -> ", "invalid tool result content %v", text)
+		})
+		s.Run("doesn't contain the excluded contexts", func() {
+			s.NotContainsf(text, "cluster-1 ", "invalid tool result content %v", text)
+			s.NotContainsf(text, "cluster-9 ", "invalid tool result content %v", text)
+		})
+	})
+	s.Run("configuration_contexts_describe doesn't contain the excluded contexts", func() {
+		toolResult, err := s.CallTool("configuration_contexts_describe", map[string]interface{}{})
+		s.Require().Nilf(err, "call tool failed %v", err)
+		s.Require().Falsef(toolResult.IsError, "call tool failed %v", toolResult.Content)
+		var decoded []map[string]any
+		s.Require().NoError(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded))
+		s.Require().Len(decoded, 2)
+		s.Equal("cluster-0", decoded[0]["Name"])
+		s.Equal("fake-context", decoded[1]["Name"])
+	})
+	s.Run("configuration_view(minified=false) doesn't contain the excluded contexts", func() {
+		toolResult, err := s.CallTool("configuration_view", map[string]interface{}{"minified": false})
+		s.Require().Nilf(err, "call tool failed %v", err)
+		s.Require().Falsef(toolResult.IsError, "call tool failed %v", toolResult.Content)
+		var decoded *v1.Config
+		s.Require().NoError(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded))
+		s.Len(decoded.Contexts, 2, "Expected only the not excluded contexts")
+		s.Len(decoded.Clusters, 2, "Expected only the clusters of the not excluded contexts")
+		s.NotContains(toolResult.Content[0].(mcp.TextContent).Text, "cluster-1-cluster")
+	})
+}
+
+func (s *ConfigurationSuite) TestContextsListMultipleKubeconfigFiles() {
+	// Both files use the same context, cluster and user names for different clusters
+	mockServerA := test.NewMockServer()
+	s.T().Cleanup(mockServerA.Close)
+	mockServerB := test.NewMockServer()
+	s.T().Cleanup(mockServerB.Close)
+	kubeconfigDir := s.T().TempDir()
+	clusterAFile := filepath.Join(kubeconfigDir, "cluster-a.yaml")
+	s.Require().NoError(clientcmd.WriteToFile(*mockServerA.Kubeconfig(), clusterAFile))
+	clusterB := mockServerB.Kubeconfig()
+	clusterB.Contexts["cluster-b-admin"] = clusterB.Contexts["fake-context"].DeepCopy()
+	clusterBFile := filepath.Join(kubeconfigDir, "cluster-b.yaml")
+	s.Require().NoError(clientcmd.WriteToFile(*clusterB, clusterBFile))
+	s.Cfg.KubeConfig = ""
+	s.Cfg.KubeConfigs = []string{clusterAFile, clusterBFile}
+	s.InitMcpClient()
+	s.Run("configuration_contexts_list", func() {
+		toolResult, err := s.CallTool("configuration_contexts_list", map[string]interface{}{})
+		s.Require().Nilf(err, "call tool failed %v", err)
+		s.Require().Falsef(toolResult.IsError, "call tool failed %v", toolResult.Content)
+		text := toolResult.Content[0].(mcp.TextContent).Text
+		s.Run("contains the targets of all the kubeconfig files", func() {
+			s.Regexpf(`^Available Kubernetes contexts \(3 total, default: fake-context\)`, text, "invalid tool result content %v", text)
+			s.Regexpf(`(?m)^\*fake-context -> http:\/\/127\.0\.0\.1:\d*$`, text, "invalid tool result content %v", text)
+			s.Containsf(text, " cluster-b-admin -> "+mockServerB.Config().Host+"\n", "invalid tool result content %v", text)
+		})
+		s.Run("contains the colliding context prefixed with the file name", func() {
+			s.Containsf(text, " cluster-b:fake-context -> "+mockServerB.Config().Host+"\n", "invalid tool result content %v", text)
+		})
+	})
+}
+
+func (s *ConfigurationSuite) TestContextsDescribe() {
+	mockServer := test.NewMockServer()
+	s.T().Cleanup(mockServer.Close)
+	kubeconfig := mockServer.Kubeconfig()
+	kubeconfig.AuthInfos["fake"].Token = "super-secret-token"
+	kubeconfig.Clusters["production"] = clientcmdapi.NewCluster()
+	kubeconfig.Clusters["production"].Server = "https://production.example.com:6443"
+	kubeconfig.AuthInfos["production-admin"] = clientcmdapi.NewAuthInfo()
+	kubeconfig.AuthInfos["production-admin"].Token = "another-secret-token"
+	kubeconfig.AuthInfos["production-admin"].Password = "a-secret-password"
+	kubeconfig.Contexts["production"] = clientcmdapi.NewContext()
+	kubeconfig.Contexts["production"].Cluster = "production"
+	kubeconfig.Contexts["production"].AuthInfo = "production-admin"
+	kubeconfig.Contexts["production"].Namespace = "apps"
+	s.Cfg.KubeConfig = test.KubeconfigFile(s.T(), kubeconfig)
+	s.InitMcpClient()
+	s.Run("configuration_contexts_describe", func() {
+		toolResult, err := s.CallTool("configuration_contexts_describe", map[string]interface{}{})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed %v", toolResult.Content)
+		})
+		text := toolResult.Content[0].(mcp.TextContent).Text
+		var decoded []map[string]any
+		err = yaml.Unmarshal([]byte(text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "invalid tool result content %v", err)
+		})
+		s.Run("returns each context with its cluster, server URL, and namespace", func() {
+			s.Require().Len(decoded, 2)
+			s.Equal("fake-context", decoded[0]["Name"])
+			s.Equal("fake", decoded[0]["Cluster"])
+			s.Regexp(`^https?://127\.0\.0\.1:\d+$`, decoded[0]["Server"])
+			s.Equal("default", decoded[0]["Namespace"])
+			s.Equal(true, decoded[0]["Default"])
+			s.Equal(map[string]any{
+				"Name":      "production",
+				"Cluster":   "production",
+				"Server":    "https://production.example.com:6443",
+				"Namespace": "apps",
+				"Default":   false,
+			}, decoded[1])
+		})
+		s.Run("does not expose credentials", func() {
+			for _, secret := range []string{"super-secret-token", "another-secret-token", "a-secret-password", "production-admin"} {
+				s.NotContains(text, secret)
+			}
+		})
+	})
+}
+
+func (s *ConfigurationSuite) TestContextsDescribeInCluster() {
+	s.Cfg.KubeConfig = "" // Force in-cluster
+	kubernetes.InClusterConfig = func() (*rest.Config, error) {
+		return &rest.Config{
+			Host:        "https://kubernetes.default.svc",
+			BearerToken: "fake-token",
+		}, nil
+	}
+	s.T().Cleanup(func() { kubernetes.InClusterConfig = rest.InClusterConfig })
+	s.InitMcpClient()
+	s.Run("configuration_contexts_describe returns a single entry", func() {
+		toolResult, err := s.CallTool("configuration_contexts_describe", map[string]interface{}{})
+		s.Require().Nilf(err, "call tool failed %v", err)
+		s.Require().Falsef(toolResult.IsError, "call tool failed %v", toolResult.Content)
+		text := toolResult.Content[0].(mcp.TextContent).Text
+		var decoded []map[string]any
+		s.Require().NoError(yaml.Unmarshal([]byte(text), &decoded))
+		s.Require().Len(decoded, 1)
+		s.Equal("in-cluster", decoded[0]["Name"])
+		s.Equal("https://kubernetes.default.svc", decoded[0]["Server"])
+		s.NotContains(text, "fake-token")
+	})
+}
+
 func (s *ConfigurationSuite) TestConfigurationView() {
 	s.InitMcpClient()
 	s.Run("configuration_view", func() {
@@ -0,0 +1,132 @@
+package mcp
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+)
+
+type CustomResourceDefinitionsSuite struct {
+	BaseMcpSuite
+}
+
+func (s *CustomResourceDefinitionsSuite) TestCrdsList() {
+	s.InitMcpClient()
+	s.Run("crds_list()", func() {
+		toolResult, err := s.CallTool("crds_list", map[string]interface{}{})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		textContent := toolResult.Content[0].(mcp.TextContent).Text
+		s.Run("returns table header", func() {
+			s.Regexp(`^NAME\s+GROUP\s+KIND\s+SCOPE\s+SHORTNAMES\s+SERVED\s+STORAGE\n`, textContent)
+		})
+		s.Run("returns the OpenShift CRDs", func() {
+			s.Regexp(`(?m)^projects\.project\.openshift\.io\s+project\.openshift\.io\s+Project\s+Cluster\s+`, textContent)
+			s.Regexp(`(?m)^routes\.route\.openshift\.io\s+route\.openshift\.io\s+Route\s+Namespaced\s+`, textContent)
+		})
+		s.Run("returns the Kubevirt CRDs", func() {
+			s.Regexp(`(?m)^virtualmachines\.kubevirt\.io\s+kubevirt\.io\s+VirtualMachine\s+Namespaced\s+.+\s+v1\n`, textContent)
+			s.Regexp(`(?m)^datasources\.cdi\.kubevirt\.io\s+cdi\.kubevirt\.io\s+DataSource\s+Namespaced\s+.+\s+v1beta1\n`, textContent)
+			s.Regexp(`(?m)^virtualmachineclusterinstancetypes\.instancetype\.kubevirt\.io\s+instancetype\.kubevirt\.io\s+VirtualMachineClusterInstancetype\s+Cluster\s+`, textContent)
+		})
+	})
+	s.Run("crds_list(group=instancetype.kubevirt.io)", func() {
+		toolResult, err := s.CallTool("crds_list", map[string]interface{}{"group": "instancetype.kubevirt.io"})
+		s.Require().Nilf(err, "call tool failed %v", err)
+		s.Require().Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		textContent := toolResult.Content[0].(mcp.TextContent).Text
+		s.Contains(textContent, "virtualmachineinstancetypes.instancetype.kubevirt.io")
+		s.Contains(textContent, "virtualmachinepreferences.instancetype.kubevirt.io")
+		s.NotContains(textContent, "routes.route.openshift.io")
+		s.NotContains(textContent, "virtualmachines.kubevirt.io")
+	})
+	s.Run("crds_list(group=non-existent.example.com)", func() {
+		toolResult, err := s.CallTool("crds_list", map[string]interface{}{"group": "non-existent.example.com"})
+		s.Require().Nilf(err, "call tool failed %v", err)
+		s.Require().Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Equal("No CustomResourceDefinitions found", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+}
+
+func (s *CustomResourceDefinitionsSuite) TestCrdsDescribe() {
+	s.InitMcpClient()
+	s.Run("crds_describe(name=routes.route.openshift.io)", func() {
+		toolResult, err := s.CallTool("crds_describe", map[string]interface{}{"name": "routes.route.openshift.io"})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		textContent := toolResult.Content[0].(mcp.TextContent).Text
+		s.Run("returns header", func() {
+			s.Truef(regexp.MustCompile(`^# CustomResourceDefinition routes.route.openshift.io \(YAML format\):\n`).MatchString(textContent),
+				"expected header, got %v", textContent)
+		})
+		s.Run("returns the names and scope", func() {
+			s.Contains(textContent, "Group: route.openshift.io")
+			s.Contains(textContent, "Kind: Route")
+			s.Contains(textContent, "Plural: routes")
+			s.Contains(textContent, "Singular: route")
+			s.Contains(textContent, "Scope: Namespaced")
+		})
+		s.Run("returns the versions", func() {
+			s.Contains(textContent, "Name: v1")
+			s.Contains(textContent, "Storage: true")
+		})
+		s.Run("returns the schema summary", func() {
+			s.Contains(textContent, "(schemaless, preserves unknown fields)")
+		})
+		s.Run("returns the conditions", func() {
+			s.Contains(textContent, "NamesAccepted=True")
+		})
+	})
+	s.Run("crds_describe(name=nil)", func() {
+		toolResult, _ := s.CallTool("crds_describe", map[string]interface{}{})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to describe crd, missing argument name", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("crds_describe(name=non-existent.example.com)", func() {
+		toolResult, _ := s.CallTool("crds_describe", map[string]interface{}{"name": "non-existent.example.com"})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Contains(toolResult.Content[0].(mcp.TextContent).Text, "failed to describe crd non-existent.example.com:")
+	})
+}
+
+func (s *CustomResourceDefinitionsSuite) TestCrdsDenied() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		denied_resources = [ { group = "apiextensions.k8s.io", version = "v1" } ]
+	`), s.Cfg), "Expected to parse denied resources config")
+	s.InitMcpClient()
+	s.Run("crds_list (denied)", func() {
+		toolResult, err := s.CallTool("crds_list", map[string]interface{}{})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes denial", func() {
+			msg := toolResult.Content[0].(mcp.TextContent).Text
+			expectedMessage := "failed to list crds:(.+:)? resource not allowed: apiextensions.k8s.io/v1, Kind=CustomResourceDefinition"
+			s.Regexpf(expectedMessage, msg, "expected descriptive error '%s', got %v", expectedMessage, msg)
+		})
+	})
+	s.Run("crds_describe (denied)", func() {
+		toolResult, err := s.CallTool("crds_describe", map[string]interface{}{"name": "routes.route.openshift.io"})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes denial", func() {
+			msg := toolResult.Content[0].(mcp.TextContent).Text
+			expectedMessage := "failed to describe crd routes.route.openshift.io:(.+:)? resource not allowed: apiextensions.k8s.io/v1, Kind=CustomResourceDefinition"
+			s.Regexpf(expectedMessage, msg, "expected descriptive error '%s', got %v", expectedMessage, msg)
+		})
+	})
+}
+
+func TestCustomResourceDefinitions(t *testing.T) {
+	suite.Run(t, new(CustomResourceDefinitionsSuite))
+}
@@ -0,0 +1,117 @@
+package mcp
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+type CronJobsSuite struct {
+	BaseMcpSuite
+}
+
+func (s *CronJobsSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	kc := kubernetes.NewForConfigOrDie(envTestRestConfig)
+	_, _ = kc.BatchV1().CronJobs("default").Create(s.T().Context(), &batchv1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{Name: "a-cronjob"},
+		Spec: batchv1.CronJobSpec{
+			Schedule: "0 0 * * *",
+			JobTemplate: batchv1.JobTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "a-cronjob"}},
+				Spec: batchv1.JobSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							RestartPolicy: corev1.RestartPolicyNever,
+							Containers:    []corev1.Container{{Name: "job", Image: "busybox", Command: []string{"echo", "hello"}}},
+						},
+					},
+				},
+			},
+		},
+	}, metav1.CreateOptions{})
+}
+
+func (s *CronJobsSuite) TestCronJobsTrigger() {
+	s.InitMcpClient()
+	kc := kubernetes.NewForConfigOrDie(envTestRestConfig)
+	s.Run("cronjobs_trigger with missing name returns error", func() {
+		toolResult, _ := s.CallTool("cronjobs_trigger", map[string]interface{}{})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to trigger cronjob, missing argument name", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("cronjobs_trigger with nonexistent cronjob returns error", func() {
+		toolResult, _ := s.CallTool("cronjobs_trigger", map[string]interface{}{"name": "nonexistent", "namespace": "default"})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Contains(toolResult.Content[0].(mcp.TextContent).Text, "failed to trigger cronjob nonexistent in namespace default:")
+	})
+	s.Run("cronjobs_trigger(name=a-cronjob, job_name=a-cronjob-manual)", func() {
+		toolResult, err := s.CallTool("cronjobs_trigger", map[string]interface{}{
+			"name":      "a-cronjob",
+			"namespace": "default",
+			"job_name":  "a-cronjob-manual",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		s.Run("returns the created Job name", func() {
+			s.Equal("Job a-cronjob-manual created in namespace default from CronJob a-cronjob", toolResult.Content[0].(mcp.TextContent).Text)
+		})
+		job, err := kc.BatchV1().Jobs("default").Get(s.T().Context(), "a-cronjob-manual", metav1.GetOptions{})
+		s.Require().NoError(err, "expected Job to be created")
+		s.Run("creates Job from the CronJob job template", func() {
+			s.Equal("a-cronjob", job.Labels["app"])
+			s.Require().Len(job.Spec.Template.Spec.Containers, 1)
+			s.Equal("busybox", job.Spec.Template.Spec.Containers[0].Image)
+			s.Equal([]string{"echo", "hello"}, job.Spec.Template.Spec.Containers[0].Command)
+		})
+		s.Run("marks Job as manually instantiated", func() {
+			s.Equal("manual", job.Annotations["cronjob.kubernetes.io/instantiate"])
+		})
+		s.Run("sets the CronJob as the Job owner", func() {
+			s.Require().Len(job.OwnerReferences, 1)
+			s.Equal("CronJob", job.OwnerReferences[0].Kind)
+			s.Equal("a-cronjob", job.OwnerReferences[0].Name)
+		})
+	})
+	s.Run("cronjobs_trigger(name=a-cronjob) generates a Job name", func() {
+		toolResult, err := s.CallTool("cronjobs_trigger", map[string]interface{}{"name": "a-cronjob", "namespace": "default"})
+		s.Require().Nilf(err, "call tool failed %v", err)
+		s.Require().Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		jobName := regexp.MustCompile(`^Job (a-cronjob-manual-[a-z0-9]{5}) created`).FindStringSubmatch(toolResult.Content[0].(mcp.TextContent).Text)
+		s.Require().Lenf(jobName, 2, "expected generated Job name in output: %s", toolResult.Content[0].(mcp.TextContent).Text)
+		_, err = kc.BatchV1().Jobs("default").Get(s.T().Context(), jobName[1], metav1.GetOptions{})
+		s.NoError(err, "expected Job with generated name to be created")
+	})
+}
+
+func (s *CronJobsSuite) TestCronJobsTriggerDenied() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		denied_resources = [ { group = "batch", version = "v1", kind = "Job" } ]
+	`), s.Cfg), "Expected to parse denied resources config")
+	s.InitMcpClient()
+	s.Run("cronjobs_trigger (denied)", func() {
+		toolResult, err := s.CallTool("cronjobs_trigger", map[string]interface{}{"name": "a-cronjob", "namespace": "default", "job_name": "a-denied-job"})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes denial", func() {
+			msg := toolResult.Content[0].(mcp.TextContent).Text
+			expectedMessage := "failed to trigger cronjob a-cronjob in namespace default:(.+:)? resource not allowed: batch/v1, Kind=Job"
+			s.Regexpf(expectedMessage, msg, "expected descriptive error '%s', got %v", expectedMessage, msg)
+		})
+	})
+}
+
+func TestCronJobs(t *testing.T) {
+	suite.Run(t, new(CronJobsSuite))
+}
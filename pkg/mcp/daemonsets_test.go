@@ -0,0 +1,134 @@
+package mcp
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type DaemonSetsSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+}
+
+func (s *DaemonSetsSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	discovery := test.NewDiscoveryClientHandler()
+	discovery.APIResourceLists[1].APIResources = append(discovery.APIResourceLists[1].APIResources,
+		metav1.APIResource{Name: "daemonsets", Kind: "DaemonSet", Namespaced: true, Verbs: metav1.Verbs{"get", "list", "watch"}})
+	s.mockServer.Handle(discovery)
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		daemonSets := []appsv1.DaemonSet{
+			{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "DaemonSet"},
+				ObjectMeta: metav1.ObjectMeta{Name: "log-collector", Namespace: "monitoring", Labels: map[string]string{"app": "log-collector"}},
+				Spec: appsv1.DaemonSetSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+					NodeSelector: map[string]string{"kubernetes.io/os": "linux", "node-role": "worker"},
+				}}},
+				Status: appsv1.DaemonSetStatus{DesiredNumberScheduled: 5, CurrentNumberScheduled: 4, NumberReady: 3, UpdatedNumberScheduled: 2, NumberAvailable: 1},
+			},
+			{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "DaemonSet"},
+				ObjectMeta: metav1.ObjectMeta{Name: "cni-plugin", Namespace: "default", Labels: map[string]string{"app": "cni"}},
+				Status:     appsv1.DaemonSetStatus{DesiredNumberScheduled: 3, CurrentNumberScheduled: 3, NumberReady: 3, UpdatedNumberScheduled: 3, NumberAvailable: 3},
+			},
+		}
+		var items []appsv1.DaemonSet
+		switch req.URL.Path {
+		case "/apis/apps/v1/daemonsets":
+			items = daemonSets
+		case "/apis/apps/v1/namespaces/default/daemonsets":
+			items = daemonSets[1:]
+		default:
+			return
+		}
+		if req.URL.Query().Get("labelSelector") == "app=log-collector" {
+			items = daemonSets[:1]
+		}
+		test.WriteObject(w, &appsv1.DaemonSetList{
+			TypeMeta: metav1.TypeMeta{APIVersion: "apps/v1", Kind: "DaemonSetList"},
+			Items:    items,
+		})
+	}))
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+}
+
+func (s *DaemonSetsSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *DaemonSetsSuite) TestDaemonSetsList() {
+	s.InitMcpClient()
+	s.Run("daemonsets_list(all_namespaces=true)", func() {
+		toolResult, err := s.CallTool("daemonsets_list", map[string]interface{}{"all_namespaces": true})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		textContent := toolResult.Content[0].(mcp.TextContent).Text
+		s.Run("returns headers", func() {
+			expectedHeaders := regexp.MustCompile(`(?m)^NAMESPACE\s+NAME\s+DESIRED\s+CURRENT\s+READY\s+UP-TO-DATE\s+AVAILABLE\s+NODE SELECTOR\s*$`)
+			s.Regexpf(expectedHeaders, textContent, "expected headers not found in output:\n%s", textContent)
+		})
+		s.Run("returns counts and node selector", func() {
+			expectedRows := []string{
+				`(?m)^monitoring\s+log-collector\s+5\s+4\s+3\s+2\s+1\s+kubernetes.io/os=linux,node-role=worker\s*$`,
+				`(?m)^default\s+cni-plugin\s+3\s+3\s+3\s+3\s+3\s+<none>\s*$`,
+			}
+			for _, row := range expectedRows {
+				s.Regexpf(row, textContent, "expected row '%s' not found in output:\n%s", row, textContent)
+			}
+		})
+	})
+	s.Run("daemonsets_list(namespace=default, all_namespaces=false)", func() {
+		toolResult, err := s.CallTool("daemonsets_list", map[string]interface{}{"namespace": "default", "all_namespaces": false})
+		s.Require().Nilf(err, "call tool failed %v", err)
+		s.Require().Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		textContent := toolResult.Content[0].(mcp.TextContent).Text
+		s.Contains(textContent, "cni-plugin")
+		s.NotContains(textContent, "log-collector")
+	})
+	s.Run("daemonsets_list(label_selector=app=log-collector)", func() {
+		toolResult, err := s.CallTool("daemonsets_list", map[string]interface{}{"all_namespaces": true, "label_selector": "app=log-collector"})
+		s.Require().Nilf(err, "call tool failed %v", err)
+		s.Require().Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		textContent := toolResult.Content[0].(mcp.TextContent).Text
+		s.Contains(textContent, "log-collector")
+		s.NotContains(textContent, "cni-plugin")
+	})
+}
+
+func (s *DaemonSetsSuite) TestDaemonSetsListDenied() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		denied_resources = [ { group = "apps", version = "v1", kind = "DaemonSet" } ]
+	`), s.Cfg), "Expected to parse denied resources config")
+	s.InitMcpClient()
+	s.Run("daemonsets_list (denied)", func() {
+		toolResult, err := s.CallTool("daemonsets_list", map[string]interface{}{"all_namespaces": true})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes denial", func() {
+			msg := toolResult.Content[0].(mcp.TextContent).Text
+			expectedMessage := "failed to list daemonsets:(.+:)? resource not allowed: apps/v1, Kind=DaemonSet"
+			s.Regexpf(expectedMessage, msg, "expected descriptive error '%s', got %v", expectedMessage, msg)
+		})
+	})
+}
+
+func TestDaemonSets(t *testing.T) {
+	suite.Run(t, new(DaemonSetsSuite))
+}
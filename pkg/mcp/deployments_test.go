@@ -0,0 +1,242 @@
+package mcp
+
+import (
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
+)
+
+type DeploymentsSuite struct {
+	BaseMcpSuite
+}
+
+func (s *DeploymentsSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	kc := kubernetes.NewForConfigOrDie(envTestRestConfig)
+	_ = kc.AppsV1().Deployments("default").Delete(s.T().Context(), "a-deployment-to-set-image", metav1.DeleteOptions{})
+	_, _ = kc.AppsV1().Deployments("default").Create(s.T().Context(), &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "a-deployment-to-set-image"},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "a-deployment-to-set-image"}},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "a-deployment-to-set-image"}},
+				Spec: corev1.PodSpec{Containers: []corev1.Container{
+					{Name: "app", Image: "nginx:1.25"},
+					{Name: "sidecar", Image: "busybox:1.36"},
+				}},
+			},
+		},
+	}, metav1.CreateOptions{})
+}
+
+func (s *DeploymentsSuite) TestDeploymentsSetImage() {
+	s.InitMcpClient()
+	kc := kubernetes.NewForConfigOrDie(envTestRestConfig)
+	s.Run("deployments_set_image with missing container returns error", func() {
+		toolResult, _ := s.CallTool("deployments_set_image", map[string]interface{}{"name": "a-deployment-to-set-image", "image": "nginx:1.26"})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to set deployment image, missing argument container", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("deployments_set_image with nonexistent container returns error", func() {
+		toolResult, _ := s.CallTool("deployments_set_image", map[string]interface{}{
+			"namespace": "default", "name": "a-deployment-to-set-image", "container": "nonexistent", "image": "nginx:1.26",
+		})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to set image of container nonexistent in deployment a-deployment-to-set-image in namespace default: container nonexistent not found in deployment a-deployment-to-set-image",
+			toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("deployments_set_image(dry_run=true)", func() {
+		toolResult, err := s.CallTool("deployments_set_image", map[string]interface{}{
+			"namespace": "default", "name": "a-deployment-to-set-image", "container": "app", "image": "nginx:1.26", "dry_run": true,
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		s.Run("returns the old and new image", func() {
+			s.Equal("Deployment a-deployment-to-set-image container app image would be updated (dry run): nginx:1.25 -> nginx:1.26",
+				toolResult.Content[0].(mcp.TextContent).Text)
+		})
+		s.Run("does not update the Deployment", func() {
+			deployment, err := kc.AppsV1().Deployments("default").Get(s.T().Context(), "a-deployment-to-set-image", metav1.GetOptions{})
+			s.Require().NoError(err)
+			s.Equal("nginx:1.25", deployment.Spec.Template.Spec.Containers[0].Image)
+		})
+	})
+	s.Run("deployments_set_image(container=app, image=nginx:1.26)", func() {
+		toolResult, err := s.CallTool("deployments_set_image", map[string]interface{}{
+			"namespace": "default", "name": "a-deployment-to-set-image", "container": "app", "image": "nginx:1.26",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		s.Run("returns the old and new image", func() {
+			s.Equal("Deployment a-deployment-to-set-image container app image updated: nginx:1.25 -> nginx:1.26",
+				toolResult.Content[0].(mcp.TextContent).Text)
+		})
+		deployment, err := kc.AppsV1().Deployments("default").Get(s.T().Context(), "a-deployment-to-set-image", metav1.GetOptions{})
+		s.Require().NoError(err)
+		s.Run("updates the container image", func() {
+			s.Equal("nginx:1.26", deployment.Spec.Template.Spec.Containers[0].Image)
+		})
+		s.Run("preserves the other containers", func() {
+			s.Require().Len(deployment.Spec.Template.Spec.Containers, 2)
+			s.Equal("busybox:1.36", deployment.Spec.Template.Spec.Containers[1].Image)
+		})
+	})
+}
+
+func (s *DeploymentsSuite) TestDeploymentsSetImageDenied() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		denied_resources = [ { group = "apps", version = "v1", kind = "Deployment" } ]
+	`), s.Cfg), "Expected to parse denied resources config")
+	s.InitMcpClient()
+	s.Run("deployments_set_image (denied)", func() {
+		toolResult, err := s.CallTool("deployments_set_image", map[string]interface{}{
+			"namespace": "default", "name": "a-deployment-to-set-image", "container": "app", "image": "nginx:1.26",
+		})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes denial", func() {
+			msg := toolResult.Content[0].(mcp.TextContent).Text
+			expectedMessage := "failed to set image of container app in deployment a-deployment-to-set-image in namespace default:(.+:)? resource not allowed: apps/v1, Kind=Deployment"
+			s.Regexpf(expectedMessage, msg, "expected descriptive error '%s', got %v", expectedMessage, msg)
+		})
+	})
+}
+
+func (s *DeploymentsSuite) TestDeploymentsSetImageReadOnly() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		read_only = true
+	`), s.Cfg), "Expected to parse read only config")
+	s.InitMcpClient()
+	s.Run("deployments_set_image is not available in read-only mode", func() {
+		tools, err := s.ListTools(s.T().Context(), mcp.ListToolsRequest{})
+		s.Require().NoError(err)
+		for _, tool := range tools.Tools {
+			s.NotEqual("deployments_set_image", tool.Name)
+		}
+	})
+	s.Run("deployments_rollback is not available in read-only mode", func() {
+		tools, err := s.ListTools(s.T().Context(), mcp.ListToolsRequest{})
+		s.Require().NoError(err)
+		for _, tool := range tools.Tools {
+			s.NotEqual("deployments_rollback", tool.Name)
+		}
+	})
+}
+
+func (s *DeploymentsSuite) TestDeploymentsRollback() {
+	kc := kubernetes.NewForConfigOrDie(envTestRestConfig)
+	// No Deployment controller runs in envtest, the ReplicaSets for each revision are created manually
+	podTemplate := func(image string, labels map[string]string) corev1.PodTemplateSpec {
+		return corev1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{Labels: labels},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app", Image: image}}},
+		}
+	}
+	_ = kc.AppsV1().Deployments("default").Delete(s.T().Context(), "a-deployment-to-roll-back", metav1.DeleteOptions{})
+	deployment, err := kc.AppsV1().Deployments("default").Create(s.T().Context(), &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "a-deployment-to-roll-back"},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "a-deployment-to-roll-back"}},
+			Template: podTemplate("nginx:1.26", map[string]string{"app": "a-deployment-to-roll-back"}),
+		},
+	}, metav1.CreateOptions{})
+	s.Require().NoError(err)
+	for revision, image := range map[string]string{"1": "nginx:1.25", "2": "nginx:1.26"} {
+		labels := map[string]string{"app": "a-deployment-to-roll-back", "pod-template-hash": "revision-" + revision}
+		_ = kc.AppsV1().ReplicaSets("default").Delete(s.T().Context(), "a-deployment-to-roll-back-"+revision, metav1.DeleteOptions{})
+		_, err = kc.AppsV1().ReplicaSets("default").Create(s.T().Context(), &appsv1.ReplicaSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "a-deployment-to-roll-back-" + revision,
+				Annotations: map[string]string{"deployment.kubernetes.io/revision": revision},
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: "apps/v1", Kind: "Deployment", Name: deployment.Name, UID: deployment.UID, Controller: ptr.To(true),
+				}},
+			},
+			Spec: appsv1.ReplicaSetSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: labels},
+				Template: podTemplate(image, labels),
+			},
+		}, metav1.CreateOptions{})
+		s.Require().NoError(err)
+	}
+	s.InitMcpClient()
+	s.Run("deployments_rollback with missing name returns error", func() {
+		toolResult, _ := s.CallTool("deployments_rollback", map[string]interface{}{})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to roll back deployment, missing argument name", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("deployments_rollback with nonexistent revision returns error", func() {
+		toolResult, _ := s.CallTool("deployments_rollback", map[string]interface{}{
+			"namespace": "default", "name": "a-deployment-to-roll-back", "to_revision": 3,
+		})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to roll back deployment a-deployment-to-roll-back in namespace default: revision 3 not found for deployment a-deployment-to-roll-back",
+			toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("deployments_rollback to the current template returns error", func() {
+		toolResult, _ := s.CallTool("deployments_rollback", map[string]interface{}{
+			"namespace": "default", "name": "a-deployment-to-roll-back", "to_revision": 2,
+		})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to roll back deployment a-deployment-to-roll-back in namespace default: deployment a-deployment-to-roll-back already matches the template of revision 2",
+			toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("deployments_rollback(name=a-deployment-to-roll-back)", func() {
+		toolResult, err := s.CallTool("deployments_rollback", map[string]interface{}{
+			"namespace": "default", "name": "a-deployment-to-roll-back",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		s.Run("returns the revision rolled back to", func() {
+			s.Equal("Deployment a-deployment-to-roll-back rolled back to revision 1", toolResult.Content[0].(mcp.TextContent).Text)
+		})
+		deployment, err := kc.AppsV1().Deployments("default").Get(s.T().Context(), "a-deployment-to-roll-back", metav1.GetOptions{})
+		s.Require().NoError(err)
+		s.Run("reverts the Pod template", func() {
+			s.Equal("nginx:1.25", deployment.Spec.Template.Spec.Containers[0].Image)
+		})
+		s.Run("does not copy the pod-template-hash label", func() {
+			s.Equal(map[string]string{"app": "a-deployment-to-roll-back"}, deployment.Spec.Template.Labels)
+		})
+	})
+}
+
+func (s *DeploymentsSuite) TestDeploymentsRollbackDenied() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		denied_resources = [ { group = "apps", version = "v1", kind = "Deployment" } ]
+	`), s.Cfg), "Expected to parse denied resources config")
+	s.InitMcpClient()
+	s.Run("deployments_rollback (denied)", func() {
+		toolResult, err := s.CallTool("deployments_rollback", map[string]interface{}{
+			"namespace": "default", "name": "a-deployment-to-set-image",
+		})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes denial", func() {
+			msg := toolResult.Content[0].(mcp.TextContent).Text
+			expectedMessage := "failed to roll back deployment a-deployment-to-set-image in namespace default:(.+:)? resource not allowed: apps/v1, Kind=Deployment"
+			s.Regexpf(expectedMessage, msg, "expected descriptive error '%s', got %v", expectedMessage, msg)
+		})
+	})
+}
+
+func TestDeployments(t *testing.T) {
+	suite.Run(t, new(DeploymentsSuite))
+}
@@ -1,8 +1,10 @@
 package mcp
 
 import (
+	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/BurntSushi/toml"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -142,6 +144,112 @@ func (s *EventsSuite) TestEventsListDenied() {
 	})
 }
 
+func (s *EventsSuite) TestEventsRecent() {
+	client := kubernetes.NewForConfigOrDie(envTestRestConfig)
+	now := time.Now()
+	for i, event := range []struct {
+		name string
+		age  time.Duration
+		typ  string
+	}{
+		{"oldest", 72 * time.Hour, "Warning"},
+		{"newest", 30 * time.Minute, "Normal"},
+		{"middle", 10 * time.Hour, "Warning"},
+	} {
+		_, eventCreateErr := client.CoreV1().Events("ns-2").Create(s.T().Context(), &v1.Event{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "recent-event-" + event.name,
+			},
+			InvolvedObject: v1.ObjectReference{
+				APIVersion: "v1",
+				Kind:       "Pod",
+				Name:       "a-pod",
+				Namespace:  "ns-2",
+			},
+			FirstTimestamp: metav1.NewTime(now.Add(-event.age)),
+			Type:           event.typ,
+			Reason:         fmt.Sprintf("Reason%d", i),
+			Message:        "The " + event.name + " event",
+		}, metav1.CreateOptions{})
+		s.Require().NoError(eventCreateErr, "failed to create event %s", event.name)
+	}
+	s.InitMcpClient()
+	s.Run("events_recent(namespace=ns-2)", func() {
+		toolResult, err := s.CallTool("events_recent", map[string]interface{}{
+			"namespace": "ns-2",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		var decoded []map[string]any
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "unmarshal failed %v", err)
+		})
+		s.Run("returns events newest first", func() {
+			s.Require().Len(decoded, 3)
+			s.Equal("The newest event", decoded[0]["Message"])
+			s.Equal("The middle event", decoded[1]["Message"])
+			s.Equal("The oldest event", decoded[2]["Message"])
+		})
+		s.Run("returns human-readable age", func() {
+			s.Equal("30m", decoded[0]["Age"])
+			s.Equal("10h", decoded[1]["Age"])
+			s.Equal("3d", decoded[2]["Age"])
+		})
+	})
+	s.Run("events_recent(namespace=ns-2, limit=2)", func() {
+		toolResult, err := s.CallTool("events_recent", map[string]interface{}{
+			"namespace": "ns-2",
+			"limit":     2,
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		s.Run("has yaml comment indicating output format", func() {
+			s.Truef(strings.HasPrefix(toolResult.Content[0].(mcp.TextContent).Text, "# The following 2 most recent events (YAML format, newest first) were found:\n"), "unexpected result %v", toolResult.Content[0].(mcp.TextContent).Text)
+		})
+		var decoded []map[string]any
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("returns limited events", func() {
+			s.Require().Len(decoded, 2)
+			s.Equal("The newest event", decoded[0]["Message"])
+			s.Equal("The middle event", decoded[1]["Message"])
+		})
+	})
+	s.Run("events_recent(namespace=ns-2, warnings_only=true)", func() {
+		toolResult, err := s.CallTool("events_recent", map[string]interface{}{
+			"namespace":     "ns-2",
+			"warnings_only": true,
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		var decoded []map[string]any
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("returns only warning events", func() {
+			s.Require().Len(decoded, 2)
+			s.Equal("The middle event", decoded[0]["Message"])
+			s.Equal("The oldest event", decoded[1]["Message"])
+		})
+	})
+	s.Run("events_recent(limit=0)", func() {
+		toolResult, err := s.CallTool("events_recent", map[string]interface{}{
+			"limit": 0,
+		})
+		s.Run("has error", func() {
+			s.Nilf(err, "call tool should not return error object")
+			s.Truef(toolResult.IsError, "call tool should fail")
+		})
+		s.Run("describes invalid limit", func() {
+			s.Equal("limit must be greater than 0, got 0", toolResult.Content[0].(mcp.TextContent).Text)
+		})
+	})
+}
+
 func TestEvents(t *testing.T) {
 	suite.Run(t, new(EventsSuite))
 }
@@ -9,9 +9,16 @@ import (
 	"github.com/containers/kubernetes-mcp-server/pkg/api"
 	"github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
 )
 
+// deprecatedMetaKey is the _meta key of the tools (and their call results) that are configured as deprecated
+const deprecatedMetaKey = "deprecated"
+
+// requestIDMetaKey is the _meta key of the failed tool call results holding the request ID, to correlate them with the server logs
+const requestIDMetaKey = "requestId"
+
 func ServerToolToGoSdkTool(s *Server, tool api.ServerTool) (*mcp.Tool, mcp.ToolHandler, error) {
 	goSdkTool := &mcp.Tool{
 		Name:        tool.Tool.Name,
@@ -26,30 +33,54 @@ func ServerToolToGoSdkTool(s *Server, tool api.ServerTool) (*mcp.Tool, mcp.ToolH
 		},
 		InputSchema: tool.Tool.InputSchema,
 	}
+	deprecation, deprecated := s.configuration.DeprecatedTools[tool.Tool.Name]
+	if deprecated {
+		goSdkTool.Description = fmt.Sprintf("%s\n\nDEPRECATED: %s", goSdkTool.Description, deprecation)
+		goSdkTool.Meta = mcp.Meta{deprecatedMetaKey: deprecation}
+	}
 	goSdkHandler := func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 		toolCallRequest, err := GoSdkToolCallRequestToToolCallRequest(request)
 		if err != nil {
 			return nil, fmt.Errorf("%v for tool %s", err, tool.Tool.Name)
 		}
+		if err = withToolDefaults(toolCallRequest, s.configuration.ToolDefaults[tool.Tool.Name]); err != nil {
+			return nil, fmt.Errorf("%v for tool %s", err, tool.Tool.Name)
+		}
 		// get the correct derived Kubernetes client for the target specified in the request
-		cluster := toolCallRequest.GetString(s.p.GetTargetParameterName(), s.p.GetDefaultTarget())
+		cluster := toolCallRequest.GetString(s.GetTargetParameterName(), s.p.GetDefaultTarget())
 		ctx = kubernetes.ExchangeTokenInContext(ctx, s.configuration.StaticConfig, s.oidcProvider, s.httpClient, s.p, cluster)
 		k, err := s.p.GetDerivedKubernetes(ctx, cluster)
 		if err != nil {
+			klog.FromContext(ctx).V(3).Info(fmt.Sprintf("mcp tool call %s failed: %v", tool.Tool.Name, err))
 			return nil, err
 		}
 
 		result, err := tool.Handler(api.ToolHandlerParams{
 			Context:                ctx,
 			ExtendedConfigProvider: s.configuration,
+			ToolsConfigProvider:    s.configuration,
 			KubernetesClient:       k,
 			ToolCallRequest:        toolCallRequest,
 			ListOutput:             s.configuration.ListOutput(),
 		})
 		if err != nil {
+			klog.FromContext(ctx).V(3).Info(fmt.Sprintf("mcp tool call %s failed: %v", tool.Tool.Name, err))
 			return nil, err
 		}
-		return NewTextResult(result.Content, result.Error), nil
+		textResult := NewTextResult(result.Content, result.Error)
+		if deprecated {
+			textResult.Meta = mcp.Meta{deprecatedMetaKey: deprecation}
+		}
+		if result.Error != nil {
+			klog.FromContext(ctx).V(3).Info(fmt.Sprintf("mcp tool call %s returned an error: %v", tool.Tool.Name, result.Error))
+			if id := requestID(ctx); id != "" {
+				if textResult.Meta == nil {
+					textResult.Meta = mcp.Meta{}
+				}
+				textResult.Meta[requestIDMetaKey] = id
+			}
+		}
+		return textResult, nil
 	}
 	return goSdkTool, goSdkHandler, nil
 }
@@ -80,6 +111,31 @@ func GoSdkToolCallParamsToToolCallRequest(toolCallParams *mcp.CallToolParamsRaw)
 	}, nil
 }
 
+// withToolDefaults sets the configured default arguments (tool_defaults) that are absent from the tool call request.
+func withToolDefaults(toolCallRequest *ToolCallRequest, defaults map[string]any) error {
+	if len(defaults) == 0 {
+		return nil
+	}
+	// Round-trip through JSON so that the defaults have the same types as the arguments sent by the clients
+	data, err := json.Marshal(defaults)
+	if err != nil {
+		return fmt.Errorf("failed to marshal default arguments: %v", err)
+	}
+	var normalizedDefaults map[string]any
+	if err = json.Unmarshal(data, &normalizedDefaults); err != nil {
+		return fmt.Errorf("failed to unmarshal default arguments: %v", err)
+	}
+	if toolCallRequest.arguments == nil {
+		toolCallRequest.arguments = make(map[string]any, len(normalizedDefaults))
+	}
+	for key, value := range normalizedDefaults {
+		if _, ok := toolCallRequest.arguments[key]; !ok {
+			toolCallRequest.arguments[key] = value
+		}
+	}
+	return nil
+}
+
 func (ToolCallRequest *ToolCallRequest) GetArguments() map[string]any {
 	return ToolCallRequest.arguments
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"flag"
 	"path/filepath"
 	"runtime"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/BurntSushi/toml"
 	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -237,6 +239,214 @@ func (s *HelmSuite) TestHelmListDenied() {
 	})
 }
 
+func (s *HelmSuite) TestHelmHistory() {
+	kc := kubernetes.NewForConfigOrDie(envTestRestConfig)
+	createHelmReleaseRevision(s.T().Context(), s.Require(), kc, "release-with-history", 1, "superseded")
+	createHelmReleaseRevision(s.T().Context(), s.Require(), kc, "release-with-history", 2, "deployed")
+	s.InitMcpClient()
+	s.Run("helm_history(name=release-with-history)", func() {
+		toolResult, err := s.CallTool("helm_history", map[string]interface{}{
+			"name": "release-with-history",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		var decoded []map[string]interface{}
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "invalid tool result content %v", err)
+		})
+		s.Run("has 2 revisions", func() {
+			s.Require().Lenf(decoded, 2, "invalid helm history count, expected 2, got %v", len(decoded))
+		})
+		s.Run("returns newest revision first", func() {
+			s.Equalf(float64(2), decoded[0]["revision"], "invalid first revision, expected 2, got %v", decoded[0]["revision"])
+			s.Equalf(float64(1), decoded[1]["revision"], "invalid second revision, expected 1, got %v", decoded[1]["revision"])
+		})
+		s.Run("has valid status", func() {
+			s.Equalf("deployed", decoded[0]["status"], "invalid status, expected deployed, got %v", decoded[0]["status"])
+			s.Equalf("superseded", decoded[1]["status"], "invalid status, expected superseded, got %v", decoded[1]["status"])
+		})
+		s.Run("has valid chartVersion", func() {
+			s.Equalf("1.0.2", decoded[0]["chartVersion"], "invalid chartVersion, expected 1.0.2, got %v", decoded[0]["chartVersion"])
+			s.Equalf("1.0.1", decoded[1]["chartVersion"], "invalid chartVersion, expected 1.0.1, got %v", decoded[1]["chartVersion"])
+		})
+	})
+	s.Run("helm_history(name=release-with-history, max=1)", func() {
+		toolResult, err := s.CallTool("helm_history", map[string]interface{}{
+			"name": "release-with-history",
+			"max":  1,
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		s.Run("returns only the newest revision", func() {
+			var decoded []map[string]interface{}
+			s.Require().NoError(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded))
+			s.Require().Lenf(decoded, 1, "invalid helm history count, expected 1, got %v", len(decoded))
+			s.Equalf(float64(2), decoded[0]["revision"], "invalid revision, expected 2, got %v", decoded[0]["revision"])
+		})
+	})
+	s.Run("helm_history(name=non-existent-release)", func() {
+		toolResult, err := s.CallTool("helm_history", map[string]interface{}{
+			"name": "non-existent-release",
+		})
+		s.Run("has error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Truef(toolResult.IsError, "call tool should fail")
+		})
+		s.Run("describes release not found", func() {
+			s.Equalf("failed to get helm release history for 'non-existent-release': release non-existent-release not found",
+				toolResult.Content[0].(mcp.TextContent).Text, "unexpected error %v", toolResult.Content[0].(mcp.TextContent).Text)
+		})
+	})
+}
+
+func (s *HelmSuite) TestHelmDiffRevisions() {
+	kc := kubernetes.NewForConfigOrDie(envTestRestConfig)
+	createHelmReleaseRevisionWithManifest(s.T().Context(), s.Require(), kc, "release-to-diff", 1, "superseded",
+		"---\nkind: ConfigMap\nmetadata:\n  name: release-to-diff\ndata:\n  replicas: \"1\"\n")
+	createHelmReleaseRevisionWithManifest(s.T().Context(), s.Require(), kc, "release-to-diff", 2, "deployed",
+		"---\nkind: ConfigMap\nmetadata:\n  name: release-to-diff\ndata:\n  replicas: \"3\"\n")
+	s.InitMcpClient()
+	s.Run("helm_diff_revisions(name=release-to-diff, from_revision=1, to_revision=2)", func() {
+		toolResult, err := s.CallTool("helm_diff_revisions", map[string]interface{}{
+			"name":          "release-to-diff",
+			"from_revision": 1,
+			"to_revision":   2,
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed %v", toolResult.Content)
+		})
+		text := toolResult.Content[0].(mcp.TextContent).Text
+		s.Run("returns unified diff headers", func() {
+			s.Contains(text, "--- release-to-diff revision 1\n")
+			s.Contains(text, "+++ release-to-diff revision 2\n")
+		})
+		s.Run("returns changed lines", func() {
+			s.Contains(text, "\n-  replicas: \"1\"\n")
+			s.Contains(text, "\n+  replicas: \"3\"\n")
+		})
+		s.Run("returns unchanged lines as context", func() {
+			s.Contains(text, "\n   name: release-to-diff\n")
+		})
+	})
+	s.Run("helm_diff_revisions(name=release-to-diff, from_revision=2, to_revision=2)", func() {
+		toolResult, err := s.CallTool("helm_diff_revisions", map[string]interface{}{
+			"name":          "release-to-diff",
+			"from_revision": 2,
+			"to_revision":   2,
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed %v", toolResult.Content)
+		})
+		s.Run("describes no differences", func() {
+			s.Equalf("No differences between the manifests of revisions 2 and 2 of release release-to-diff",
+				toolResult.Content[0].(mcp.TextContent).Text, "unexpected result %v", toolResult.Content[0].(mcp.TextContent).Text)
+		})
+	})
+	s.Run("helm_diff_revisions(name=release-to-diff, from_revision=1, to_revision=1337)", func() {
+		toolResult, err := s.CallTool("helm_diff_revisions", map[string]interface{}{
+			"name":          "release-to-diff",
+			"from_revision": 1,
+			"to_revision":   1337,
+		})
+		s.Run("has error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Truef(toolResult.IsError, "call tool should fail")
+		})
+		s.Run("describes revision not found", func() {
+			s.Equalf("failed to diff helm release revisions for 'release-to-diff': revision 1337 not found for release release-to-diff",
+				toolResult.Content[0].(mcp.TextContent).Text, "unexpected error %v", toolResult.Content[0].(mcp.TextContent).Text)
+		})
+	})
+	s.Run("helm_diff_revisions(name=release-to-diff, from_revision=1) missing to_revision", func() {
+		toolResult, err := s.CallTool("helm_diff_revisions", map[string]interface{}{
+			"name":          "release-to-diff",
+			"from_revision": 1,
+		})
+		s.Run("has error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Truef(toolResult.IsError, "call tool should fail")
+		})
+		s.Run("describes missing argument", func() {
+			s.Equalf("failed to diff helm release revisions, missing argument to_revision",
+				toolResult.Content[0].(mcp.TextContent).Text, "unexpected error %v", toolResult.Content[0].(mcp.TextContent).Text)
+		})
+	})
+}
+
+func (s *HelmSuite) TestHelmRollback() {
+	kc := kubernetes.NewForConfigOrDie(envTestRestConfig)
+	createHelmReleaseRevision(s.T().Context(), s.Require(), kc, "release-to-rollback", 1, "superseded")
+	createHelmReleaseRevision(s.T().Context(), s.Require(), kc, "release-to-rollback", 2, "deployed")
+	s.InitMcpClient()
+	s.Run("helm_rollback(name=release-to-rollback, revision=1)", func() {
+		toolResult, err := s.CallTool("helm_rollback", map[string]interface{}{
+			"name":     "release-to-rollback",
+			"revision": 1,
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed %v", toolResult.Content)
+		})
+		s.Run("returns rolled back", func() {
+			s.Truef(strings.HasPrefix(toolResult.Content[0].(mcp.TextContent).Text, "Rolled back release release-to-rollback to revision 1"),
+				"unexpected result %v", toolResult.Content[0].(mcp.TextContent).Text)
+		})
+		s.Run("returns the resources might still be rolling out", func() {
+			s.Containsf(toolResult.Content[0].(mcp.TextContent).Text, "the rolled back resources might still be rolling out",
+				"unexpected result %v", toolResult.Content[0].(mcp.TextContent).Text)
+		})
+		s.Run("creates new revision with the chart of the prior revision", func() {
+			secret, err := kc.CoreV1().Secrets("default").Get(s.T().Context(), "sh.helm.release.v1.release-to-rollback.v3", metav1.GetOptions{})
+			s.Require().NoErrorf(err, "expected revision 3 to be created, got %v", err)
+			s.Equalf("deployed", secret.Labels["status"], "invalid revision 3 status, expected deployed, got %v", secret.Labels["status"])
+			toolResult, err = s.CallTool("helm_history", map[string]interface{}{
+				"name": "release-to-rollback",
+				"max":  1,
+			})
+			s.Require().NoError(err)
+			var decoded []map[string]interface{}
+			s.Require().NoError(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded))
+			s.Require().Len(decoded, 1)
+			s.Equalf("1.0.1", decoded[0]["chartVersion"], "invalid chartVersion, expected 1.0.1, got %v", decoded[0]["chartVersion"])
+		})
+	})
+	s.Run("helm_rollback(name=release-to-rollback, revision=1337)", func() {
+		toolResult, err := s.CallTool("helm_rollback", map[string]interface{}{
+			"name":     "release-to-rollback",
+			"revision": 1337,
+		})
+		s.Run("has error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Truef(toolResult.IsError, "call tool should fail")
+		})
+		s.Run("describes revision not found", func() {
+			s.Equalf("failed to rollback helm release 'release-to-rollback': revision 1337 not found for release release-to-rollback",
+				toolResult.Content[0].(mcp.TextContent).Text, "unexpected error %v", toolResult.Content[0].(mcp.TextContent).Text)
+		})
+	})
+	s.Run("helm_rollback(name=non-existent-release, revision=1)", func() {
+		toolResult, err := s.CallTool("helm_rollback", map[string]interface{}{
+			"name":     "non-existent-release",
+			"revision": 1,
+		})
+		s.Run("has error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Truef(toolResult.IsError, "call tool should fail")
+		})
+		s.Run("describes release not found", func() {
+			s.Equalf("failed to rollback helm release 'non-existent-release': release non-existent-release not found",
+				toolResult.Content[0].(mcp.TextContent).Text, "unexpected error %v", toolResult.Content[0].(mcp.TextContent).Text)
+		})
+	})
+}
+
 func (s *HelmSuite) TestHelmUninstallNoReleases() {
 	s.InitMcpClient()
 	s.Run("helm_uninstall(name=release-to-uninstall) with no releases", func() {
@@ -328,6 +538,36 @@ func (s *HelmSuite) TestHelmUninstallDenied() {
 	})
 }
 
+// createHelmReleaseRevision stores a minimal Helm release revision (chart version 1.0.<revision>) in the default namespace
+func createHelmReleaseRevision(ctx context.Context, r *require.Assertions, kc *kubernetes.Clientset, name string, revision int, status string) {
+	createHelmReleaseRevisionWithManifest(ctx, r, kc, name, revision, status, "")
+}
+
+// createHelmReleaseRevisionWithManifest stores a minimal Helm release revision with the provided rendered manifest
+func createHelmReleaseRevisionWithManifest(ctx context.Context, r *require.Assertions, kc *kubernetes.Clientset, name string, revision int, status string, manifest string) {
+	encodedManifest, err := json.Marshal(manifest)
+	r.NoError(err)
+	_, err = kc.CoreV1().Secrets("default").Create(ctx, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "sh.helm.release.v1." + name + ".v" + strconv.Itoa(revision),
+			Labels: map[string]string{
+				"owner": "helm", "name": name, "version": strconv.Itoa(revision), "status": status,
+			},
+		},
+		Data: map[string][]byte{
+			"release": []byte(base64.StdEncoding.EncodeToString([]byte("{" +
+				"\"name\":\"" + name + "\"," +
+				"\"namespace\":\"default\"," +
+				"\"version\":" + strconv.Itoa(revision) + "," +
+				"\"info\":{\"status\":\"" + status + "\"}," +
+				"\"manifest\":" + string(encodedManifest) + "," +
+				"\"chart\":{\"metadata\":{\"apiVersion\":\"v2\",\"name\":\"no-op\",\"version\":\"1.0." + strconv.Itoa(revision) + "\"}}" +
+				"}"))),
+		},
+	}, metav1.CreateOptions{})
+	r.NoError(err)
+}
+
 func clearHelmReleases(ctx context.Context, kc *kubernetes.Clientset) {
 	secrets, _ := kc.CoreV1().Secrets("default").List(ctx, metav1.ListOptions{})
 	for _, secret := range secrets.Items {
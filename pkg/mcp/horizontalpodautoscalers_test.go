@@ -0,0 +1,165 @@
+package mcp
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+type HorizontalPodAutoscalersSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+}
+
+func (s *HorizontalPodAutoscalersSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.mockServer.Handle(test.NewDiscoveryClientHandler(metav1.APIResourceList{
+		GroupVersion: "autoscaling/v2",
+		APIResources: []metav1.APIResource{
+			{Name: "horizontalpodautoscalers", Kind: "HorizontalPodAutoscaler", Namespaced: true, Verbs: metav1.Verbs{"get", "list", "watch"}},
+		},
+	}))
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		hpas := []autoscalingv2.HorizontalPodAutoscaler{
+			{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "autoscaling/v2", Kind: "HorizontalPodAutoscaler"},
+				ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+				Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+					ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "web"},
+					MinReplicas:    ptr.To(int32(2)),
+					MaxReplicas:    10,
+					Metrics: []autoscalingv2.MetricSpec{
+						{Type: autoscalingv2.ResourceMetricSourceType, Resource: &autoscalingv2.ResourceMetricSource{
+							Name:   corev1.ResourceCPU,
+							Target: autoscalingv2.MetricTarget{Type: autoscalingv2.UtilizationMetricType, AverageUtilization: ptr.To(int32(80))},
+						}},
+						{Type: autoscalingv2.PodsMetricSourceType, Pods: &autoscalingv2.PodsMetricSource{
+							Metric: autoscalingv2.MetricIdentifier{Name: "requests-per-second"},
+							Target: autoscalingv2.MetricTarget{Type: autoscalingv2.AverageValueMetricType, AverageValue: ptr.To(resource.MustParse("100"))},
+						}},
+					},
+				},
+				Status: autoscalingv2.HorizontalPodAutoscalerStatus{
+					CurrentReplicas: 4,
+					DesiredReplicas: 6,
+					CurrentMetrics: []autoscalingv2.MetricStatus{
+						{Type: autoscalingv2.ResourceMetricSourceType, Resource: &autoscalingv2.ResourceMetricStatus{
+							Name:    corev1.ResourceCPU,
+							Current: autoscalingv2.MetricValueStatus{AverageUtilization: ptr.To(int32(95))},
+						}},
+						{Type: autoscalingv2.PodsMetricSourceType, Pods: &autoscalingv2.PodsMetricStatus{
+							Metric:  autoscalingv2.MetricIdentifier{Name: "requests-per-second"},
+							Current: autoscalingv2.MetricValueStatus{AverageValue: ptr.To(resource.MustParse("150"))},
+						}},
+					},
+					Conditions: []autoscalingv2.HorizontalPodAutoscalerCondition{
+						{Type: autoscalingv2.AbleToScale, Status: corev1.ConditionTrue, Reason: "SucceededRescale"},
+						{Type: autoscalingv2.ScalingLimited, Status: corev1.ConditionFalse, Reason: "DesiredWithinRange"},
+					},
+				},
+			},
+			{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "autoscaling/v2", Kind: "HorizontalPodAutoscaler"},
+				ObjectMeta: metav1.ObjectMeta{Name: "worker", Namespace: "jobs"},
+				Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+					ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "StatefulSet", Name: "worker"},
+					MaxReplicas:    5,
+					Metrics: []autoscalingv2.MetricSpec{
+						{Type: autoscalingv2.ResourceMetricSourceType, Resource: &autoscalingv2.ResourceMetricSource{
+							Name:   corev1.ResourceMemory,
+							Target: autoscalingv2.MetricTarget{Type: autoscalingv2.AverageValueMetricType, AverageValue: ptr.To(resource.MustParse("512Mi"))},
+						}},
+					},
+				},
+			},
+		}
+		var items []autoscalingv2.HorizontalPodAutoscaler
+		switch req.URL.Path {
+		case "/apis/autoscaling/v2/horizontalpodautoscalers":
+			items = hpas
+		case "/apis/autoscaling/v2/namespaces/default/horizontalpodautoscalers":
+			items = hpas[:1]
+		default:
+			return
+		}
+		test.WriteObject(w, &autoscalingv2.HorizontalPodAutoscalerList{
+			TypeMeta: metav1.TypeMeta{APIVersion: "autoscaling/v2", Kind: "HorizontalPodAutoscalerList"},
+			Items:    items,
+		})
+	}))
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+}
+
+func (s *HorizontalPodAutoscalersSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *HorizontalPodAutoscalersSuite) TestHpaStatus() {
+	s.InitMcpClient()
+	s.Run("hpa_status(all_namespaces=true)", func() {
+		toolResult, err := s.CallTool("hpa_status", map[string]interface{}{"all_namespaces": true})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		textContent := toolResult.Content[0].(mcp.TextContent).Text
+		s.Run("returns headers", func() {
+			expectedHeaders := regexp.MustCompile(`(?m)^NAMESPACE\s+NAME\s+REFERENCE\s+TARGETS\s+MINPODS\s+MAXPODS\s+REPLICAS\s+DESIRED\s+CONDITIONS\s*$`)
+			s.Regexpf(expectedHeaders, textContent, "expected headers not found in output:\n%s", textContent)
+		})
+		s.Run("returns current versus target metrics, replicas, and conditions", func() {
+			expectedRows := []string{
+				`(?m)^default\s+web\s+Deployment/web\s+cpu: 95%/80%, requests-per-second: 150/100 \(avg\)\s+2\s+10\s+4\s+6\s+AbleToScale=True\(SucceededRescale\),ScalingLimited=False\(DesiredWithinRange\)\s*$`,
+				`(?m)^jobs\s+worker\s+StatefulSet/worker\s+memory: <unknown>/512Mi \(avg\)\s+1\s+5\s+0\s+0\s+<none>\s*$`,
+			}
+			for _, row := range expectedRows {
+				s.Regexpf(row, textContent, "expected row '%s' not found in output:\n%s", row, textContent)
+			}
+		})
+	})
+	s.Run("hpa_status(namespace=default, all_namespaces=false)", func() {
+		toolResult, err := s.CallTool("hpa_status", map[string]interface{}{"namespace": "default", "all_namespaces": false})
+		s.Require().Nilf(err, "call tool failed %v", err)
+		s.Require().Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		textContent := toolResult.Content[0].(mcp.TextContent).Text
+		s.Contains(textContent, "Deployment/web")
+		s.NotContains(textContent, "StatefulSet/worker")
+	})
+}
+
+func (s *HorizontalPodAutoscalersSuite) TestHpaStatusDenied() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		denied_resources = [ { group = "autoscaling", version = "v2" } ]
+	`), s.Cfg), "Expected to parse denied resources config")
+	s.InitMcpClient()
+	s.Run("hpa_status (denied)", func() {
+		toolResult, err := s.CallTool("hpa_status", map[string]interface{}{"all_namespaces": true})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes denial", func() {
+			msg := toolResult.Content[0].(mcp.TextContent).Text
+			expectedMessage := "failed to get horizontalpodautoscalers status:(.+:)? resource not allowed: autoscaling/v2, Kind=HorizontalPodAutoscaler"
+			s.Regexpf(expectedMessage, msg, "expected descriptive error '%s', got %v", expectedMessage, msg)
+		})
+	})
+}
+
+func TestHorizontalPodAutoscalers(t *testing.T) {
+	suite.Run(t, new(HorizontalPodAutoscalersSuite))
+}
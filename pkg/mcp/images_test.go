@@ -0,0 +1,128 @@
+package mcp
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type ImagesSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+}
+
+func (s *ImagesSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.mockServer.Handle(test.NewDiscoveryClientHandler())
+	pod := func(namespace, name string, initImages []string, images ...string) corev1.Pod {
+		p := corev1.Pod{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		}
+		for i, image := range initImages {
+			p.Spec.InitContainers = append(p.Spec.InitContainers, corev1.Container{Name: "init-" + string(rune('a'+i)), Image: image})
+		}
+		for i, image := range images {
+			p.Spec.Containers = append(p.Spec.Containers, corev1.Container{Name: "container-" + string(rune('a'+i)), Image: image})
+		}
+		return p
+	}
+	defaultPods := []corev1.Pod{
+		pod("default", "web-1", []string{"busybox"}, "nginx:1.27", "envoyproxy/envoy:latest"),
+		pod("default", "web-2", nil, "nginx:1.27", "envoyproxy/envoy:latest"),
+		pod("default", "pinned", nil, "registry.example.com:5000/team/app@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"),
+		pod("default", "same-image-twice", nil, "nginx:1.27", "nginx:1.27"),
+	}
+	otherPods := []corev1.Pod{
+		pod("other", "api", nil, "registry.example.com:5000/team/api"),
+	}
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/api/v1/namespaces/default/pods":
+			test.WriteObject(w, &corev1.PodList{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "PodList"}, Items: defaultPods})
+		case "/api/v1/pods":
+			test.WriteObject(w, &corev1.PodList{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "PodList"}, Items: append(defaultPods, otherPods...)})
+		}
+	}))
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+}
+
+func (s *ImagesSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *ImagesSuite) TestImagesList() {
+	s.InitMcpClient()
+	s.Run("images_list(namespace=default)", func() {
+		toolResult, err := s.CallTool("images_list", map[string]interface{}{"namespace": "default", "all_namespaces": false})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		textContent := toolResult.Content[0].(mcp.TextContent).Text
+		s.Run("returns headers", func() {
+			expectedHeaders := regexp.MustCompile(`(?m)^IMAGE\s+PODS\s+MUTABLE\s*$`)
+			s.Regexpf(expectedHeaders, textContent, "expected headers not found in output:\n%s", textContent)
+		})
+		s.Run("aggregates the distinct images with their pod counts", func() {
+			expectedRows := []string{
+				`(?m)^busybox\s+1\s+yes\s*$`,
+				`(?m)^envoyproxy/envoy:latest\s+2\s+yes\s*$`,
+				`(?m)^nginx:1.27\s+3\s+no\s*$`,
+				`(?m)^registry.example.com:5000/team/app@sha256:[0-9a-f]+\s+1\s+no\s*$`,
+			}
+			for _, row := range expectedRows {
+				s.Regexpf(row, textContent, "expected row '%s' not found in output:\n%s", row, textContent)
+			}
+		})
+		s.Run("does not include images from other namespaces", func() {
+			s.NotContains(textContent, "team/api")
+		})
+	})
+	s.Run("images_list(all_namespaces=true)", func() {
+		toolResult, err := s.CallTool("images_list", map[string]interface{}{"all_namespaces": true})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		textContent := toolResult.Content[0].(mcp.TextContent).Text
+		s.Run("includes images from all namespaces", func() {
+			s.Regexpf(`(?m)^registry.example.com:5000/team/api\s+1\s+yes\s*$`, textContent, "expected image from other namespace in output:\n%s", textContent)
+			s.Regexpf(`(?m)^nginx:1.27\s+3\s+no\s*$`, textContent, "expected image from default namespace in output:\n%s", textContent)
+		})
+	})
+}
+
+func (s *ImagesSuite) TestImagesListDenied() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		denied_resources = [ { version = "v1", kind = "Pod" } ]
+	`), s.Cfg), "Expected to parse denied resources config")
+	s.InitMcpClient()
+	s.Run("images_list (denied)", func() {
+		toolResult, err := s.CallTool("images_list", map[string]interface{}{"namespace": "default"})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes denial", func() {
+			msg := toolResult.Content[0].(mcp.TextContent).Text
+			expectedMessage := "failed to list images:(.+:)? resource not allowed: /v1, Kind=Pod"
+			s.Regexpf(expectedMessage, msg, "expected descriptive error '%s', got %v", expectedMessage, msg)
+		})
+	})
+}
+
+func TestImages(t *testing.T) {
+	suite.Run(t, new(ImagesSuite))
+}
@@ -0,0 +1,201 @@
+package mcp
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/ptr"
+)
+
+type IngressesSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+}
+
+func (s *IngressesSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/apis/networking.k8s.io/v1/namespaces/default/ingresses":
+			test.WriteObject(w, &networkingv1.IngressList{
+				TypeMeta: metav1.TypeMeta{APIVersion: "networking.k8s.io/v1", Kind: "IngressList"},
+				Items: []networkingv1.Ingress{{
+					TypeMeta:   metav1.TypeMeta{APIVersion: "networking.k8s.io/v1", Kind: "Ingress"},
+					ObjectMeta: metav1.ObjectMeta{Name: "an-ingress", Namespace: "default"},
+					Spec: networkingv1.IngressSpec{
+						TLS: []networkingv1.IngressTLS{{Hosts: []string{"secure.example.com"}}},
+						Rules: []networkingv1.IngressRule{
+							{Host: "secure.example.com", IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{
+								Paths: []networkingv1.HTTPIngressPath{{
+									Path:     "/api",
+									PathType: ptr.To(networkingv1.PathTypePrefix),
+									Backend: networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{
+										Name: "api-service", Port: networkingv1.ServiceBackendPort{Number: 8080},
+									}},
+								}},
+							}}},
+							{Host: "plain.example.com", IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{
+								Paths: []networkingv1.HTTPIngressPath{{
+									PathType: ptr.To(networkingv1.PathTypePrefix),
+									Backend: networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{
+										Name: "web-service", Port: networkingv1.ServiceBackendPort{Name: "http"},
+									}},
+								}},
+							}}},
+						},
+					},
+				}},
+			})
+		case "/apis/route.openshift.io/v1/namespaces/default/routes":
+			test.WriteObject(w, &unstructured.UnstructuredList{
+				Object: map[string]interface{}{"apiVersion": "route.openshift.io/v1", "kind": "RouteList"},
+				Items: []unstructured.Unstructured{{Object: map[string]interface{}{
+					"apiVersion": "route.openshift.io/v1",
+					"kind":       "Route",
+					"metadata":   map[string]interface{}{"name": "a-route", "namespace": "default"},
+					"spec": map[string]interface{}{
+						"host": "a-route.apps.example.com",
+						"to":   map[string]interface{}{"kind": "Service", "name": "web-service"},
+						"port": map[string]interface{}{"targetPort": "http"},
+						"tls":  map[string]interface{}{"termination": "Edge"},
+					},
+				}}},
+			})
+		}
+	}))
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+}
+
+func (s *IngressesSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *IngressesSuite) TestIngressesList() {
+	s.mockServer.Handle(test.NewDiscoveryClientHandler(metav1.APIResourceList{
+		GroupVersion: "networking.k8s.io/v1",
+		APIResources: []metav1.APIResource{
+			{Name: "ingresses", Kind: "Ingress", Namespaced: true, Verbs: metav1.Verbs{"get", "list", "watch"}},
+		},
+	}))
+	s.InitMcpClient()
+	s.Run("ingresses_list(namespace=default)", func() {
+		toolResult, err := s.CallTool("ingresses_list", map[string]interface{}{"namespace": "default"})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		textContent := toolResult.Content[0].(mcp.TextContent).Text
+		s.Run("returns headers", func() {
+			expectedHeaders := regexp.MustCompile(`(?m)^NAMESPACE\s+NAME\s+HOST\s+PATH\s+SERVICE\s+PORT\s+TLS\s*$`)
+			s.Regexpf(expectedHeaders, textContent, "expected headers not found in output:\n%s", textContent)
+		})
+		s.Run("returns hosts, paths, backends and TLS status", func() {
+			expectedRows := []string{
+				`default\s+an-ingress\s+secure.example.com\s+/api\s+api-service\s+8080\s+yes`,
+				`default\s+an-ingress\s+plain.example.com\s+/\s+web-service\s+http\s+no`,
+			}
+			for _, row := range expectedRows {
+				s.Regexpf(row, textContent, "expected row '%s' not found in output:\n%s", row, textContent)
+			}
+		})
+	})
+	s.Run("routes_list is not available outside OpenShift", func() {
+		tools, err := s.ListTools(s.T().Context(), mcp.ListToolsRequest{})
+		s.Require().NoError(err)
+		for _, tool := range tools.Tools {
+			s.NotEqual("routes_list", tool.Name)
+		}
+	})
+}
+
+func (s *IngressesSuite) TestIngressesListDenied() {
+	s.mockServer.Handle(test.NewDiscoveryClientHandler(metav1.APIResourceList{
+		GroupVersion: "networking.k8s.io/v1",
+		APIResources: []metav1.APIResource{
+			{Name: "ingresses", Kind: "Ingress", Namespaced: true, Verbs: metav1.Verbs{"get", "list", "watch"}},
+		},
+	}))
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		denied_resources = [ { group = "networking.k8s.io", version = "v1", kind = "Ingress" } ]
+	`), s.Cfg), "Expected to parse denied resources config")
+	s.InitMcpClient()
+	s.Run("ingresses_list (denied)", func() {
+		toolResult, err := s.CallTool("ingresses_list", map[string]interface{}{"namespace": "default"})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes denial", func() {
+			msg := toolResult.Content[0].(mcp.TextContent).Text
+			expectedMessage := "failed to list ingresses:(.+:)? resource not allowed: networking.k8s.io/v1, Kind=Ingress"
+			s.Regexpf(expectedMessage, msg, "expected descriptive error '%s', got %v", expectedMessage, msg)
+		})
+	})
+}
+
+func (s *IngressesSuite) TestRoutesListInOpenShift() {
+	s.mockServer.Handle(test.NewInOpenShiftHandler(metav1.APIResourceList{
+		GroupVersion: "route.openshift.io/v1",
+		APIResources: []metav1.APIResource{
+			{Name: "routes", Kind: "Route", Namespaced: true, Verbs: metav1.Verbs{"get", "list", "watch"}},
+		},
+	}))
+	s.InitMcpClient()
+	s.Run("routes_list(namespace=default)", func() {
+		toolResult, err := s.CallTool("routes_list", map[string]interface{}{"namespace": "default"})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		textContent := toolResult.Content[0].(mcp.TextContent).Text
+		s.Run("returns headers", func() {
+			expectedHeaders := regexp.MustCompile(`(?m)^NAMESPACE\s+NAME\s+HOST\s+PATH\s+SERVICE\s+PORT\s+TERMINATION\s*$`)
+			s.Regexpf(expectedHeaders, textContent, "expected headers not found in output:\n%s", textContent)
+		})
+		s.Run("returns host, backend and TLS termination", func() {
+			expectedRow := `default\s+a-route\s+a-route.apps.example.com\s+/\s+web-service\s+http\s+edge`
+			s.Regexpf(expectedRow, textContent, "expected row '%s' not found in output:\n%s", expectedRow, textContent)
+		})
+	})
+}
+
+func (s *IngressesSuite) TestRoutesListInOpenShiftDenied() {
+	s.mockServer.Handle(test.NewInOpenShiftHandler(metav1.APIResourceList{
+		GroupVersion: "route.openshift.io/v1",
+		APIResources: []metav1.APIResource{
+			{Name: "routes", Kind: "Route", Namespaced: true, Verbs: metav1.Verbs{"get", "list", "watch"}},
+		},
+	}))
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		denied_resources = [ { group = "route.openshift.io", version = "v1", kind = "Route" } ]
+	`), s.Cfg), "Expected to parse denied resources config")
+	s.InitMcpClient()
+	s.Run("routes_list (denied)", func() {
+		toolResult, err := s.CallTool("routes_list", map[string]interface{}{"namespace": "default"})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes denial", func() {
+			msg := toolResult.Content[0].(mcp.TextContent).Text
+			expectedMessage := "failed to list routes:(.+:)? resource not allowed: route.openshift.io/v1, Kind=Route"
+			s.Regexpf(expectedMessage, msg, "expected descriptive error '%s', got %v", expectedMessage, msg)
+		})
+	})
+}
+
+func TestIngresses(t *testing.T) {
+	suite.Run(t, new(IngressesSuite))
+}
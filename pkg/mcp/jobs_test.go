@@ -0,0 +1,217 @@
+package mcp
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+)
+
+type JobsSuite struct {
+	BaseMcpSuite
+	mockServer          *test.MockServer
+	finishingJobQueries int
+}
+
+func (s *JobsSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.finishingJobQueries = 0
+	s.mockServer = test.NewMockServer()
+	s.mockServer.Handle(test.NewDiscoveryClientHandler(metav1.APIResourceList{
+		GroupVersion: "batch/v1",
+		APIResources: []metav1.APIResource{
+			{Name: "jobs", Kind: "Job", Namespaced: true, Verbs: metav1.Verbs{"get", "list", "watch"}},
+			{Name: "cronjobs", Kind: "CronJob", Namespaced: true, Verbs: metav1.Verbs{"get", "list", "watch"}},
+		},
+	}))
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/apis/batch/v1/namespaces/default/jobs/a-job":
+			test.WriteObject(w, &batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{Name: "a-job", Namespace: "default"},
+				Spec:       batchv1.JobSpec{Completions: ptr.To(int32(5))},
+				Status: batchv1.JobStatus{
+					Active:    1,
+					Succeeded: 3,
+					Failed:    2,
+					StartTime: &metav1.Time{Time: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+					Conditions: []batchv1.JobCondition{{
+						Type:   batchv1.JobSuspended,
+						Status: "False",
+						Reason: "JobResumed",
+					}},
+				},
+			})
+		case "/apis/batch/v1/namespaces/default/jobs/a-finishing-job":
+			// The Job completes after being queried once
+			s.finishingJobQueries++
+			job := &batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{Name: "a-finishing-job", Namespace: "default"},
+				Status:     batchv1.JobStatus{Active: 1},
+			}
+			if s.finishingJobQueries > 1 {
+				job.Status = batchv1.JobStatus{
+					Succeeded:  1,
+					Conditions: []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}},
+				}
+			}
+			test.WriteObject(w, job)
+		case "/apis/batch/v1/namespaces/default/cronjobs/a-cronjob":
+			test.WriteObject(w, &batchv1.CronJob{
+				ObjectMeta: metav1.ObjectMeta{Name: "a-cronjob", Namespace: "default"},
+				Spec:       batchv1.CronJobSpec{Schedule: "*/5 * * * *"},
+				Status: batchv1.CronJobStatus{
+					LastScheduleTime: &metav1.Time{Time: time.Date(2025, 1, 1, 0, 5, 0, 0, time.UTC)},
+					Active:           []corev1.ObjectReference{{Name: "a-cronjob-28925765"}},
+				},
+			})
+		}
+	}))
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+}
+
+func (s *JobsSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *JobsSuite) TestJobsStatus() {
+	s.InitMcpClient()
+	s.Run("jobs_status(name=nil)", func() {
+		toolResult, err := s.CallTool("jobs_status", map[string]interface{}{})
+		s.Require().Nil(err, "call tool should not return error object")
+		s.True(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to get job status, missing argument name", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("jobs_status(name=a-job, kind=Deployment)", func() {
+		toolResult, err := s.CallTool("jobs_status", map[string]interface{}{"name": "a-job", "kind": "Deployment"})
+		s.Require().Nil(err, "call tool should not return error object")
+		s.True(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to get job status, invalid kind Deployment, valid values are: Job, CronJob", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("jobs_status(name=a-job)", func() {
+		toolResult, err := s.CallTool("jobs_status", map[string]interface{}{"name": "a-job"})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed %v", toolResult.Content)
+		})
+		var decoded map[string]any
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "invalid tool result content %v", err)
+		})
+		s.Run("reports completion counts", func() {
+			s.EqualValues(5, decoded["Completions"])
+			s.EqualValues(3, decoded["Succeeded"])
+			s.EqualValues(2, decoded["Failed"])
+			s.EqualValues(1, decoded["Active"])
+		})
+		s.Run("reports start time", func() {
+			s.Equal("2025-01-01T00:00:00Z", decoded["StartTime"])
+		})
+		s.Run("reports conditions", func() {
+			s.Require().Len(decoded["Conditions"], 1)
+			condition := decoded["Conditions"].([]any)[0].(map[string]any)
+			s.Equal("Suspended", condition["Type"])
+			s.Equal("JobResumed", condition["Reason"])
+		})
+	})
+	s.Run("jobs_status(name=a-cronjob, kind=CronJob)", func() {
+		toolResult, err := s.CallTool("jobs_status", map[string]interface{}{"name": "a-cronjob", "kind": "CronJob"})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed %v", toolResult.Content)
+		})
+		var decoded map[string]any
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "invalid tool result content %v", err)
+		})
+		s.Run("reports schedule", func() {
+			s.Equal("*/5 * * * *", decoded["Schedule"])
+			s.Equal(false, decoded["Suspended"])
+		})
+		s.Run("reports last schedule time", func() {
+			s.Equal("2025-01-01T00:05:00Z", decoded["LastScheduleTime"])
+		})
+		s.Run("reports active jobs", func() {
+			s.Equal([]any{"a-cronjob-28925765"}, decoded["ActiveJobs"])
+		})
+	})
+}
+
+func (s *JobsSuite) TestJobsStatusFollow() {
+	s.InitMcpClient()
+	s.Run("jobs_status(name=a-cronjob, kind=CronJob, follow=true)", func() {
+		toolResult, err := s.CallTool("jobs_status", map[string]interface{}{"name": "a-cronjob", "kind": "CronJob", "follow": true})
+		s.Require().Nil(err, "call tool should not return error object")
+		s.True(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to get job status, follow is only supported for kind Job", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("jobs_status(name=a-finishing-job, follow=true)", func() {
+		toolResult, err := s.CallTool("jobs_status", map[string]interface{}{"name": "a-finishing-job", "follow": true, "duration": 10})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed %v", toolResult.Content)
+		})
+		var decoded map[string]any
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "invalid tool result content %v", err)
+		})
+		s.Run("waits for the Job to finish", func() {
+			s.Greater(s.finishingJobQueries, 1)
+			s.EqualValues(1, decoded["Succeeded"])
+			s.EqualValues(0, decoded["Active"])
+		})
+	})
+	s.Run("jobs_status(name=a-job, follow=true, duration=1)", func() {
+		toolResult, err := s.CallTool("jobs_status", map[string]interface{}{"name": "a-job", "follow": true, "duration": 1})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed %v", toolResult.Content)
+		})
+		s.Run("reports the Job did not finish", func() {
+			s.True(strings.HasPrefix(toolResult.Content[0].(mcp.TextContent).Text,
+				"# Job a-job did not finish within 1 seconds, showing its last observed status\n"))
+		})
+		s.Run("returns the last observed status", func() {
+			s.Contains(toolResult.Content[0].(mcp.TextContent).Text, "Active: 1")
+		})
+	})
+}
+
+func (s *JobsSuite) TestJobsStatusDenied() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		denied_resources = [ { group = "batch", version = "v1" } ]
+	`), s.Cfg), "Expected to parse denied resources config")
+	s.InitMcpClient()
+	s.Run("jobs_status (denied)", func() {
+		toolResult, err := s.CallTool("jobs_status", map[string]interface{}{"name": "a-job", "namespace": "default"})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes denial", func() {
+			msg := toolResult.Content[0].(mcp.TextContent).Text
+			s.Contains(msg, "resource not allowed:")
+			s.Contains(msg, "failed to get Job status for a-job in namespace default:")
+		})
+	})
+}
+
+func TestJobs(t *testing.T) {
+	suite.Run(t, new(JobsSuite))
+}
@@ -0,0 +1,110 @@
+package mcp
+
+import (
+	"bytes"
+	"flag"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	"k8s.io/klog/v2"
+	"k8s.io/klog/v2/textlogger"
+)
+
+type LogLevelSuite struct {
+	BaseMcpSuite
+	klogState klog.State
+	logBuffer bytes.Buffer
+}
+
+func (s *LogLevelSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.klogState = klog.CaptureState()
+	s.logBuffer.Reset()
+	flags := flag.NewFlagSet("test", flag.ContinueOnError)
+	klog.InitFlags(flags)
+	_ = flags.Set("v", "1")
+	// The logger accepts any verbosity, klog's verbosity (-v) is the one that decides what gets logged
+	klog.SetLogger(textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(9), textlogger.Output(&s.logBuffer))))
+}
+
+func (s *LogLevelSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	s.klogState.Restore()
+}
+
+func (s *LogLevelSuite) TestSetLogLevelNotAvailableByDefault() {
+	s.InitMcpClient()
+	tools, err := s.ListTools(s.T().Context(), mcp.ListToolsRequest{})
+	s.Require().NoError(err)
+	for _, tool := range tools.Tools {
+		s.NotEqual("set_log_level", tool.Name, "set_log_level should not be exposed unless allow_runtime_log_level is enabled")
+	}
+}
+
+func (s *LogLevelSuite) TestSetLogLevel() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		allow_runtime_log_level = true
+	`), s.Cfg), "Expected to parse allow_runtime_log_level config")
+	s.InitMcpClient()
+	s.Run("set_log_level is available", func() {
+		tools, err := s.ListTools(s.T().Context(), mcp.ListToolsRequest{})
+		s.Require().NoError(err)
+		var names []string
+		for _, tool := range tools.Tools {
+			names = append(names, tool.Name)
+		}
+		s.Contains(names, "set_log_level")
+	})
+	s.Run("set_log_level with missing level returns error", func() {
+		toolResult, _ := s.CallTool("set_log_level", map[string]interface{}{})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to set log level, missing argument level", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("set_log_level with out of range level returns error", func() {
+		toolResult, _ := s.CallTool("set_log_level", map[string]interface{}{"level": 10})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to set log level, level must be from 0 to 9, got 10", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("higher verbosity logs are not written before changing the level", func() {
+		_, err := s.CallTool("configuration_view", map[string]interface{}{"minified": true})
+		s.Require().NoError(err)
+		s.NotContains(s.logBuffer.String(), "mcp tool call: configuration_view(")
+	})
+	s.Run("set_log_level(level=5)", func() {
+		toolResult, err := s.CallTool("set_log_level", map[string]interface{}{"level": 5})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		s.Run("returns the previous and the new level", func() {
+			s.Equal("Log level changed from 1 to 5", toolResult.Content[0].(mcp.TextContent).Text)
+		})
+		s.Run("enables the higher verbosity", func() {
+			s.True(klog.V(5).Enabled())
+		})
+	})
+	s.Run("higher verbosity logs are written after changing the level", func() {
+		_, err := s.CallTool("configuration_view", map[string]interface{}{"minified": true})
+		s.Require().NoError(err)
+		s.Contains(s.logBuffer.String(), "mcp tool call: configuration_view(")
+	})
+}
+
+func (s *LogLevelSuite) TestSetLogLevelReadOnly() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		allow_runtime_log_level = true
+		read_only = true
+	`), s.Cfg), "Expected to parse config")
+	s.InitMcpClient()
+	tools, err := s.ListTools(s.T().Context(), mcp.ListToolsRequest{})
+	s.Require().NoError(err)
+	for _, tool := range tools.Tools {
+		s.NotEqual("set_log_level", tool.Name, "set_log_level should not be exposed in read-only mode")
+	}
+}
+
+func TestLogLevel(t *testing.T) {
+	suite.Run(t, new(LogLevelSuite))
+}
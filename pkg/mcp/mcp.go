@@ -6,6 +6,9 @@ import (
 	"net/http"
 	"os"
 	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/coreos/go-oidc/v3/oidc"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
@@ -25,6 +28,12 @@ type ContextKey string
 
 const TokenScopesContextKey = ContextKey("TokenScopesContextKey")
 
+// toolsetLoadRetryMaxBackoff is the maximum delay between the retries of the initial toolset load
+const toolsetLoadRetryMaxBackoff = 30 * time.Second
+
+// toolsetLoadRetryInitialBackoff is the delay before the first retry of the initial toolset load, doubled on each retry
+var toolsetLoadRetryInitialBackoff = time.Second
+
 type Configuration struct {
 	*config.StaticConfig
 	listOutput output.Output
@@ -36,13 +45,54 @@ func (c *Configuration) Toolsets() []api.Toolset {
 		for _, toolset := range c.StaticConfig.Toolsets {
 			c.toolsets = append(c.toolsets, toolsets.ToolsetFromString(toolset))
 		}
+		c.toolsets = withToolsetDependencies(c.toolsets)
 	}
 	return c.toolsets
 }
 
+// withToolsetDependencies returns the provided toolsets followed by any (transitive) dependency
+// that is not explicitly enabled.
+func withToolsetDependencies(enabled []api.Toolset) []api.Toolset {
+	names := make([]string, 0, len(enabled))
+	for _, toolset := range enabled {
+		if toolset != nil {
+			names = append(names, toolset.GetName())
+		}
+	}
+	for i := 0; i < len(enabled); i++ {
+		if enabled[i] == nil {
+			continue
+		}
+		for _, dependency := range enabled[i].GetDependencies() {
+			if slices.Contains(names, dependency) {
+				continue
+			}
+			dependencyToolset := toolsets.ToolsetFromString(dependency)
+			if dependencyToolset == nil {
+				klog.Warningf("Toolset %s requires unknown toolset %s", enabled[i].GetName(), dependency)
+				continue
+			}
+			klog.V(1).Infof("Toolset %s auto-enabled (required by %s)", dependency, enabled[i].GetName())
+			names = append(names, dependency)
+			enabled = append(enabled, dependencyToolset)
+		}
+	}
+	return enabled
+}
+
 func (c *Configuration) ListOutput() output.Output {
 	if c.listOutput == nil {
-		c.listOutput = output.FromString(c.StaticConfig.ListOutput)
+		maxListItems := c.StaticConfig.MaxListItems
+		if maxListItems <= 0 {
+			maxListItems = internalk8s.DefaultMaxListItems
+		}
+		c.listOutput = output.WithTruncationNotice(
+			output.WithMaskedMetadataKeys(
+				output.WithListSummaryThreshold(output.FromString(c.StaticConfig.ListOutput), c.StaticConfig.ListSummaryThreshold),
+				c.StaticConfig.MaskedMetadataKeys,
+			),
+			maxListItems,
+		)
 	}
 	return c.listOutput
 }
@@ -51,7 +101,8 @@ func (c *Configuration) isToolApplicable(tool api.ServerTool) bool {
 	if c.ReadOnly && !ptr.Deref(tool.Tool.Annotations.ReadOnlyHint, false) {
 		return false
 	}
-	if c.DisableDestructive && ptr.Deref(tool.Tool.Annotations.DestructiveHint, false) {
+	if c.DisableDestructive && ptr.Deref(tool.Tool.Annotations.DestructiveHint, false) &&
+		!slices.Contains(c.AllowedDestructiveTools, tool.Tool.Name) {
 		return false
 	}
 	if c.EnabledTools != nil && !slices.Contains(c.EnabledTools, tool.Tool.Name) {
@@ -60,9 +111,33 @@ func (c *Configuration) isToolApplicable(tool api.ServerTool) bool {
 	if c.DisabledTools != nil && slices.Contains(c.DisabledTools, tool.Tool.Name) {
 		return false
 	}
+	if !tool.IsEnabled(c) {
+		return false
+	}
 	return true
 }
 
+// implementation returns the MCP Implementation advertised by the server, honoring the configured overrides.
+func implementation(staticConfig *config.StaticConfig) *mcp.Implementation {
+	impl := &mcp.Implementation{
+		Name:       version.BinaryName,
+		Title:      version.BinaryName,
+		Version:    version.Version,
+		WebsiteURL: version.WebsiteURL,
+	}
+	if staticConfig == nil {
+		return impl
+	}
+	if staticConfig.ServerName != "" {
+		impl.Name = staticConfig.ServerName
+		impl.Title = staticConfig.ServerName
+	}
+	if staticConfig.ServerTitle != "" {
+		impl.Title = staticConfig.ServerTitle
+	}
+	return impl
+}
+
 type Server struct {
 	configuration  *Configuration
 	oidcProvider   *oidc.Provider
@@ -71,20 +146,27 @@ type Server struct {
 	enabledTools   []string
 	enabledPrompts []string
 	p              internalk8s.Provider
+	auditLog       *auditLogger
+	// stopWarmUp cancels the startup warm-up of the targets (warm_targets_on_startup), nil if not enabled
+	stopWarmUp context.CancelFunc
+	// toolCalls tracks the in-flight tool calls so that they can be drained on shutdown
+	toolCalls       sync.WaitGroup
+	activeToolCalls atomic.Int64
+	// toolCallsMu guards draining so that no tool call is added to toolCalls once WaitForToolCalls started waiting
+	toolCallsMu sync.Mutex
+	draining    bool
 }
 
-func NewServer(configuration Configuration, oidcProvider *oidc.Provider, httpClient *http.Client) (*Server, error) {
+// NewServer creates a new MCP server for the provided configuration.
+// The provided context bounds the server startup (default target validation and toolset load retries), it should be
+// cancelled when the process is asked to terminate so that the startup doesn't wait out the remaining retries.
+func NewServer(ctx context.Context, configuration Configuration, oidcProvider *oidc.Provider, httpClient *http.Client) (*Server, error) {
 	s := &Server{
 		configuration: &configuration,
 		oidcProvider:  oidcProvider,
 		httpClient:    httpClient,
 		server: mcp.NewServer(
-			&mcp.Implementation{
-				Name:       version.BinaryName,
-				Title:      version.BinaryName,
-				Version:    version.Version,
-				WebsiteURL: version.WebsiteURL,
-			},
+			implementation(configuration.StaticConfig),
 			&mcp.ServerOptions{
 				Capabilities: &mcp.ServerCapabilities{
 					Resources: nil,
@@ -95,32 +177,104 @@ func NewServer(configuration Configuration, oidcProvider *oidc.Provider, httpCli
 			}),
 	}
 
-	s.server.AddReceivingMiddleware(authHeaderPropagationMiddleware)
-	s.server.AddReceivingMiddleware(toolCallLoggingMiddleware)
+	var err error
+	s.auditLog, err = newAuditLogger(configuration.AuditLogPath, configuration.AuditLogRedactedKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	s.server.AddReceivingMiddleware(s.authHeaderPropagationMiddleware)
+	s.server.AddReceivingMiddleware(s.toolCallLoggingMiddleware)
+	s.server.AddReceivingMiddleware(s.auditLogMiddleware)
+	s.server.AddReceivingMiddleware(s.toolCallTrackingMiddleware)
 	if configuration.RequireOAuth && false { // TODO: Disabled scope auth validation for now
 		s.server.AddReceivingMiddleware(toolScopedAuthorizationMiddleware)
 	}
+	// Added last so that it wraps (and runs before) the rest of the middleware
+	s.server.AddReceivingMiddleware(requestIDMiddleware)
 
-	var err error
 	s.p, err = internalk8s.NewProvider(s.configuration.StaticConfig)
 	if err != nil {
 		return nil, err
 	}
-	err = s.reloadToolsets()
+	if err = s.validateDefaultTarget(ctx); err != nil {
+		s.p.Close()
+		return nil, err
+	}
+	err = s.loadInitialToolsets(ctx)
 	if err != nil {
+		s.p.Close()
 		return nil, err
 	}
-	s.p.WatchTargets(s.reloadToolsets)
+	if s.configuration.WarmTargetsOnStartup {
+		var warmUpCtx context.Context
+		warmUpCtx, s.stopWarmUp = context.WithCancel(context.Background())
+		go internalk8s.WarmUpTargets(warmUpCtx, s.p, s.configuration.GetMultiClusterConcurrency())
+	}
+	s.p.WatchTargets(s.reloadTargets)
 
 	return s, nil
 }
 
+// validateDefaultTarget checks that the default cluster target is reachable if configured (validate_default_target_on_startup).
+// An unreachable target is logged as a warning, or returned as an error if the server is configured to fail fast.
+func (s *Server) validateDefaultTarget(ctx context.Context) error {
+	mode := s.configuration.ValidateDefaultTargetOnStartup
+	if mode == "" {
+		return nil
+	}
+	err := internalk8s.ValidateDefaultTarget(ctx, s.p)
+	if err == nil {
+		return nil
+	}
+	if mode == config.ValidateDefaultTargetFail {
+		return err
+	}
+	klog.Warningf("%v, tool calls without an explicit target will fail", err)
+	return nil
+}
+
+// loadInitialToolsets loads the toolsets at startup, retrying with an exponential backoff up to the configured
+// number of times (toolset_load_retries) if the load fails. The retries stop early if the provided context is done.
+func (s *Server) loadInitialToolsets(ctx context.Context) error {
+	backoff := toolsetLoadRetryInitialBackoff
+	err := s.reloadToolsets()
+	for attempt := 1; err != nil && attempt <= s.configuration.ToolsetLoadRetries; attempt++ {
+		klog.Warningf("Failed to load toolsets, retrying in %s (retry %d of %d): %v",
+			backoff, attempt, s.configuration.ToolsetLoadRetries, err)
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("%w (retries cancelled: %w)", err, ctx.Err())
+		case <-timer.C:
+		}
+		backoff = min(backoff*2, toolsetLoadRetryMaxBackoff)
+		err = s.reloadToolsets()
+	}
+	return err
+}
+
+// reloadToolsets (re)registers the tools and prompts applicable to the current configuration and cluster targets.
+// The new tools and prompts are computed before the registered ones are replaced, if the reload fails (e.g. transient
+// API outage while retrieving the targets) the previously registered tools and prompts are kept.
 func (s *Server) reloadToolsets() error {
+	if err := s.loadToolsets(); err != nil {
+		if s.enabledTools != nil {
+			klog.Errorf("Failed to reload toolsets, keeping the %d previously registered tools and %d prompts: %v",
+				len(s.enabledTools), len(s.enabledPrompts), err)
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *Server) loadToolsets() error {
 	ctx := context.Background()
 
 	targets, err := s.p.GetTargets(ctx)
 	if err != nil {
-		return err
+		return fmt.Errorf("failed to get targets: %w", err)
 	}
 
 	filter := CompositeFilter(
@@ -128,21 +282,25 @@ func (s *Server) reloadToolsets() error {
 		ShouldIncludeTargetListTool(s.p.GetTargetParameterName(), targets),
 	)
 
-	mutator := WithTargetParameter(
-		s.p.GetDefaultTarget(),
-		s.p.GetTargetParameterName(),
-		targets,
+	mutator := CompositeMutator(
+		WithTargetParameter(
+			s.p.GetDefaultTarget(),
+			s.GetTargetParameterName(),
+			targets,
+			s.configuration.MaxTargetEnumSize,
+		),
+		WithOpenShiftToolPrefix(s.configuration.OpenShiftToolPrefix),
+		WithAllNamespacesDefault(s.configuration.GetDefaultAllNamespaces()),
 	)
 
 	// TODO: No option to perform a full replacement of tools.
 	// s.server.SetTools(m3labsServerTools...)
 
-	// Track previously enabled tools
-	previousTools := s.enabledTools
-
 	// Build new list of applicable tools
 	applicableTools := make([]api.ServerTool, 0)
-	s.enabledTools = make([]string, 0)
+	enabledTools := make([]string, 0)
+	// Toolset name that registered each of the applicable tools, to detect tool name conflicts
+	toolOwners := make(map[string]string)
 	for _, toolset := range s.configuration.Toolsets() {
 		for _, tool := range toolset.GetTools(s.p) {
 			tool := mutator(tool)
@@ -150,32 +308,34 @@ func (s *Server) reloadToolsets() error {
 				continue
 			}
 
-			applicableTools = append(applicableTools, tool)
-			s.enabledTools = append(s.enabledTools, tool.Tool.Name)
-		}
-	}
+			if owner, conflict := toolOwners[tool.Tool.Name]; conflict {
+				if s.configuration.ToolConflictPolicy == api.ToolConflictPolicyError {
+					return fmt.Errorf("tool %s is registered by multiple toolsets: %s, %s", tool.Tool.Name, owner, toolset.GetName())
+				}
+				klog.Warningf("Tool %s is registered by multiple toolsets: %s, %s, the tool from %s takes precedence",
+					tool.Tool.Name, owner, toolset.GetName(), toolset.GetName())
+				applicableTools[slices.Index(enabledTools, tool.Tool.Name)] = tool
+				toolOwners[tool.Tool.Name] = toolset.GetName()
+				continue
+			}
 
-	// TODO: No option to perform a full replacement of tools.
-	// Remove tools that are no longer applicable
-	toolsToRemove := make([]string, 0)
-	for _, oldTool := range previousTools {
-		if !slices.Contains(s.enabledTools, oldTool) {
-			toolsToRemove = append(toolsToRemove, oldTool)
+			toolOwners[tool.Tool.Name] = toolset.GetName()
+			applicableTools = append(applicableTools, tool)
+			enabledTools = append(enabledTools, tool.Tool.Name)
 		}
 	}
-	s.server.RemoveTools(toolsToRemove...)
 
+	goSdkTools := make([]*mcp.Tool, 0, len(applicableTools))
+	goSdkToolHandlers := make([]mcp.ToolHandler, 0, len(applicableTools))
 	for _, tool := range applicableTools {
 		goSdkTool, goSdkToolHandler, err := ServerToolToGoSdkTool(s, tool)
 		if err != nil {
 			return fmt.Errorf("failed to convert tool %s: %v", tool.Tool.Name, err)
 		}
-		s.server.AddTool(goSdkTool, goSdkToolHandler)
+		goSdkTools = append(goSdkTools, goSdkTool)
+		goSdkToolHandlers = append(goSdkToolHandlers, goSdkToolHandler)
 	}
 
-	// Track previously enabled prompts
-	previousPrompts := s.enabledPrompts
-
 	// Build and register prompts from all toolsets
 	toolsetPrompts := make([]api.ServerPrompt, 0)
 	// Load embedded toolset prompts
@@ -188,32 +348,49 @@ func (s *Server) reloadToolsets() error {
 	// Merge: config prompts override embedded prompts with same name
 	applicablePrompts := prompts.MergePrompts(toolsetPrompts, configPrompts)
 
-	// Update enabled prompts list
-	s.enabledPrompts = make([]string, 0)
+	enabledPrompts := make([]string, 0, len(applicablePrompts))
+	goSdkPrompts := make([]*mcp.Prompt, 0, len(applicablePrompts))
+	goSdkPromptHandlers := make([]mcp.PromptHandler, 0, len(applicablePrompts))
 	for _, prompt := range applicablePrompts {
-		s.enabledPrompts = append(s.enabledPrompts, prompt.Prompt.Name)
+		mcpPrompt, promptHandler, err := ServerPromptToGoSdkPrompt(s, prompt)
+		if err != nil {
+			return fmt.Errorf("failed to convert prompt %s: %v", prompt.Prompt.Name, err)
+		}
+		enabledPrompts = append(enabledPrompts, prompt.Prompt.Name)
+		goSdkPrompts = append(goSdkPrompts, mcpPrompt)
+		goSdkPromptHandlers = append(goSdkPromptHandlers, promptHandler)
+	}
+
+	// Everything was built successfully, replace the registered tools and prompts
+	// TODO: No option to perform a full replacement of tools.
+	// Remove tools that are no longer applicable
+	toolsToRemove := make([]string, 0)
+	for _, oldTool := range s.enabledTools {
+		if !slices.Contains(enabledTools, oldTool) {
+			toolsToRemove = append(toolsToRemove, oldTool)
+		}
+	}
+	s.server.RemoveTools(toolsToRemove...)
+	for i := range goSdkTools {
+		s.server.AddTool(goSdkTools[i], goSdkToolHandlers[i])
 	}
+	s.enabledTools = enabledTools
 
 	// Remove prompts that are no longer applicable
 	promptsToRemove := make([]string, 0)
-	for _, oldPrompt := range previousPrompts {
-		if !slices.Contains(s.enabledPrompts, oldPrompt) {
+	for _, oldPrompt := range s.enabledPrompts {
+		if !slices.Contains(enabledPrompts, oldPrompt) {
 			promptsToRemove = append(promptsToRemove, oldPrompt)
 		}
 	}
 	s.server.RemovePrompts(promptsToRemove...)
-
-	// Register all applicable prompts
-	for _, prompt := range applicablePrompts {
-		mcpPrompt, promptHandler, err := ServerPromptToGoSdkPrompt(s, prompt)
-		if err != nil {
-			return fmt.Errorf("failed to convert prompt %s: %v", prompt.Prompt.Name, err)
-		}
-		s.server.AddPrompt(mcpPrompt, promptHandler)
+	for i := range goSdkPrompts {
+		s.server.AddPrompt(goSdkPrompts[i], goSdkPromptHandlers[i])
 	}
+	s.enabledPrompts = enabledPrompts
 
 	// start new watch
-	s.p.WatchTargets(s.reloadToolsets)
+	s.p.WatchTargets(s.reloadTargets)
 	return nil
 }
 
@@ -243,11 +420,17 @@ func (s *Server) ServeHTTP() *mcp.StreamableHTTPHandler {
 }
 
 // GetTargetParameterName returns the parameter name used for target identification in MCP requests
+// (the provider's unless overridden by the configured target_parameter_name)
 func (s *Server) GetTargetParameterName() string {
 	if s.p == nil {
 		return "" // fallback for uninitialized provider
 	}
-	return s.p.GetTargetParameterName()
+	targetParameterName := s.p.GetTargetParameterName()
+	if targetParameterName != "" && s.configuration.TargetParameterName != "" {
+		// single-cluster providers have no target parameter to rename
+		return s.configuration.TargetParameterName
+	}
+	return targetParameterName
 }
 
 func (s *Server) GetEnabledTools() []string {
@@ -271,6 +454,11 @@ func (s *Server) ReloadConfiguration(newConfig *config.StaticConfig) error {
 	s.configuration.listOutput = nil
 	s.configuration.toolsets = nil
 
+	// Reopen the audit log so that rotated files are picked up and path changes are applied
+	if err := s.auditLog.reopen(newConfig.AuditLogPath, newConfig.AuditLogRedactedKeys); err != nil {
+		return fmt.Errorf("failed to reopen audit log: %w", err)
+	}
+
 	// Reload the Kubernetes provider (this will also rebuild tools)
 	if err := s.reloadToolsets(); err != nil {
 		return fmt.Errorf("failed to reload toolsets: %w", err)
@@ -280,10 +468,65 @@ func (s *Server) ReloadConfiguration(newConfig *config.StaticConfig) error {
 	return nil
 }
 
+// ActiveToolCalls returns the number of tool calls currently being handled.
+func (s *Server) ActiveToolCalls() int64 {
+	return s.activeToolCalls.Load()
+}
+
+// trackToolCall registers a new in-flight tool call, it returns false if the server is draining the tool calls.
+func (s *Server) trackToolCall() bool {
+	s.toolCallsMu.Lock()
+	defer s.toolCallsMu.Unlock()
+	if s.draining {
+		return false
+	}
+	s.toolCalls.Add(1)
+	s.activeToolCalls.Add(1)
+	return true
+}
+
+// WaitForToolCalls blocks until all the in-flight tool calls complete or the provided context is done.
+// It's intended to be called on shutdown, after the server stopped accepting new requests and before Close.
+// Any tool call received after WaitForToolCalls is called is rejected.
+func (s *Server) WaitForToolCalls(ctx context.Context) error {
+	s.toolCallsMu.Lock()
+	s.draining = true
+	s.toolCallsMu.Unlock()
+	done := make(chan struct{})
+	go func() {
+		s.toolCalls.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("%d tool calls still in progress: %w", s.ActiveToolCalls(), ctx.Err())
+	}
+}
+
+// reloadTargets is the callback for the provider target changes, the targets might have been replaced so the startup
+// warm-up (if still running) is stopped before the toolsets are reloaded.
+func (s *Server) reloadTargets() error {
+	s.cancelWarmUp()
+	return s.reloadToolsets()
+}
+
+// cancelWarmUp stops the startup warm-up of the targets if it's still running.
+func (s *Server) cancelWarmUp() {
+	if s.stopWarmUp != nil {
+		s.stopWarmUp()
+	}
+}
+
 func (s *Server) Close() {
+	s.cancelWarmUp()
 	if s.p != nil {
 		s.p.Close()
 	}
+	if s.auditLog != nil {
+		s.auditLog.close()
+	}
 }
 
 func NewTextResult(content string, err error) *mcp.CallToolResult {
@@ -0,0 +1,92 @@
+package mcp
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type McpAuditLogSuite struct {
+	BaseMcpSuite
+	auditLogPath string
+}
+
+func (s *McpAuditLogSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.auditLogPath = filepath.Join(s.T().TempDir(), "audit.log")
+	s.Cfg.AuditLogPath = s.auditLogPath
+	s.Cfg.AuditLogRedactedKeys = []string{"minified"}
+}
+
+func (s *McpAuditLogSuite) readAuditLog() []map[string]any {
+	f, err := os.Open(s.auditLogPath)
+	s.Require().NoError(err, "failed to open audit log")
+	defer func() { _ = f.Close() }()
+	var entries []map[string]any
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var entry map[string]any
+		s.Require().NoError(json.Unmarshal(scanner.Bytes(), &entry), "failed to parse audit log line %s", scanner.Text())
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func (s *McpAuditLogSuite) TestWritesLinePerToolCall() {
+	s.InitMcpClient()
+	_, err := s.CallTool("configuration_view", map[string]interface{}{"minified": false})
+	s.Require().NoError(err, "call to tool configuration_view failed")
+	_, err = s.CallTool("namespaces_list", map[string]interface{}{})
+	s.Require().NoError(err, "call to tool namespaces_list failed")
+
+	entries := s.readAuditLog()
+	s.Run("writes one line per call", func() {
+		s.Require().Len(entries, 2)
+		s.Equal("configuration_view", entries[0]["tool"])
+		s.Equal("namespaces_list", entries[1]["tool"])
+	})
+	s.Run("records outcome", func() {
+		s.Equal("success", entries[0]["outcome"])
+		s.Equal("success", entries[1]["outcome"])
+	})
+	s.Run("records timestamp and duration", func() {
+		s.NotEmpty(entries[0]["timestamp"])
+		s.Contains(entries[0], "duration_ms")
+	})
+	s.Run("redacts configured keys", func() {
+		s.Equal(map[string]any{"minified": "[REDACTED]"}, entries[0]["arguments"])
+	})
+}
+
+func (s *McpAuditLogSuite) TestRecordsFailedToolCall() {
+	s.InitMcpClient()
+	_, err := s.CallTool("pods_get", map[string]interface{}{"name": "inexistent-pod", "token": "should-not-be-logged"})
+	s.Require().NoError(err, "call to tool pods_get failed")
+
+	entries := s.readAuditLog()
+	s.Require().Len(entries, 1)
+	s.Run("records error outcome", func() {
+		s.Equal("error", entries[0]["outcome"])
+		s.Contains(entries[0]["error"], "inexistent-pod")
+	})
+	s.Run("redacts default sensitive keys", func() {
+		s.Equal("[REDACTED]", entries[0]["arguments"].(map[string]any)["token"])
+	})
+}
+
+func (s *McpAuditLogSuite) TestDisabledWhenPathEmpty() {
+	s.Cfg.AuditLogPath = ""
+	s.InitMcpClient()
+	_, err := s.CallTool("namespaces_list", map[string]interface{}{})
+	s.Require().NoError(err, "call to tool namespaces_list failed")
+	_, err = os.Stat(s.auditLogPath)
+	s.True(os.IsNotExist(err), "audit log file should not be created")
+}
+
+func TestMcpAuditLog(t *testing.T) {
+	suite.Run(t, new(McpAuditLogSuite))
+}
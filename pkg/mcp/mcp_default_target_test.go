@@ -0,0 +1,88 @@
+package mcp
+
+import (
+	"bytes"
+	"flag"
+	"net/http"
+	"testing"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+	"github.com/stretchr/testify/suite"
+	"k8s.io/klog/v2"
+	"k8s.io/klog/v2/textlogger"
+)
+
+type DefaultTargetValidationSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+	klogState  klog.State
+	logBuffer  bytes.Buffer
+}
+
+func (s *DefaultTargetValidationSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.mockServer.Handle(test.NewDiscoveryClientHandler())
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/version" {
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"major": "1", "minor": "33", "gitVersion": "v1.33.0"}`))
+	}))
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.klogState = klog.CaptureState()
+	s.logBuffer.Reset()
+	flags := flag.NewFlagSet("test", flag.ContinueOnError)
+	klog.InitFlags(flags)
+	klog.SetLogger(textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(0), textlogger.Output(&s.logBuffer))))
+}
+
+func (s *DefaultTargetValidationSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	s.klogState.Restore()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *DefaultTargetValidationSuite) TestReachableDefaultTarget() {
+	s.Cfg.ValidateDefaultTargetOnStartup = "fail"
+	server, err := NewServer(s.T().Context(), Configuration{StaticConfig: s.Cfg}, nil, nil)
+	s.Require().NoError(err, "Expected no error for a reachable default target")
+	s.T().Cleanup(server.Close)
+	s.NotContains(s.logBuffer.String(), "is unreachable")
+}
+
+func (s *DefaultTargetValidationSuite) TestUnreachableDefaultTarget() {
+	// The current-context of the kubeconfig points to a server that is no longer listening
+	s.mockServer.Close()
+	s.Run("warn logs a warning and starts the server", func() {
+		s.logBuffer.Reset()
+		s.Cfg.ValidateDefaultTargetOnStartup = "warn"
+		server, err := NewServer(s.T().Context(), Configuration{StaticConfig: s.Cfg}, nil, nil)
+		s.Require().NoError(err, "Expected no error when the validation only warns")
+		s.T().Cleanup(server.Close)
+		s.Contains(s.logBuffer.String(), "is unreachable")
+		s.Contains(s.logBuffer.String(), "tool calls without an explicit target will fail")
+	})
+	s.Run("fail returns an error", func() {
+		s.Cfg.ValidateDefaultTargetOnStartup = "fail"
+		server, err := NewServer(s.T().Context(), Configuration{StaticConfig: s.Cfg}, nil, nil)
+		s.Require().Error(err, "Expected error when the validation fails fast")
+		s.Nil(server)
+		s.ErrorContains(err, "is unreachable")
+	})
+	s.Run("disabled by default", func() {
+		s.logBuffer.Reset()
+		s.Cfg.ValidateDefaultTargetOnStartup = ""
+		server, err := NewServer(s.T().Context(), Configuration{StaticConfig: s.Cfg}, nil, nil)
+		s.Require().NoError(err, "Expected no error when the validation is disabled")
+		s.T().Cleanup(server.Close)
+		s.NotContains(s.logBuffer.String(), "is unreachable")
+	})
+}
+
+func TestDefaultTargetValidation(t *testing.T) {
+	suite.Run(t, new(DefaultTargetValidationSuite))
+}
@@ -53,6 +53,35 @@ func (s *McpLoggingSuite) TestLogsToolCall() {
 	})
 }
 
+func (s *McpLoggingSuite) TestLogsToolCallRedactedArguments() {
+	s.SetLogLevel(5)
+	s.Cfg.RedactedToolArgs = []string{"API_KEY"}
+	s.InitMcpClient()
+	_, err := s.CallTool("configuration_view", map[string]interface{}{
+		"minified": false,
+		"token":    "a-token-that-should-not-be-logged",
+		"api_key":  "an-api-key-that-should-not-be-logged",
+		"nested":   map[string]interface{}{"password": "a-password-that-should-not-be-logged"},
+	})
+	s.Require().NoError(err, "call to tool configuration_view failed")
+
+	s.Run("Logs non-sensitive arguments", func() {
+		s.Contains(s.logBuffer.String(), "minified:false")
+	})
+	s.Run("Redacts default sensitive arguments", func() {
+		s.Contains(s.logBuffer.String(), "token:[REDACTED]")
+		s.NotContains(s.logBuffer.String(), "a-token-that-should-not-be-logged")
+	})
+	s.Run("Redacts configured arguments (case-insensitive)", func() {
+		s.Contains(s.logBuffer.String(), "api_key:[REDACTED]")
+		s.NotContains(s.logBuffer.String(), "an-api-key-that-should-not-be-logged")
+	})
+	s.Run("Redacts nested arguments", func() {
+		s.Contains(s.logBuffer.String(), "nested:map[password:[REDACTED]]")
+		s.NotContains(s.logBuffer.String(), "a-password-that-should-not-be-logged")
+	})
+}
+
 func (s *McpLoggingSuite) TestLogsToolCallHeaders() {
 	s.SetLogLevel(7)
 	s.InitMcpClient(transport.WithHTTPHeaders(map[string]string{
@@ -1,10 +1,14 @@
 package mcp
 
 import (
+	"context"
+	"errors"
+	"slices"
 	"testing"
 
 	"github.com/containers/kubernetes-mcp-server/internal/test"
 	"github.com/containers/kubernetes-mcp-server/pkg/config"
+	internalk8s "github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/stretchr/testify/suite"
 )
@@ -34,7 +38,7 @@ func (s *ConfigReloadSuite) TearDownTest() {
 
 func (s *ConfigReloadSuite) TestConfigurationReload() {
 	// Initialize server with initial config
-	server, err := NewServer(Configuration{
+	server, err := NewServer(s.T().Context(), Configuration{
 		StaticConfig: s.Cfg,
 	}, nil, nil)
 	s.Require().NoError(err)
@@ -109,7 +113,7 @@ func (s *ConfigReloadSuite) TestConfigurationReload() {
 }
 
 func (s *ConfigReloadSuite) TestConfigurationValues() {
-	server, err := NewServer(Configuration{
+	server, err := NewServer(s.T().Context(), Configuration{
 		StaticConfig: s.Cfg,
 	}, nil, nil)
 	s.Require().NoError(err)
@@ -137,7 +141,7 @@ func (s *ConfigReloadSuite) TestConfigurationValues() {
 }
 
 func (s *ConfigReloadSuite) TestMultipleReloads() {
-	server, err := NewServer(Configuration{
+	server, err := NewServer(s.T().Context(), Configuration{
 		StaticConfig: s.Cfg,
 	}, nil, nil)
 	s.Require().NoError(err)
@@ -174,7 +178,7 @@ func (s *ConfigReloadSuite) TestMultipleReloads() {
 }
 
 func (s *ConfigReloadSuite) TestReloadUpdatesToolsets() {
-	server, err := NewServer(Configuration{
+	server, err := NewServer(s.T().Context(), Configuration{
 		StaticConfig: s.Cfg,
 	}, nil, nil)
 	s.Require().NoError(err)
@@ -210,7 +214,7 @@ func (s *ConfigReloadSuite) TestReloadUpdatesToolsets() {
 }
 
 func (s *ConfigReloadSuite) TestServerLifecycle() {
-	server, err := NewServer(Configuration{
+	server, err := NewServer(s.T().Context(), Configuration{
 		StaticConfig: s.Cfg,
 	}, nil, nil)
 	s.Require().NoError(err)
@@ -228,6 +232,48 @@ func (s *ConfigReloadSuite) TestServerLifecycle() {
 	})
 }
 
+// failingTargetsProvider simulates a transient API outage by failing to retrieve the targets
+type failingTargetsProvider struct {
+	internalk8s.Provider
+}
+
+func (p *failingTargetsProvider) GetTargets(_ context.Context) ([]string, error) {
+	return nil, errors.New("connection refused")
+}
+
+func (s *ConfigReloadSuite) TestReloadKeepsToolsWhenGetTargetsFails() {
+	s.InitMcpClient()
+	initialTools, err := s.ListTools(s.T().Context(), mcp.ListToolsRequest{})
+	s.Require().NoError(err)
+	s.Require().Greater(len(initialTools.Tools), 0)
+	initialEnabledTools := slices.Clone(s.mcpServer.GetEnabledTools())
+	initialEnabledPrompts := slices.Clone(s.mcpServer.GetEnabledPrompts())
+
+	provider := s.mcpServer.p
+	s.mcpServer.p = &failingTargetsProvider{Provider: provider}
+	s.T().Cleanup(func() { s.mcpServer.p = provider })
+
+	err = s.mcpServer.reloadToolsets()
+	s.Run("returns the error", func() {
+		s.Require().Error(err)
+		s.Equal("failed to get targets: connection refused", err.Error())
+	})
+	s.Run("keeps the previously enabled tools and prompts", func() {
+		s.Equal(initialEnabledTools, s.mcpServer.GetEnabledTools())
+		s.Equal(initialEnabledPrompts, s.mcpServer.GetEnabledPrompts())
+	})
+	s.Run("keeps the previously registered tools", func() {
+		tools, err := s.ListTools(s.T().Context(), mcp.ListToolsRequest{})
+		s.Require().NoError(err)
+		s.Len(tools.Tools, len(initialTools.Tools))
+	})
+	s.Run("recovers once the targets are available again", func() {
+		s.mcpServer.p = provider
+		s.Require().NoError(s.mcpServer.reloadToolsets())
+		s.Equal(initialEnabledTools, s.mcpServer.GetEnabledTools())
+	})
+}
+
 func TestConfigReload(t *testing.T) {
 	suite.Run(t, new(ConfigReloadSuite))
 }
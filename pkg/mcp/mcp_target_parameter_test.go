@@ -0,0 +1,109 @@
+package mcp
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd/api"
+)
+
+type TargetParameterNameSuite struct {
+	BaseMcpSuite
+	mockServer      *test.MockServer
+	otherMockServer *test.MockServer
+}
+
+func (s *TargetParameterNameSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	namespacesHandler := func(namespace string) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if req.URL.Path != "/api/v1/namespaces" {
+				return
+			}
+			test.WriteObject(w, &corev1.NamespaceList{
+				TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "NamespaceList"},
+				Items: []corev1.Namespace{
+					{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Namespace"}, ObjectMeta: metav1.ObjectMeta{Name: namespace}},
+				},
+			})
+		})
+	}
+	s.mockServer = test.NewMockServer()
+	s.mockServer.Handle(test.NewDiscoveryClientHandler())
+	s.mockServer.Handle(namespacesHandler("ns-in-fake-context"))
+	s.otherMockServer = test.NewMockServer()
+	s.otherMockServer.Handle(test.NewDiscoveryClientHandler())
+	s.otherMockServer.Handle(namespacesHandler("ns-in-other-context"))
+	kubeconfig := s.mockServer.Kubeconfig()
+	otherKubeconfig := s.otherMockServer.Kubeconfig()
+	kubeconfig.Clusters["other"] = otherKubeconfig.Clusters["fake"]
+	kubeconfig.AuthInfos["other"] = otherKubeconfig.AuthInfos["fake"]
+	kubeconfig.Contexts["other-context"] = api.NewContext()
+	kubeconfig.Contexts["other-context"].Cluster = "other"
+	kubeconfig.Contexts["other-context"].AuthInfo = "other"
+	s.Cfg.KubeConfig = test.KubeconfigFile(s.T(), kubeconfig)
+	s.Cfg.TargetParameterName = "cluster"
+}
+
+func (s *TargetParameterNameSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+	if s.otherMockServer != nil {
+		s.otherMockServer.Close()
+	}
+}
+
+func (s *TargetParameterNameSuite) TestToolsUseOverriddenName() {
+	s.InitMcpClient()
+	tools, err := s.ListTools(s.T().Context(), mcp.ListToolsRequest{})
+	s.Require().NoError(err, "Expected no error from ListTools")
+	for _, tool := range tools.Tools {
+		if tool.Name != "namespaces_list" {
+			continue
+		}
+		s.Run("has overridden target parameter", func() {
+			property, ok := tool.InputSchema.Properties["cluster"].(map[string]any)
+			s.Require().True(ok, "Expected cluster property in namespaces_list tool")
+			s.Equal("Optional parameter selecting which cluster to run the tool in. Defaults to fake-context if not set", property["description"])
+			s.ElementsMatch([]any{"fake-context", "other-context"}, property["enum"])
+		})
+		s.Run("has no default target parameter", func() {
+			s.NotContains(tool.InputSchema.Properties, "context")
+		})
+		return
+	}
+	s.Fail("Expected namespaces_list tool")
+}
+
+func (s *TargetParameterNameSuite) TestCallsResolveTarget() {
+	s.InitMcpClient()
+	s.Run("namespaces_list(cluster=other-context) runs in the provided target", func() {
+		toolResult, err := s.CallTool("namespaces_list", map[string]interface{}{"cluster": "other-context"})
+		s.Require().NoError(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Contains(toolResult.Content[0].(mcp.TextContent).Text, "ns-in-other-context")
+	})
+	s.Run("namespaces_list() runs in the default target", func() {
+		toolResult, err := s.CallTool("namespaces_list", map[string]interface{}{})
+		s.Require().NoError(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Contains(toolResult.Content[0].(mcp.TextContent).Text, "ns-in-fake-context")
+	})
+	s.Run("namespaces_list(context=other-context) ignores the default parameter name", func() {
+		toolResult, err := s.CallTool("namespaces_list", map[string]interface{}{"context": "other-context"})
+		s.Require().NoError(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Contains(toolResult.Content[0].(mcp.TextContent).Text, "ns-in-fake-context")
+	})
+}
+
+func TestTargetParameterName(t *testing.T) {
+	suite.Run(t, new(TargetParameterNameSuite))
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/BurntSushi/toml"
 	"github.com/containers/kubernetes-mcp-server/internal/test"
+	"github.com/containers/kubernetes-mcp-server/pkg/version"
 	"github.com/mark3labs/mcp-go/client/transport"
 	"github.com/stretchr/testify/suite"
 )
@@ -95,6 +96,28 @@ func (s *McpHeadersSuite) TestAuthorizationHeaderPropagation() {
 	}
 }
 
+func (s *McpHeadersSuite) TestIgnoreClientCredentials() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		ignore_client_credentials = true
+	`), s.Cfg), "Expected to parse ignore_client_credentials config")
+	kubeconfig := s.mockServer.Kubeconfig()
+	kubeconfig.AuthInfos["fake"].Token = "a-token-from-mcp-server"
+	s.Cfg.KubeConfig = test.KubeconfigFile(s.T(), kubeconfig)
+	cases := []string{"kubernetes-authorization", "Authorization"}
+	for _, header := range cases {
+		s.InitMcpClient(transport.WithHTTPHeaders(map[string]string{header: "Bearer a-token-from-mcp-client"}))
+		_, _ = s.CallTool("pods_list", map[string]interface{}{})
+		s.Run("Kube API receives the server credentials instead of the "+header+" header", func() {
+			s.pathHeadersMux.Lock()
+			podsHeaders := s.pathHeaders["/api/v1/namespaces/default/pods"]
+			s.pathHeadersMux.Unlock()
+
+			s.Require().NotNil(podsHeaders, "No requests were made to /api/v1/namespaces/default/pods")
+			s.Equal("Bearer a-token-from-mcp-server", podsHeaders.Get("Authorization"), "Server credentials not found in request to /api/v1/namespaces/default/pods")
+		})
+	}
+}
+
 func TestMcpHeaders(t *testing.T) {
 	suite.Run(t, new(McpHeadersSuite))
 }
@@ -126,3 +149,51 @@ func (s *ServerInstructionsSuite) TestServerInstructionsFromConfiguration() {
 func TestServerInstructions(t *testing.T) {
 	suite.Run(t, new(ServerInstructionsSuite))
 }
+
+type ServerImplementationSuite struct {
+	BaseMcpSuite
+}
+
+func (s *ServerImplementationSuite) TestServerImplementationDefaults() {
+	s.InitMcpClient()
+	s.Run("returns binary name and version", func() {
+		s.Require().NotNil(s.InitializeResult)
+		s.Equal(version.BinaryName, s.InitializeResult.ServerInfo.Name)
+		s.Equal(version.Version, s.InitializeResult.ServerInfo.Version)
+	})
+}
+
+func (s *ServerImplementationSuite) TestServerImplementationFromConfiguration() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		server_name = "acme-kubernetes"
+		server_title = "ACME Kubernetes MCP Server"
+	`), s.Cfg), "Expected to parse server implementation config")
+	s.InitMcpClient()
+	s.Run("returns configured name", func() {
+		s.Require().NotNil(s.InitializeResult)
+		s.Equal("acme-kubernetes", s.InitializeResult.ServerInfo.Name)
+	})
+	s.Run("returns binary version", func() {
+		s.Require().NotNil(s.InitializeResult)
+		s.Equal(version.Version, s.InitializeResult.ServerInfo.Version)
+	})
+	s.Run("returns configured title", func() {
+		s.Require().NotNil(s.InitializeResult)
+		s.Equal("ACME Kubernetes MCP Server", s.InitializeResult.ServerInfo.Title)
+	})
+}
+
+func (s *ServerImplementationSuite) TestServerImplementationTitleDefaultsToName() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		server_name = "acme-kubernetes"
+	`), s.Cfg), "Expected to parse server implementation config")
+	s.InitMcpClient()
+	s.Run("returns configured name as title", func() {
+		s.Require().NotNil(s.InitializeResult)
+		s.Equal("acme-kubernetes", s.InitializeResult.ServerInfo.Title)
+	})
+}
+
+func TestServerImplementation(t *testing.T) {
+	suite.Run(t, new(ServerImplementationSuite))
+}
@@ -1,6 +1,7 @@
 package mcp
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/BurntSushi/toml"
@@ -80,6 +81,32 @@ func (s *McpToolProcessingSuite) TestDisableDestructive() {
 	})
 }
 
+func (s *McpToolProcessingSuite) TestDisableDestructiveWithAllowedDestructiveTools() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		disable_destructive = true
+		allowed_destructive_tools = [ "pods_delete" ]
+	`), s.Cfg), "Expected to parse disable destructive server config")
+	s.InitMcpClient()
+
+	tools, err := s.ListTools(s.T().Context(), mcp.ListToolsRequest{})
+	s.Require().NotNil(tools)
+
+	s.Run("ListTools returns tools", func() {
+		s.NoError(err, "call ListTools failed")
+		s.NotNilf(tools, "list tools failed")
+	})
+
+	s.Run("ListTools returns only the allowed destructive tool", func() {
+		var destructiveTools []string
+		for _, tool := range tools.Tools {
+			if tool.Annotations.DestructiveHint != nil && *tool.Annotations.DestructiveHint {
+				destructiveTools = append(destructiveTools, tool.Name)
+			}
+		}
+		s.Equal([]string{"pods_delete"}, destructiveTools, "only pods_delete should remain enabled")
+	})
+}
+
 func (s *McpToolProcessingSuite) TestEnabledTools() {
 	s.Require().NoError(toml.Unmarshal([]byte(`
 		enabled_tools = [ "namespaces_list", "events_list" ]
@@ -125,6 +152,69 @@ func (s *McpToolProcessingSuite) TestDisabledTools() {
 	})
 }
 
+func (s *McpToolProcessingSuite) TestDeprecatedTools() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		[deprecated_tools]
+		namespaces_list = "Use resources_list with apiVersion=v1 and kind=Namespace instead"
+	`), s.Cfg), "Expected to parse deprecated tools server config")
+	s.InitMcpClient()
+
+	tools, err := s.ListTools(s.T().Context(), mcp.ListToolsRequest{})
+	s.Require().NoError(err, "call ListTools failed")
+	s.Require().NotNil(tools)
+
+	s.Run("ListTools includes the deprecation message in the description of deprecated tools", func() {
+		idx := slices.IndexFunc(tools.Tools, func(tool mcp.Tool) bool { return tool.Name == "namespaces_list" })
+		s.Require().NotEqual(-1, idx, "namespaces_list not found")
+		s.Equal("List all the Kubernetes namespaces in the current cluster\n\nDEPRECATED: Use resources_list with apiVersion=v1 and kind=Namespace instead",
+			tools.Tools[idx].Description)
+		s.Require().NotNil(tools.Tools[idx].Meta, "expected _meta in deprecated tool")
+		s.Equal("Use resources_list with apiVersion=v1 and kind=Namespace instead", tools.Tools[idx].Meta.AdditionalFields["deprecated"])
+	})
+	s.Run("ListTools does not modify the description of other tools", func() {
+		for _, tool := range tools.Tools {
+			if tool.Name != "namespaces_list" {
+				s.NotContainsf(tool.Description, "DEPRECATED", "Tool %s is not deprecated", tool.Name)
+			}
+		}
+	})
+	s.Run("CallTool includes the deprecation message in the result of deprecated tools", func() {
+		toolResult, err := s.CallTool("namespaces_list", map[string]interface{}{})
+		s.Require().NoError(err, "call tool failed")
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Require().NotNil(toolResult.Meta, "expected _meta in deprecated tool result")
+		s.Equal("Use resources_list with apiVersion=v1 and kind=Namespace instead", toolResult.Meta.AdditionalFields["deprecated"])
+	})
+	s.Run("CallTool does not include the deprecation message in the result of other tools", func() {
+		toolResult, err := s.CallTool("events_list", map[string]interface{}{})
+		s.Require().NoError(err, "call tool failed")
+		s.Nil(toolResult.Meta)
+	})
+}
+
+func (s *McpToolProcessingSuite) TestToolDefaults() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		[tool_defaults.pods_list_in_namespace]
+		namespace = "ns-1"
+	`), s.Cfg), "Expected to parse tool defaults server config")
+	s.InitMcpClient()
+
+	s.Run("CallTool applies the configured default when the argument is omitted", func() {
+		toolResult, err := s.CallTool("pods_list_in_namespace", map[string]interface{}{})
+		s.Require().NoError(err, "call tool failed")
+		s.Require().Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Contains(toolResult.Content[0].(mcp.TextContent).Text, "a-pod-in-ns-1")
+		s.NotContains(toolResult.Content[0].(mcp.TextContent).Text, "a-pod-in-ns-2")
+	})
+	s.Run("CallTool prefers the explicit argument over the configured default", func() {
+		toolResult, err := s.CallTool("pods_list_in_namespace", map[string]interface{}{"namespace": "ns-2"})
+		s.Require().NoError(err, "call tool failed")
+		s.Require().Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Contains(toolResult.Content[0].(mcp.TextContent).Text, "a-pod-in-ns-2")
+		s.NotContains(toolResult.Content[0].(mcp.TextContent).Text, "a-pod-in-ns-1")
+	})
+}
+
 func TestMcpToolProcessing(t *testing.T) {
 	suite.Run(t, new(McpToolProcessingSuite))
 }
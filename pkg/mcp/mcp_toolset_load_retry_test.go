@@ -0,0 +1,133 @@
+package mcp
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"k8s.io/klog/v2"
+	"k8s.io/klog/v2/textlogger"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	internalk8s "github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+)
+
+// transientlyFailingStrategy is the cluster provider strategy of a kubeconfig provider whose GetTargets fails
+// the number of times set in transientTargetsFailures before succeeding
+const transientlyFailingStrategy = "test-transiently-failing"
+
+var transientTargetsFailures atomic.Int32
+
+type transientlyFailingProvider struct {
+	internalk8s.Provider
+}
+
+func (p *transientlyFailingProvider) GetTargets(ctx context.Context) ([]string, error) {
+	if transientTargetsFailures.Add(-1) >= 0 {
+		return nil, errors.New("the server is currently unable to handle the request")
+	}
+	return p.Provider.GetTargets(ctx)
+}
+
+// kubeConfigStrategyConfig delegates to the wrapped configuration with the kubeconfig cluster provider strategy
+type kubeConfigStrategyConfig struct {
+	api.BaseConfig
+}
+
+func (c kubeConfigStrategyConfig) GetClusterProviderStrategy() string {
+	return api.ClusterProviderKubeConfig
+}
+
+func init() {
+	internalk8s.RegisterProvider(transientlyFailingStrategy, func(cfg api.BaseConfig) (internalk8s.Provider, error) {
+		p, err := internalk8s.NewProvider(kubeConfigStrategyConfig{BaseConfig: cfg})
+		if err != nil {
+			return nil, err
+		}
+		return &transientlyFailingProvider{Provider: p}, nil
+	})
+}
+
+type ToolsetLoadRetrySuite struct {
+	BaseMcpSuite
+	originalInitialBackoff time.Duration
+	klogState              klog.State
+	logBuffer              bytes.Buffer
+}
+
+func (s *ToolsetLoadRetrySuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.Cfg.ClusterProviderStrategy = transientlyFailingStrategy
+	s.originalInitialBackoff = toolsetLoadRetryInitialBackoff
+	toolsetLoadRetryInitialBackoff = time.Millisecond
+	s.klogState = klog.CaptureState()
+	s.logBuffer.Reset()
+	flags := flag.NewFlagSet("test", flag.ContinueOnError)
+	klog.InitFlags(flags)
+	klog.SetLogger(textlogger.NewLogger(textlogger.NewConfig(textlogger.Verbosity(0), textlogger.Output(&s.logBuffer))))
+}
+
+func (s *ToolsetLoadRetrySuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	toolsetLoadRetryInitialBackoff = s.originalInitialBackoff
+	transientTargetsFailures.Store(0)
+	s.klogState.Restore()
+}
+
+func (s *ToolsetLoadRetrySuite) TestFailsImmediatelyByDefault() {
+	transientTargetsFailures.Store(1)
+	server, err := NewServer(s.T().Context(), Configuration{StaticConfig: s.Cfg}, nil, nil)
+	s.Require().Error(err, "Expected error when the initial toolset load fails")
+	s.Nil(server)
+	s.ErrorContains(err, "failed to get targets: the server is currently unable to handle the request")
+	s.NotContains(s.logBuffer.String(), "retrying")
+}
+
+func (s *ToolsetLoadRetrySuite) TestRetriesTransientFailures() {
+	transientTargetsFailures.Store(2)
+	s.Cfg.ToolsetLoadRetries = 3
+	server, err := NewServer(s.T().Context(), Configuration{StaticConfig: s.Cfg}, nil, nil)
+	s.Require().NoError(err, "Expected the initial toolset load to succeed once the provider recovers")
+	s.T().Cleanup(server.Close)
+	s.Run("registers the tools", func() {
+		s.Contains(server.enabledTools, "pods_list")
+	})
+	s.Run("logs the retries", func() {
+		s.Contains(s.logBuffer.String(), "Failed to load toolsets, retrying in 1ms (retry 1 of 3)")
+		s.Contains(s.logBuffer.String(), "Failed to load toolsets, retrying in 2ms (retry 2 of 3)")
+		s.NotContains(s.logBuffer.String(), "retry 3 of 3")
+	})
+}
+
+func (s *ToolsetLoadRetrySuite) TestRetriesAreBounded() {
+	transientTargetsFailures.Store(5)
+	s.Cfg.ToolsetLoadRetries = 2
+	server, err := NewServer(s.T().Context(), Configuration{StaticConfig: s.Cfg}, nil, nil)
+	s.Require().Error(err, "Expected error when the provider doesn't recover within the retries")
+	s.Nil(server)
+	s.ErrorContains(err, "failed to get targets")
+	s.Equal(int32(2), transientTargetsFailures.Load(), "Expected the initial attempt and 2 retries")
+}
+
+func (s *ToolsetLoadRetrySuite) TestRetriesStopWhenContextIsDone() {
+	transientTargetsFailures.Store(5)
+	s.Cfg.ToolsetLoadRetries = 3
+	toolsetLoadRetryInitialBackoff = time.Hour
+	ctx, cancel := context.WithCancel(s.T().Context())
+	cancel()
+	server, err := NewServer(ctx, Configuration{StaticConfig: s.Cfg}, nil, nil)
+	s.Require().Error(err, "Expected error when the context is done before the provider recovers")
+	s.Nil(server)
+	s.ErrorIs(err, context.Canceled)
+	s.ErrorContains(err, "failed to get targets")
+	s.Equal(int32(4), transientTargetsFailures.Load(), "Expected only the initial attempt")
+}
+
+func TestToolsetLoadRetry(t *testing.T) {
+	suite.Run(t, new(ToolsetLoadRetrySuite))
+}
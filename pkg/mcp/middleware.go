@@ -8,11 +8,18 @@ import (
 
 	internalk8s "github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"k8s.io/apimachinery/pkg/util/uuid"
 	"k8s.io/klog/v2"
 )
 
-func authHeaderPropagationMiddleware(next mcp.MethodHandler) mcp.MethodHandler {
+// authHeaderPropagationMiddleware propagates the Authorization header of the MCP client requests to the Kubernetes API.
+// The header is ignored when the server is configured with ignore_client_credentials = true, the server's own
+// credentials are used instead.
+func (s *Server) authHeaderPropagationMiddleware(next mcp.MethodHandler) mcp.MethodHandler {
 	return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		if s.configuration.IgnoreClientCredentials {
+			return next(ctx, method, req)
+		}
 		if req.GetExtra() != nil && req.GetExtra().Header != nil {
 			// Get the standard Authorization header (OAuth compliant)
 			authHeader := req.GetExtra().Header.Get(string(internalk8s.OAuthAuthorizationHeader))
@@ -30,16 +37,38 @@ func authHeaderPropagationMiddleware(next mcp.MethodHandler) mcp.MethodHandler {
 	}
 }
 
-func toolCallLoggingMiddleware(next mcp.MethodHandler) mcp.MethodHandler {
+// requestIDMiddleware ensures every request has a request ID in its context, along with a context logger
+// (see klog.FromContext) that includes it in every log line.
+// The ID set by the HTTP RequestMiddleware is reused, otherwise (e.g. STDIO) a new one is generated.
+func requestIDMiddleware(next mcp.MethodHandler) mcp.MethodHandler {
+	return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		if requestID, ok := ctx.Value(internalk8s.RequestIDHeader).(string); ok && requestID != "" {
+			return next(ctx, method, req)
+		}
+		return next(internalk8s.WithRequestID(ctx, string(uuid.NewUUID())), method, req)
+	}
+}
+
+// requestID returns the request ID stored in the provided context, or an empty string if none.
+func requestID(ctx context.Context) string {
+	requestID, _ := ctx.Value(internalk8s.RequestIDHeader).(string)
+	return requestID
+}
+
+// toolCallLoggingMiddleware logs the tool calls with their arguments, the values of the sensitive arguments
+// (the default redacted keys and redacted_tool_args) are redacted.
+func (s *Server) toolCallLoggingMiddleware(next mcp.MethodHandler) mcp.MethodHandler {
 	return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
 		switch params := req.GetParams().(type) {
 		case *mcp.CallToolParamsRaw:
 			toolCallRequest, _ := GoSdkToolCallParamsToToolCallRequest(params)
-			klog.V(5).Infof("mcp tool call: %s(%v)", toolCallRequest.Name, toolCallRequest.GetArguments())
+			arguments := redactArguments(toolCallRequest.GetArguments(), redactedArgumentKeys(s.configuration.RedactedToolArgs))
+			logger := klog.FromContext(ctx)
+			logger.V(5).Info(fmt.Sprintf("mcp tool call: %s(%v)", toolCallRequest.Name, arguments))
 			if req.GetExtra() != nil && req.GetExtra().Header != nil {
 				buffer := bytes.NewBuffer(make([]byte, 0))
 				if err := req.GetExtra().Header.WriteSubset(buffer, map[string]bool{"Authorization": true, "authorization": true}); err == nil {
-					klog.V(7).Infof("mcp tool call headers: %s", buffer)
+					logger.V(7).Info(fmt.Sprintf("mcp tool call headers: %s", buffer))
 				}
 			}
 		}
@@ -47,6 +76,23 @@ func toolCallLoggingMiddleware(next mcp.MethodHandler) mcp.MethodHandler {
 	}
 }
 
+// toolCallTrackingMiddleware keeps track of the in-flight tool calls (see Server.WaitForToolCalls).
+func (s *Server) toolCallTrackingMiddleware(next mcp.MethodHandler) mcp.MethodHandler {
+	return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+		if _, ok := req.GetParams().(*mcp.CallToolParamsRaw); !ok {
+			return next(ctx, method, req)
+		}
+		if !s.trackToolCall() {
+			return nil, fmt.Errorf("server is shutting down, tool call %s rejected", req.GetParams().(*mcp.CallToolParamsRaw).Name)
+		}
+		defer func() {
+			s.activeToolCalls.Add(-1)
+			s.toolCalls.Done()
+		}()
+		return next(ctx, method, req)
+	}
+}
+
 func toolScopedAuthorizationMiddleware(next mcp.MethodHandler) mcp.MethodHandler {
 	return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
 		scopes, ok := ctx.Value(TokenScopesContextKey).([]string)
@@ -8,10 +8,12 @@ import (
 	"github.com/BurntSushi/toml"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/stretchr/testify/suite"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/yaml"
 )
 
@@ -170,6 +172,73 @@ func (s *NamespacesSuite) TestProjectsListInOpenShiftDenied() {
 	})
 }
 
+func (s *NamespacesSuite) createNamespacesDiffFixtures() {
+	kc := kubernetes.NewForConfigOrDie(envTestRestConfig)
+	for _, ns := range []string{"ns-diff-a", "ns-diff-b"} {
+		_, _ = kc.CoreV1().Namespaces().Create(s.T().Context(), &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ns}}, metav1.CreateOptions{})
+		_, _ = kc.CoreV1().ConfigMaps(ns).Create(s.T().Context(), &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "shared-config"}}, metav1.CreateOptions{})
+	}
+	_, _ = kc.CoreV1().ConfigMaps("ns-diff-a").Create(s.T().Context(), &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "only-a-config"}}, metav1.CreateOptions{})
+	_, _ = kc.CoreV1().Secrets("ns-diff-b").Create(s.T().Context(), &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "only-b-secret"}}, metav1.CreateOptions{})
+}
+
+func (s *NamespacesSuite) TestNamespacesDiff() {
+	s.createNamespacesDiffFixtures()
+	s.InitMcpClient()
+	s.Run("namespaces_diff with missing namespace_b returns error", func() {
+		toolResult, _ := s.CallTool("namespaces_diff", map[string]interface{}{"namespace_a": "ns-diff-a"})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to diff namespaces, missing argument namespace_b", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("namespaces_diff(namespace_a=ns-diff-a, namespace_b=ns-diff-b)", func() {
+		toolResult, err := s.CallTool("namespaces_diff", map[string]interface{}{"namespace_a": "ns-diff-a", "namespace_b": "ns-diff-b"})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		textContent := toolResult.Content[0].(mcp.TextContent).Text
+		s.Run("returns headers", func() {
+			expectedHeaders := regexp.MustCompile(`(?m)^APIVERSION\s+KIND\s+NS-DIFF-A\s+NS-DIFF-B\s+DIFFERENCES\s*$`)
+			s.Regexpf(expectedHeaders, textContent, "expected headers not found in output:\n%s", textContent)
+		})
+		s.Run("returns the kinds with their counts and differences", func() {
+			expectedRows := []string{
+				`(?m)^v1\s+ConfigMap\s+2\s+1\s+only in ns-diff-a: only-a-config\s*$`,
+				`(?m)^v1\s+Secret\s+0\s+1\s+only in ns-diff-b: only-b-secret\s*$`,
+			}
+			for _, row := range expectedRows {
+				s.Regexpf(row, textContent, "expected row '%s' not found in output:\n%s", row, textContent)
+			}
+		})
+		s.Run("does not return kinds absent from both namespaces", func() {
+			s.NotRegexp(`(?m)^apps/v1\s+Deployment\s`, textContent)
+		})
+	})
+}
+
+func (s *NamespacesSuite) TestNamespacesDiffDenied() {
+	s.createNamespacesDiffFixtures()
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		denied_resources = [ { version = "v1", kind = "Secret" } ]
+	`), s.Cfg), "Expected to parse denied resources config")
+	s.InitMcpClient()
+	s.Run("namespaces_diff (denied)", func() {
+		toolResult, err := s.CallTool("namespaces_diff", map[string]interface{}{"namespace_a": "ns-diff-a", "namespace_b": "ns-diff-b"})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		textContent := toolResult.Content[0].(mcp.TextContent).Text
+		s.Run("returns the allowed kinds", func() {
+			s.Regexpf(`(?m)^v1\s+ConfigMap\s+2\s+1\s`, textContent, "expected ConfigMap row in output:\n%s", textContent)
+		})
+		s.Run("does not return the denied kinds", func() {
+			s.NotContains(textContent, "Secret")
+			s.NotContains(textContent, "only-b-secret")
+		})
+	})
+}
+
 func TestNamespaces(t *testing.T) {
 	suite.Run(t, new(NamespacesSuite))
 }
@@ -3,12 +3,15 @@ package mcp
 import (
 	"net/http"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/BurntSushi/toml"
 	"github.com/containers/kubernetes-mcp-server/internal/test"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/stretchr/testify/suite"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type NodesSuite struct {
@@ -30,6 +33,156 @@ func (s *NodesSuite) TearDownTest() {
 	}
 }
 
+func (s *NodesSuite) TestNodesDescribe() {
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		// Get Node response
+		if req.URL.Path == "/api/v1/nodes/existing-node" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{
+				"apiVersion": "v1",
+				"kind": "Node",
+				"metadata": {
+					"name": "existing-node"
+				},
+				"spec": {
+					"taints": [{"key": "node-role.kubernetes.io/control-plane", "effect": "NoSchedule"}]
+				},
+				"status": {
+					"capacity": {"cpu": "4", "memory": "16Gi", "pods": "110"},
+					"allocatable": {"cpu": "3800m", "memory": "15Gi", "pods": "110"},
+					"conditions": [{"type": "Ready", "status": "True", "reason": "KubeletReady", "message": "kubelet is posting ready status"}],
+					"nodeInfo": {"kubeletVersion": "v1.34.0", "osImage": "Fedora CoreOS", "operatingSystem": "linux", "architecture": "amd64"}
+				}
+			}`))
+			return
+		}
+		// List Pods scheduled on the Node response
+		if req.URL.Path == "/api/v1/pods" && req.URL.Query().Get("fieldSelector") == "spec.nodeName=existing-node" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{
+				"apiVersion": "v1",
+				"kind": "PodList",
+				"items": [
+					{"metadata": {"name": "pod-1", "namespace": "default"}, "status": {"phase": "Running"}},
+					{"metadata": {"name": "pod-2", "namespace": "kube-system"}, "status": {"phase": "Pending"}}
+				]
+			}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	s.InitMcpClient()
+	s.Run("nodes_describe(name=nil)", func() {
+		toolResult, err := s.CallTool("nodes_describe", map[string]interface{}{})
+		s.Require().NotNil(toolResult, "toolResult should not be nil")
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes missing name", func() {
+			expectedMessage := "failed to describe node, missing argument name"
+			s.Equalf(expectedMessage, toolResult.Content[0].(mcp.TextContent).Text,
+				"expected descriptive error '%s', got %v", expectedMessage, toolResult.Content[0].(mcp.TextContent).Text)
+		})
+	})
+	s.Run("nodes_describe(name=inexistent-node)", func() {
+		toolResult, err := s.CallTool("nodes_describe", map[string]interface{}{
+			"name": "inexistent-node",
+		})
+		s.Require().NotNil(toolResult, "toolResult should not be nil")
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes missing node", func() {
+			expectedMessage := "failed to describe node inexistent-node: failed to get node inexistent-node: the server could not find the requested resource (get nodes inexistent-node)"
+			s.Equalf(expectedMessage, toolResult.Content[0].(mcp.TextContent).Text,
+				"expected descriptive error '%s', got %v", expectedMessage, toolResult.Content[0].(mcp.TextContent).Text)
+		})
+	})
+	s.Run("nodes_describe(name=existing-node)", func() {
+		toolResult, err := s.CallTool("nodes_describe", map[string]interface{}{
+			"name": "existing-node",
+		})
+		s.Require().NotNil(toolResult, "toolResult should not be nil")
+		s.Run("no error", func() {
+			s.Falsef(toolResult.IsError, "call tool should succeed")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		content := toolResult.Content[0].(mcp.TextContent).Text
+		s.Run("returns conditions", func() {
+			s.Containsf(content, "Conditions:", "expected conditions section, got %v", content)
+			s.Containsf(content, "KubeletReady", "expected Ready condition, got %v", content)
+		})
+		s.Run("returns taints", func() {
+			s.Containsf(content, "node-role.kubernetes.io/control-plane:NoSchedule", "expected taint, got %v", content)
+		})
+		s.Run("returns capacity and allocatable", func() {
+			s.Containsf(content, "Capacity:", "expected capacity section, got %v", content)
+			s.Containsf(content, "Allocatable:", "expected allocatable section, got %v", content)
+			s.Containsf(content, "3800m", "expected allocatable cpu, got %v", content)
+		})
+		s.Run("returns node info", func() {
+			s.Containsf(content, "KubeletVersion: v1.34.0", "expected kubelet version, got %v", content)
+			s.Containsf(content, "OSImage: Fedora CoreOS", "expected OS image, got %v", content)
+		})
+		s.Run("returns pod count", func() {
+			s.Containsf(content, "Count: 2", "expected pod count, got %v", content)
+			s.NotContainsf(content, "pod-1", "expected pod list to be omitted, got %v", content)
+		})
+	})
+	s.Run("nodes_describe(name=existing-node, list_pods=true)", func() {
+		toolResult, err := s.CallTool("nodes_describe", map[string]interface{}{
+			"name":      "existing-node",
+			"list_pods": true,
+		})
+		s.Require().NotNil(toolResult, "toolResult should not be nil")
+		s.Run("no error", func() {
+			s.Falsef(toolResult.IsError, "call tool should succeed")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("returns pod list", func() {
+			content := toolResult.Content[0].(mcp.TextContent).Text
+			s.Containsf(content, "pod-1", "expected pod-1 in list, got %v", content)
+			s.Containsf(content, "pod-2", "expected pod-2 in list, got %v", content)
+		})
+	})
+}
+
+func (s *NodesSuite) TestNodesDescribeDenied() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		denied_resources = [ { version = "v1", kind = "Pod" } ]
+	`), s.Cfg), "Expected to parse denied resources config")
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/api/v1/nodes/existing-node" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "Node", "metadata": {"name": "existing-node"}}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	s.InitMcpClient()
+	s.Run("nodes_describe (pods denied)", func() {
+		toolResult, err := s.CallTool("nodes_describe", map[string]interface{}{
+			"name": "existing-node",
+		})
+		s.Require().NotNil(toolResult, "toolResult should not be nil")
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes denial", func() {
+			msg := toolResult.Content[0].(mcp.TextContent).Text
+			expectedMessage := "failed to describe node existing-node:(.+:)? resource not allowed: /v1, Kind=Pod"
+			s.Regexpf(expectedMessage, msg,
+				"expected descriptive error '%s', got %v", expectedMessage, msg)
+		})
+	})
+}
+
 func (s *NodesSuite) TestNodesLog() {
 	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 		// Get Node response
@@ -200,6 +353,53 @@ func (s *NodesSuite) TestNodesLog() {
 	}
 }
 
+func (s *NodesSuite) TestNodesLogDefaultTailLines() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		default_log_tail_lines = 3
+	`), s.Cfg), "Expected to parse default log tail lines config")
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/api/v1/nodes/existing-node" {
+			test.WriteObject(w, &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "existing-node"}})
+			return
+		}
+		// Echo the requested tailLines
+		if req.URL.Path == "/api/v1/nodes/existing-node/proxy/logs" {
+			w.Header().Set("Content-Type", "text/plain")
+			_, _ = w.Write([]byte("tailLines=" + req.URL.Query().Get("tailLines")))
+		}
+	}))
+	s.InitMcpClient()
+	s.Run("nodes_log(name=existing-node, query=/kubelet.log) applies configured default", func() {
+		toolResult, err := s.CallTool("nodes_log", map[string]interface{}{
+			"name":  "existing-node",
+			"query": "/kubelet.log",
+		})
+		s.Require().NoError(err)
+		s.Falsef(toolResult.IsError, "call tool failed %v", toolResult.Content)
+		s.Equal("tailLines=3", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("nodes_log(name=existing-node, query=/kubelet.log, tailLines=2) overrides configured default", func() {
+		toolResult, err := s.CallTool("nodes_log", map[string]interface{}{
+			"name":      "existing-node",
+			"query":     "/kubelet.log",
+			"tailLines": 2,
+		})
+		s.Require().NoError(err)
+		s.Falsef(toolResult.IsError, "call tool failed %v", toolResult.Content)
+		s.Equal("tailLines=2", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("nodes_log(name=existing-node, query=/kubelet.log, tailLines=-1) retrieves all logs", func() {
+		toolResult, err := s.CallTool("nodes_log", map[string]interface{}{
+			"name":      "existing-node",
+			"query":     "/kubelet.log",
+			"tailLines": -1,
+		})
+		s.Require().NoError(err)
+		s.Falsef(toolResult.IsError, "call tool failed %v", toolResult.Content)
+		s.Equal("tailLines=", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+}
+
 func (s *NodesSuite) TestNodesLogDenied() {
 	s.Require().NoError(toml.Unmarshal([]byte(`
 		denied_resources = [ { version = "v1", kind = "Node" } ]
@@ -336,6 +536,85 @@ func (s *NodesSuite) TestNodesStatsSummaryDenied() {
 	})
 }
 
+func (s *NodesSuite) TestNodesConfig() {
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/api/v1/nodes/existing-node", "/api/v1/nodes/configz-disabled-node":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "Node", "metadata": {"name": "` + req.URL.Path[len("/api/v1/nodes/"):] + `"}}`))
+		case "/api/v1/nodes/existing-node/proxy/configz":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{
+				"kubeletconfig": {
+					"cgroupDriver": "systemd",
+					"maxPods": 110,
+					"evictionHard": {"memory.available": "100Mi"},
+					"featureGates": {"SidecarContainers": true}
+				}
+			}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	s.InitMcpClient()
+	s.Run("nodes_config(name=nil)", func() {
+		toolResult, err := s.CallTool("nodes_config", map[string]interface{}{})
+		s.Require().NotNil(toolResult, "toolResult should not be nil")
+		s.Nilf(err, "call tool should not return error object")
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to get node configuration, missing argument name", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("nodes_config(name=existing-node)", func() {
+		toolResult, err := s.CallTool("nodes_config", map[string]interface{}{"name": "existing-node"})
+		s.Require().NotNil(toolResult, "toolResult should not be nil")
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool should not return error object")
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		content := toolResult.Content[0].(mcp.TextContent).Text
+		s.Run("returns header", func() {
+			s.True(strings.HasPrefix(content, "# Kubelet configuration of node existing-node (YAML format):\n"), "unexpected header: %v", content)
+		})
+		s.Run("returns the decoded kubelet configuration", func() {
+			s.Contains(content, "cgroupDriver: systemd")
+			s.Contains(content, "maxPods: 110")
+			s.Contains(content, "memory.available: 100Mi")
+			s.Contains(content, "SidecarContainers: true")
+			s.NotContains(content, "kubeletconfig")
+		})
+	})
+	s.Run("nodes_config(name=configz-disabled-node)", func() {
+		toolResult, err := s.CallTool("nodes_config", map[string]interface{}{"name": "configz-disabled-node"})
+		s.Require().NotNil(toolResult, "toolResult should not be nil")
+		s.Nilf(err, "call tool should not return error object")
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Contains(toolResult.Content[0].(mcp.TextContent).Text,
+			"failed to get node configuration for configz-disabled-node: the kubelet configuration endpoint (/configz) is not available on node configz-disabled-node, it might be disabled (enableDebuggingHandlers: false)")
+	})
+}
+
+func (s *NodesSuite) TestNodesConfigDenied() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		denied_resources = [ { version = "v1", kind = "Node" } ]
+	`), s.Cfg), "Expected to parse denied resources config")
+	s.InitMcpClient()
+	s.Run("nodes_config (denied)", func() {
+		toolResult, err := s.CallTool("nodes_config", map[string]interface{}{"name": "does-not-matter"})
+		s.Require().NotNil(toolResult, "toolResult should not be nil")
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes denial", func() {
+			msg := toolResult.Content[0].(mcp.TextContent).Text
+			expectedMessage := "failed to get node configuration for does-not-matter:(.+:)? resource not allowed: /v1, Kind=Node"
+			s.Regexpf(expectedMessage, msg, "expected descriptive error '%s', got %v", expectedMessage, msg)
+		})
+	})
+}
+
 func TestNodes(t *testing.T) {
 	suite.Run(t, new(NodesSuite))
 }
@@ -2,6 +2,7 @@ package mcp
 
 import (
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/BurntSushi/toml"
@@ -196,6 +197,74 @@ func (s *NodesTopSuite) TestNodesTop() {
 	})
 }
 
+func (s *NodesTopSuite) TestNodesTopMetricsGroupVersion() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		metrics_group_version = "metrics.k8s.io/v1beta2"
+	`), s.Cfg), "Expected to parse metrics group version config")
+	s.WithMetricsServer()
+	s.discoveryHandler.AddAPIResourceList(metav1.APIResourceList{
+		GroupVersion: "metrics.k8s.io/v1beta2",
+		APIResources: []metav1.APIResource{
+			{Name: "nodes", Kind: "NodeMetrics", Namespaced: false, Verbs: metav1.Verbs{"get", "list"}},
+		},
+	})
+	metricsPaths := make(chan string, 10)
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/api/v1/nodes":
+			_, _ = w.Write([]byte(`{"apiVersion": "v1", "kind": "NodeList", "items": [
+				{"metadata": {"name": "node-1"}, "status": {"allocatable": {"cpu": "4", "memory": "16Gi"}}}
+			]}`))
+		case "/apis/metrics.k8s.io/v1beta1/nodes", "/apis/metrics.k8s.io/v1beta2/nodes":
+			metricsPaths <- req.URL.Path
+			_, _ = w.Write([]byte(`{"apiVersion": "metrics.k8s.io/v1beta2", "kind": "NodeMetricsList", "items": [
+				{"metadata": {"name": "node-1"}, "timestamp": "2025-10-29T09:00:00Z", "window": "30s", "usage": {"cpu": "500m", "memory": "2Gi"}}
+			]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	s.InitMcpClient()
+
+	s.Run("nodes_top() with metrics_group_version=metrics.k8s.io/v1beta2", func() {
+		toolResult, err := s.CallTool("nodes_top", map[string]interface{}{})
+		s.Require().NotNil(toolResult, "toolResult should not be nil")
+		s.Run("no error", func() {
+			s.Falsef(toolResult.IsError, "call tool should succeed: %v", toolResult.Content)
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("queries the configured metrics group version", func() {
+			s.Require().Len(metricsPaths, 1, "expected a single metrics request")
+			s.Equal("/apis/metrics.k8s.io/v1beta2/nodes", <-metricsPaths)
+		})
+		s.Run("returns metrics", func() {
+			content := toolResult.Content[0].(mcp.TextContent).Text
+			s.Contains(content, "node-1", "expected metrics to contain node-1")
+			s.Contains(content, "500m", "expected CPU usage of 500m")
+		})
+	})
+}
+
+func (s *NodesTopSuite) TestNodesTopMetricsGroupVersionNotServed() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		metrics_group_version = "metrics.k8s.io/v1"
+	`), s.Cfg), "Expected to parse metrics group version config")
+	s.WithMetricsServer()
+	s.InitMcpClient()
+
+	s.Run("nodes_top() with metrics_group_version not served by the cluster", func() {
+		toolResult, err := s.CallTool("nodes_top", map[string]interface{}{})
+		s.Require().NotNil(toolResult, "toolResult should not be nil")
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail when the configured metrics group version is unavailable")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes metrics unavailable", func() {
+			s.Equal("failed to get nodes top: metrics API is not available (metrics.k8s.io/v1)", toolResult.Content[0].(mcp.TextContent).Text)
+		})
+	})
+}
+
 func (s *NodesTopSuite) TestNodesTopMetricsUnavailable() {
 	s.InitMcpClient()
 
@@ -213,6 +282,48 @@ func (s *NodesTopSuite) TestNodesTopMetricsUnavailable() {
 	})
 }
 
+func (s *NodesTopSuite) TestNodesTopMetricsUnavailableBehaviorError() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		metrics_unavailable_behavior = "error"
+	`), s.Cfg), "Expected to parse metrics unavailable behavior config")
+	s.InitMcpClient()
+
+	s.Run("nodes_top() with metrics_unavailable_behavior=error", func() {
+		toolResult, err := s.CallTool("nodes_top", map[string]interface{}{})
+		s.Require().NotNil(toolResult, "toolResult should not be nil")
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail when metrics unavailable")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes metrics unavailable", func() {
+			s.Equal("failed to get nodes top: metrics API is not available", toolResult.Content[0].(mcp.TextContent).Text)
+		})
+	})
+}
+
+func (s *NodesTopSuite) TestNodesTopMetricsUnavailableBehaviorEmpty() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		metrics_unavailable_behavior = "empty"
+	`), s.Cfg), "Expected to parse metrics unavailable behavior config")
+	s.InitMcpClient()
+
+	s.Run("nodes_top() with metrics_unavailable_behavior=empty", func() {
+		toolResult, err := s.CallTool("nodes_top", map[string]interface{}{})
+		s.Require().NotNil(toolResult, "toolResult should not be nil")
+		s.Run("no error", func() {
+			s.Falsef(toolResult.IsError, "call tool should succeed")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("returns zero-row table with note", func() {
+			content := toolResult.Content[0].(mcp.TextContent).Text
+			lines := strings.Split(strings.TrimSpace(content), "\n")
+			s.Require().Lenf(lines, 2, "expected only note and headers in output:\n%s", content)
+			s.Equal("# metrics API is not available, no resource consumption data to display", lines[0])
+			s.Regexp(`^NAME\s+CPU\(cores\)\s+CPU\(%\)\s+MEMORY\(bytes\)\s+MEMORY\(%\)$`, lines[1])
+		})
+	})
+}
+
 func (s *NodesTopSuite) TestNodesTopDenied() {
 	s.Require().NoError(toml.Unmarshal([]byte(`
 		denied_resources = [ { group = "metrics.k8s.io", version = "v1beta1" } ]
@@ -0,0 +1,133 @@
+package mcp
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+)
+
+type PodDisruptionBudgetsSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+}
+
+func (s *PodDisruptionBudgetsSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.mockServer.Handle(test.NewDiscoveryClientHandler(metav1.APIResourceList{
+		GroupVersion: "policy/v1",
+		APIResources: []metav1.APIResource{
+			{Name: "poddisruptionbudgets", Kind: "PodDisruptionBudget", Namespaced: true, Verbs: metav1.Verbs{"get", "list", "watch"}},
+		},
+	}))
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		pdbs := []policyv1.PodDisruptionBudget{
+			{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "policy/v1", Kind: "PodDisruptionBudget"},
+				ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+				Spec:       policyv1.PodDisruptionBudgetSpec{MinAvailable: ptr.To(intstr.FromInt32(2))},
+				Status: policyv1.PodDisruptionBudgetStatus{
+					CurrentHealthy: 3, DesiredHealthy: 2, ExpectedPods: 3, DisruptionsAllowed: 1,
+				},
+			},
+			{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "policy/v1", Kind: "PodDisruptionBudget"},
+				ObjectMeta: metav1.ObjectMeta{Name: "database", Namespace: "data"},
+				Spec:       policyv1.PodDisruptionBudgetSpec{MaxUnavailable: ptr.To(intstr.FromString("0%"))},
+				Status: policyv1.PodDisruptionBudgetStatus{
+					CurrentHealthy: 2, DesiredHealthy: 2, ExpectedPods: 2, DisruptionsAllowed: 0,
+				},
+			},
+		}
+		var items []policyv1.PodDisruptionBudget
+		switch req.URL.Path {
+		case "/apis/policy/v1/poddisruptionbudgets":
+			items = pdbs
+		case "/apis/policy/v1/namespaces/default/poddisruptionbudgets":
+			items = pdbs[:1]
+		default:
+			return
+		}
+		test.WriteObject(w, &policyv1.PodDisruptionBudgetList{
+			TypeMeta: metav1.TypeMeta{APIVersion: "policy/v1", Kind: "PodDisruptionBudgetList"},
+			Items:    items,
+		})
+	}))
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+}
+
+func (s *PodDisruptionBudgetsSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *PodDisruptionBudgetsSuite) TestPdbList() {
+	s.InitMcpClient()
+	s.Run("pdb_list(all_namespaces=true)", func() {
+		toolResult, err := s.CallTool("pdb_list", map[string]interface{}{"all_namespaces": true})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		textContent := toolResult.Content[0].(mcp.TextContent).Text
+		s.Run("returns headers", func() {
+			expectedHeaders := regexp.MustCompile(`(?m)^NAMESPACE\s+NAME\s+MIN AVAILABLE\s+MAX UNAVAILABLE\s+CURRENT HEALTHY\s+DESIRED HEALTHY\s+EXPECTED PODS\s+ALLOWED DISRUPTIONS\s*$`)
+			s.Regexpf(expectedHeaders, textContent, "expected headers not found in output:\n%s", textContent)
+		})
+		s.Run("returns the allowed disruptions", func() {
+			expectedRows := []string{
+				`(?m)^default\s+web\s+2\s+<none>\s+3\s+2\s+3\s+1\s*$`,
+				`(?m)^data\s+database\s+<none>\s+0%\s+2\s+2\s+2\s+0\s*$`,
+			}
+			for _, row := range expectedRows {
+				s.Regexpf(row, textContent, "expected row '%s' not found in output:\n%s", row, textContent)
+			}
+		})
+		s.Run("highlights the PodDisruptionBudgets blocking evictions", func() {
+			s.Contains(textContent, "1 PodDisruptionBudgets allow no disruptions, evictions of their Pods (e.g. by node drains) are blocked")
+		})
+	})
+	s.Run("pdb_list(namespace=default, all_namespaces=false)", func() {
+		toolResult, err := s.CallTool("pdb_list", map[string]interface{}{"namespace": "default", "all_namespaces": false})
+		s.Require().Nilf(err, "call tool failed %v", err)
+		s.Require().Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		textContent := toolResult.Content[0].(mcp.TextContent).Text
+		s.Contains(textContent, "web")
+		s.NotContains(textContent, "database")
+		s.NotContains(textContent, "allow no disruptions")
+	})
+}
+
+func (s *PodDisruptionBudgetsSuite) TestPdbListDenied() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		denied_resources = [ { group = "policy", version = "v1" } ]
+	`), s.Cfg), "Expected to parse denied resources config")
+	s.InitMcpClient()
+	s.Run("pdb_list (denied)", func() {
+		toolResult, err := s.CallTool("pdb_list", map[string]interface{}{"all_namespaces": true})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes denial", func() {
+			msg := toolResult.Content[0].(mcp.TextContent).Text
+			expectedMessage := "failed to list poddisruptionbudgets:(.+:)? resource not allowed: policy/v1, Kind=PodDisruptionBudget"
+			s.Regexpf(expectedMessage, msg, "expected descriptive error '%s', got %v", expectedMessage, msg)
+		})
+	})
+}
+
+func TestPodDisruptionBudgets(t *testing.T) {
+	suite.Run(t, new(PodDisruptionBudgetsSuite))
+}
@@ -0,0 +1,150 @@
+package mcp
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type PodsByPhaseSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+}
+
+func (s *PodsByPhaseSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.mockServer.Handle(test.NewDiscoveryClientHandler())
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/api/v1/namespaces/ns-1/pods" {
+			return
+		}
+		pods := []corev1.Pod{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "unschedulable", Namespace: "ns-1"},
+				Status: corev1.PodStatus{Phase: corev1.PodPending, Conditions: []corev1.PodCondition{{
+					Type: corev1.PodScheduled, Status: corev1.ConditionFalse, Reason: "Unschedulable",
+					Message: "0/3 nodes are available: 3 Insufficient memory.",
+				}}},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "pulling", Namespace: "ns-1"},
+				Spec:       corev1.PodSpec{NodeName: "node-1"},
+				Status: corev1.PodStatus{Phase: corev1.PodPending, ContainerStatuses: []corev1.ContainerStatus{{
+					Name: "app", State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{
+						Reason: "ImagePullBackOff", Message: "Back-off pulling image \"example.com/app:missing\"",
+					}},
+				}}},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "running", Namespace: "ns-1"},
+				Spec:       corev1.PodSpec{NodeName: "node-1"},
+				Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "evicted", Namespace: "ns-1"},
+				Spec:       corev1.PodSpec{NodeName: "node-2"},
+				Status: corev1.PodStatus{Phase: corev1.PodFailed, Reason: "Evicted",
+					Message: "The node was low on resource: memory."},
+			},
+		}
+		// Filter the Pods by the status.phase field selector like the API server
+		list := &corev1.PodList{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "PodList"}}
+		for _, pod := range pods {
+			if req.URL.Query().Get("fieldSelector") == "status.phase="+string(pod.Status.Phase) {
+				pod.TypeMeta = metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"}
+				list.Items = append(list.Items, pod)
+			}
+		}
+		test.WriteObject(w, list)
+	}))
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+}
+
+func (s *PodsByPhaseSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *PodsByPhaseSuite) TestPodsByPhase() {
+	s.InitMcpClient()
+	s.Run("pods_by_phase(phase=nil)", func() {
+		toolResult, err := s.CallTool("pods_by_phase", map[string]interface{}{})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes missing phase", func() {
+			s.Equal("failed to list pods by phase, missing argument phase", toolResult.Content[0].(mcp.TextContent).Text)
+		})
+	})
+	s.Run("pods_by_phase(phase=Pending)", func() {
+		toolResult, err := s.CallTool("pods_by_phase", map[string]interface{}{"phase": "Pending", "namespace": "ns-1", "all_namespaces": false})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		textContent := toolResult.Content[0].(mcp.TextContent).Text
+		s.Run("returns table header", func() {
+			s.Regexp(`^NAMESPACE\s+NAME\s+NODE\s+REASON\s+MESSAGE\n`, textContent)
+		})
+		s.Run("returns the unschedulable pod with its reason", func() {
+			s.Regexp(`(?m)^ns-1\s+unschedulable\s+<none>\s+Unschedulable\s+0/3 nodes are available: 3 Insufficient memory\.$`, textContent)
+		})
+		s.Run("returns the waiting pod with its container reason", func() {
+			s.Regexp(`(?m)^ns-1\s+pulling\s+node-1\s+ImagePullBackOff\s+container app is waiting: Back-off pulling image "example.com/app:missing"$`, textContent)
+		})
+		s.Run("doesn't return the pods in other phases", func() {
+			s.NotContains(textContent, "running")
+			s.NotContains(textContent, "evicted")
+		})
+	})
+	s.Run("pods_by_phase(phase=Failed)", func() {
+		toolResult, err := s.CallTool("pods_by_phase", map[string]interface{}{"phase": "Failed", "namespace": "ns-1", "all_namespaces": false})
+		s.Require().Nilf(err, "call tool failed %v", err)
+		s.Require().Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Regexp(`(?m)^ns-1\s+evicted\s+node-2\s+Evicted\s+The node was low on resource: memory\.$`, toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("pods_by_phase(phase=Unknown)", func() {
+		toolResult, err := s.CallTool("pods_by_phase", map[string]interface{}{"phase": "Unknown", "namespace": "ns-1", "all_namespaces": false})
+		s.Require().Nilf(err, "call tool failed %v", err)
+		s.Equal("No Unknown pods found", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("pods_by_phase(phase=Completed)", func() {
+		toolResult, _ := s.CallTool("pods_by_phase", map[string]interface{}{"phase": "Completed", "namespace": "ns-1", "all_namespaces": false})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to list Completed pods: unsupported phase Completed, supported phases are: Pending, Running, Succeeded, Failed, Unknown",
+			toolResult.Content[0].(mcp.TextContent).Text)
+	})
+}
+
+func (s *PodsByPhaseSuite) TestPodsByPhaseDenied() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		denied_resources = [ { version = "v1", kind = "Pod" } ]
+	`), s.Cfg), "Expected to parse denied resources config")
+	s.InitMcpClient()
+	s.Run("pods_by_phase (denied)", func() {
+		toolResult, err := s.CallTool("pods_by_phase", map[string]interface{}{"phase": "Pending", "namespace": "ns-1", "all_namespaces": false})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes denial", func() {
+			msg := toolResult.Content[0].(mcp.TextContent).Text
+			expectedMessage := "failed to list Pending pods:(.+:)? resource not allowed: /v1, Kind=Pod"
+			s.Regexpf(expectedMessage, msg, "expected descriptive error '%s', got %v", expectedMessage, msg)
+		})
+	})
+}
+
+func TestPodsByPhase(t *testing.T) {
+	suite.Run(t, new(PodsByPhaseSuite))
+}
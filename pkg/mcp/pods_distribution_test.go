@@ -0,0 +1,116 @@
+package mcp
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type PodsDistributionSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+	// labelSelectors receives the label selector of the pod list requests
+	labelSelectors chan string
+}
+
+func (s *PodsDistributionSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.labelSelectors = make(chan string, 10)
+	s.mockServer = test.NewMockServer()
+	s.mockServer.Handle(test.NewDiscoveryClientHandler())
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/api/v1/namespaces/ns-1/pods" {
+			return
+		}
+		s.labelSelectors <- req.URL.Query().Get("labelSelector")
+		pod := func(name, node string, phase corev1.PodPhase) corev1.Pod {
+			return corev1.Pod{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns-1", Labels: map[string]string{"app": "web"}},
+				Spec:       corev1.PodSpec{NodeName: node},
+				Status:     corev1.PodStatus{Phase: phase},
+			}
+		}
+		test.WriteObject(w, &corev1.PodList{
+			TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "PodList"},
+			Items: []corev1.Pod{
+				pod("web-1", "node-1", corev1.PodRunning),
+				pod("web-2", "node-1", corev1.PodRunning),
+				pod("web-3", "node-2", corev1.PodRunning),
+				pod("web-4", "node-1", corev1.PodRunning),
+				pod("web-5", "", corev1.PodPending),
+				pod("web-completed", "node-2", corev1.PodSucceeded),
+			},
+		})
+	}))
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+}
+
+func (s *PodsDistributionSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *PodsDistributionSuite) TestPodsDistribution() {
+	s.InitMcpClient()
+	toolResult, err := s.CallTool("pods_distribution", map[string]interface{}{
+		"namespace":      "ns-1",
+		"all_namespaces": false,
+		"label_selector": "app=web",
+	})
+	s.Run("no error", func() {
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+	})
+	textContent := toolResult.Content[0].(mcp.TextContent).Text
+	s.Run("filters by label selector", func() {
+		s.Require().Len(s.labelSelectors, 1)
+		s.Equal("app=web", <-s.labelSelectors)
+	})
+	s.Run("returns table header", func() {
+		s.Regexp(`^NODE\s+PODS\s+SHARE\s+POD NAMES\n`, textContent)
+	})
+	s.Run("returns the per-node counts, the most loaded node first", func() {
+		s.Regexp(`(?m)^node-1\s+3\s+75%\s+ns-1/web-1,ns-1/web-2,ns-1/web-4\nnode-2\s+1\s+25%\s+ns-1/web-3\n`, textContent)
+	})
+	s.Run("ignores completed pods", func() {
+		s.NotContains(textContent, "web-completed")
+	})
+	s.Run("highlights the imbalance", func() {
+		s.Contains(textContent, "4 Pods scheduled on 2 nodes, max skew: 2 (imbalanced, the most loaded node runs 2 more Pods than the least loaded one)")
+	})
+	s.Run("returns the unscheduled pods", func() {
+		s.Contains(textContent, "1 Pods not scheduled to any node yet")
+	})
+}
+
+func (s *PodsDistributionSuite) TestPodsDistributionDenied() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		denied_resources = [ { version = "v1", kind = "Pod" } ]
+	`), s.Cfg), "Expected to parse denied resources config")
+	s.InitMcpClient()
+	s.Run("pods_distribution (denied)", func() {
+		toolResult, err := s.CallTool("pods_distribution", map[string]interface{}{"namespace": "ns-1", "all_namespaces": false})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes denial", func() {
+			msg := toolResult.Content[0].(mcp.TextContent).Text
+			expectedMessage := "failed to get pods distribution:(.+:)? resource not allowed: /v1, Kind=Pod"
+			s.Regexpf(expectedMessage, msg, "expected descriptive error '%s', got %v", expectedMessage, msg)
+		})
+	})
+}
+
+func TestPodsDistribution(t *testing.T) {
+	suite.Run(t, new(PodsDistributionSuite))
+}
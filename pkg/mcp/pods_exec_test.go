@@ -109,6 +109,78 @@ func (s *PodsExecSuite) TestPodsExec() {
 	})
 }
 
+func (s *PodsExecSuite) TestPodsExecDefaultContainer() {
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if !strings.HasSuffix(req.URL.Path, "/exec") {
+			return
+		}
+		var stdin, stdout bytes.Buffer
+		ctx, err := test.CreateHTTPStreams(w, req, &test.StreamOptions{
+			Stdin:  &stdin,
+			Stdout: &stdout,
+		})
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+		defer func(conn io.Closer) { _ = conn.Close() }(ctx.Closer)
+		_, _ = io.WriteString(ctx.StdoutStream, "container:"+strings.Join(req.URL.Query()["container"], " ")+"\n")
+	}))
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var annotations map[string]string
+		switch req.URL.Path {
+		case "/api/v1/namespaces/default/pods/pod-with-default-container":
+			annotations = map[string]string{"kubectl.kubernetes.io/default-container": "app"}
+		case "/api/v1/namespaces/default/pods/pod-with-invalid-default-container":
+			annotations = map[string]string{"kubectl.kubernetes.io/default-container": "not-a-container"}
+		default:
+			return
+		}
+		test.WriteObject(w, &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   "default",
+				Name:        strings.TrimPrefix(req.URL.Path, "/api/v1/namespaces/default/pods/"),
+				Annotations: annotations,
+			},
+			Spec: v1.PodSpec{Containers: []v1.Container{{Name: "istio-proxy"}, {Name: "app"}}},
+		})
+	}))
+	s.InitMcpClient()
+
+	s.Run("pods_exec(name=pod-with-default-container) uses the default container annotation", func() {
+		result, err := s.CallTool("pods_exec", map[string]interface{}{
+			"namespace": "default",
+			"name":      "pod-with-default-container",
+			"command":   []interface{}{"ls"},
+		})
+		s.Require().NoError(err, "call tool failed %v", err)
+		s.Falsef(result.IsError, "call tool failed: %v", result.Content)
+		s.Contains(result.Content[0].(mcp.TextContent).Text, "container:app\n")
+	})
+	s.Run("pods_exec(name=pod-with-default-container, container=istio-proxy) uses the provided container", func() {
+		result, err := s.CallTool("pods_exec", map[string]interface{}{
+			"namespace": "default",
+			"name":      "pod-with-default-container",
+			"command":   []interface{}{"ls"},
+			"container": "istio-proxy",
+		})
+		s.Require().NoError(err, "call tool failed %v", err)
+		s.Falsef(result.IsError, "call tool failed: %v", result.Content)
+		s.Contains(result.Content[0].(mcp.TextContent).Text, "container:istio-proxy\n")
+	})
+	s.Run("pods_exec(name=pod-with-invalid-default-container) falls back to the first container", func() {
+		result, err := s.CallTool("pods_exec", map[string]interface{}{
+			"namespace": "default",
+			"name":      "pod-with-invalid-default-container",
+			"command":   []interface{}{"ls"},
+		})
+		s.Require().NoError(err, "call tool failed %v", err)
+		s.Falsef(result.IsError, "call tool failed: %v", result.Content)
+		s.Contains(result.Content[0].(mcp.TextContent).Text, "container:istio-proxy\n")
+	})
+}
+
 func (s *PodsExecSuite) TestPodsExecDenied() {
 	s.Require().NoError(toml.Unmarshal([]byte(`
 		denied_resources = [ { version = "v1", kind = "Pod" } ]
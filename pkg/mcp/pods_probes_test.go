@@ -0,0 +1,147 @@
+package mcp
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+)
+
+type PodsProbesSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+}
+
+func (s *PodsProbesSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.mockServer.Handle(test.NewDiscoveryClientHandler())
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/api/v1/namespaces/default/pods/a-probed-pod" {
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"apiVersion": "v1",
+			"kind": "Pod",
+			"metadata": {"name": "a-probed-pod", "namespace": "default"},
+			"spec": {
+				"initContainers": [
+					{"name": "init-db", "image": "busybox"},
+					{"name": "proxy", "image": "envoy", "restartPolicy": "Always",
+						"startupProbe": {"tcpSocket": {"port": 15000}, "periodSeconds": 2, "failureThreshold": 30}}
+				],
+				"containers": [
+					{"name": "app", "image": "nginx",
+						"livenessProbe": {"httpGet": {"path": "/healthz", "port": 8080}, "initialDelaySeconds": 15, "periodSeconds": 20, "failureThreshold": 5},
+						"readinessProbe": {"exec": {"command": ["cat", "/tmp/ready"]}}
+					},
+					{"name": "grpc", "image": "grpc-server",
+						"livenessProbe": {"grpc": {"port": 9090, "service": "health"}, "timeoutSeconds": 5}
+					},
+					{"name": "no-probes", "image": "busybox"}
+				]
+			},
+			"status": {
+				"phase": "Running",
+				"initContainerStatuses": [{"name": "proxy", "ready": true, "restartCount": 0}],
+				"containerStatuses": [
+					{"name": "app", "ready": false, "restartCount": 7},
+					{"name": "grpc", "ready": true, "restartCount": 0},
+					{"name": "no-probes", "ready": true, "restartCount": 0}
+				]
+			}
+		}`))
+	}))
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+}
+
+func (s *PodsProbesSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *PodsProbesSuite) TestPodsProbes() {
+	s.InitMcpClient()
+	s.Run("pods_probes(name=nil)", func() {
+		toolResult, err := s.CallTool("pods_probes", map[string]interface{}{})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes missing name", func() {
+			s.Equal("failed to get pod probes, missing argument name", toolResult.Content[0].(mcp.TextContent).Text)
+		})
+	})
+	s.Run("pods_probes(name=a-probed-pod)", func() {
+		toolResult, err := s.CallTool("pods_probes", map[string]interface{}{"name": "a-probed-pod", "namespace": "default"})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		textContent := toolResult.Content[0].(mcp.TextContent).Text
+		s.Run("returns header", func() {
+			s.Regexp(`^# Pod a-probed-pod container probes \(YAML format\):\n`, textContent)
+		})
+		s.Run("returns the HTTP liveness probe with its thresholds", func() {
+			s.Contains(textContent, "Handler: GET http://:8080/healthz")
+			s.Contains(textContent, "Type: HTTPGet")
+			s.Contains(textContent, "InitialDelaySeconds: 15")
+			s.Contains(textContent, "PeriodSeconds: 20")
+			s.Contains(textContent, "FailureThreshold: 5")
+		})
+		s.Run("returns the exec readiness probe", func() {
+			s.Contains(textContent, "Type: Exec")
+			s.Contains(textContent, "Handler: cat /tmp/ready")
+		})
+		s.Run("returns the gRPC liveness probe", func() {
+			s.Contains(textContent, "Type: GRPC")
+			s.Contains(textContent, "Handler: grpc://:9090 service=health")
+			s.Contains(textContent, "TimeoutSeconds: 5")
+		})
+		s.Run("returns the container readiness and restart count", func() {
+			s.Contains(textContent, "RestartCount: 7")
+		})
+		s.Run("returns the sidecar startup probe", func() {
+			s.Contains(textContent, "Container: proxy")
+			s.Contains(textContent, "InitContainer: true")
+			s.Contains(textContent, "Type: TCPSocket")
+			s.Contains(textContent, "Handler: tcp://:15000")
+		})
+		s.Run("omits the init containers without probes", func() {
+			s.NotContains(textContent, "init-db")
+		})
+		s.Run("returns the containers without probes", func() {
+			s.Contains(textContent, "Container: no-probes")
+		})
+	})
+}
+
+func (s *PodsProbesSuite) TestPodsProbesDenied() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		denied_resources = [ { version = "v1", kind = "Pod" } ]
+	`), s.Cfg), "Expected to parse denied resources config")
+	s.InitMcpClient()
+	s.Run("pods_probes (denied)", func() {
+		toolResult, err := s.CallTool("pods_probes", map[string]interface{}{"name": "a-probed-pod", "namespace": "default"})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes denial", func() {
+			msg := toolResult.Content[0].(mcp.TextContent).Text
+			expectedMessage := "failed to get probes for pod a-probed-pod in namespace default:(.+:)? resource not allowed: /v1, Kind=Pod"
+			s.Regexpf(expectedMessage, msg, "expected descriptive error '%s', got %v", expectedMessage, msg)
+		})
+	})
+}
+
+func TestPodsProbes(t *testing.T) {
+	suite.Run(t, new(PodsProbesSuite))
+}
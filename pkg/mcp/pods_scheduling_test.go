@@ -0,0 +1,142 @@
+package mcp
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	"sigs.k8s.io/yaml"
+)
+
+type PodsSchedulingSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+}
+
+func (s *PodsSchedulingSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.mockServer.Handle(test.NewDiscoveryClientHandler())
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/api/v1/namespaces/default/pods/a-pending-pod" {
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"apiVersion": "v1",
+			"kind": "Pod",
+			"metadata": {"name": "a-pending-pod", "namespace": "default"},
+			"spec": {
+				"containers": [{"name": "app", "image": "nginx", "resources": {"requests": {"cpu": "100m"}, "limits": {"cpu": "200m"}}}],
+				"nodeSelector": {"disktype": "ssd"},
+				"affinity": {
+					"nodeAffinity": {"requiredDuringSchedulingIgnoredDuringExecution": {"nodeSelectorTerms": [
+						{"matchExpressions": [{"key": "kubernetes.io/arch", "operator": "In", "values": ["amd64", "arm64"]}]}
+					]}},
+					"podAntiAffinity": {"preferredDuringSchedulingIgnoredDuringExecution": [
+						{"weight": 100, "podAffinityTerm": {"topologyKey": "kubernetes.io/hostname", "labelSelector": {"matchLabels": {"app": "web"}}}}
+					]}
+				},
+				"tolerations": [
+					{"key": "dedicated", "operator": "Equal", "value": "gpu", "effect": "NoSchedule"},
+					{"key": "node.kubernetes.io/not-ready", "operator": "Exists", "effect": "NoExecute", "tolerationSeconds": 300}
+				]
+			},
+			"status": {
+				"phase": "Pending",
+				"conditions": [{
+					"type": "PodScheduled",
+					"status": "False",
+					"reason": "Unschedulable",
+					"message": "0/3 nodes are available: 3 node(s) didn't match Pod's node affinity/selector."
+				}]
+			}
+		}`))
+	}))
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+}
+
+func (s *PodsSchedulingSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *PodsSchedulingSuite) TestPodsScheduling() {
+	s.InitMcpClient()
+	s.Run("pods_scheduling(name=nil)", func() {
+		toolResult, err := s.CallTool("pods_scheduling", map[string]interface{}{})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes missing name", func() {
+			s.Equal("failed to get pod scheduling details, missing argument name", toolResult.Content[0].(mcp.TextContent).Text)
+		})
+	})
+	s.Run("pods_scheduling(name=a-pending-pod, namespace=default)", func() {
+		toolResult, err := s.CallTool("pods_scheduling", map[string]interface{}{"name": "a-pending-pod", "namespace": "default"})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		var decoded map[string]any
+		s.Require().NoError(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded), "invalid tool result content")
+		s.Run("returns computed QoS class", func() {
+			s.Equal("Burstable", decoded["QoSClass"])
+		})
+		s.Run("returns phase and empty node name", func() {
+			s.Equal("Pending", decoded["Phase"])
+			s.Equal("", decoded["NodeName"])
+		})
+		s.Run("returns node selector", func() {
+			s.Equal(map[string]any{"disktype": "ssd"}, decoded["NodeSelector"])
+		})
+		s.Run("returns affinity summary", func() {
+			s.Equal([]any{
+				"NodeAffinity (required): kubernetes.io/arch In amd64,arm64",
+				"PodAntiAffinity (preferred, weight 100): topologyKey=kubernetes.io/hostname selector=app=web",
+			}, decoded["Affinity"])
+		})
+		s.Run("returns tolerations", func() {
+			s.Equal([]any{
+				"dedicated=gpu:NoSchedule",
+				"node.kubernetes.io/not-ready:NoExecute for 300s",
+			}, decoded["Tolerations"])
+		})
+		s.Run("returns reason why the pod is unschedulable", func() {
+			s.Equal(map[string]any{
+				"Reason":  "Unschedulable",
+				"Message": "0/3 nodes are available: 3 node(s) didn't match Pod's node affinity/selector.",
+			}, decoded["Unschedulable"])
+		})
+	})
+}
+
+func (s *PodsSchedulingSuite) TestPodsSchedulingDenied() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		denied_resources = [ { version = "v1", kind = "Pod" } ]
+	`), s.Cfg), "Expected to parse denied resources config")
+	s.InitMcpClient()
+	s.Run("pods_scheduling (denied)", func() {
+		toolResult, err := s.CallTool("pods_scheduling", map[string]interface{}{"name": "a-pending-pod", "namespace": "default"})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes denial", func() {
+			msg := toolResult.Content[0].(mcp.TextContent).Text
+			expectedMessage := "failed to get scheduling details for pod a-pending-pod in namespace default:(.+:)? resource not allowed: /v1, Kind=Pod"
+			s.Regexpf(expectedMessage, msg, "expected descriptive error '%s', got %v", expectedMessage, msg)
+		})
+	})
+}
+
+func TestPodsScheduling(t *testing.T) {
+	suite.Run(t, new(PodsSchedulingSuite))
+}
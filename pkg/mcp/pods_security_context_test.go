@@ -0,0 +1,150 @@
+package mcp
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	"sigs.k8s.io/yaml"
+)
+
+type PodsSecurityContextSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+}
+
+func (s *PodsSecurityContextSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.mockServer.Handle(test.NewDiscoveryClientHandler())
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/api/v1/namespaces/default/pods/a-secured-pod" {
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{
+			"apiVersion": "v1",
+			"kind": "Pod",
+			"metadata": {"name": "a-secured-pod", "namespace": "default"},
+			"spec": {
+				"hostNetwork": true,
+				"securityContext": {
+					"runAsUser": 1000, "runAsGroup": 3000, "runAsNonRoot": true, "fsGroup": 2000,
+					"seccompProfile": {"type": "RuntimeDefault"}
+				},
+				"initContainers": [
+					{"name": "init-permissions", "image": "busybox", "securityContext": {"runAsUser": 0, "runAsNonRoot": false}}
+				],
+				"containers": [
+					{"name": "app", "image": "nginx", "securityContext": {
+						"allowPrivilegeEscalation": false, "readOnlyRootFilesystem": true,
+						"capabilities": {"add": ["NET_BIND_SERVICE"], "drop": ["ALL"]}
+					}},
+					{"name": "debugger", "image": "busybox", "securityContext": {
+						"privileged": true,
+						"seccompProfile": {"type": "Localhost", "localhostProfile": "profiles/audit.json"}
+					}}
+				]
+			}
+		}`))
+	}))
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+}
+
+func (s *PodsSecurityContextSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *PodsSecurityContextSuite) TestPodsSecurityContext() {
+	s.InitMcpClient()
+	s.Run("pods_security_context(name=nil)", func() {
+		toolResult, err := s.CallTool("pods_security_context", map[string]interface{}{})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes missing name", func() {
+			s.Equal("failed to get pod security context, missing argument name", toolResult.Content[0].(mcp.TextContent).Text)
+		})
+	})
+	s.Run("pods_security_context(name=a-secured-pod)", func() {
+		toolResult, err := s.CallTool("pods_security_context", map[string]interface{}{"name": "a-secured-pod", "namespace": "default"})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		textContent := toolResult.Content[0].(mcp.TextContent).Text
+		s.Run("returns header", func() {
+			s.Regexp(`^# Pod a-secured-pod security context \(YAML format\):\n`, textContent)
+		})
+		var decoded map[string]any
+		s.Require().NoError(yaml.Unmarshal([]byte(strings.SplitN(textContent, "\n", 2)[1]), &decoded), "invalid tool result content")
+		s.Run("returns the pod-level settings", func() {
+			s.Equal(true, decoded["HostNetwork"])
+			s.Equal(float64(2000), decoded["FSGroup"])
+			s.NotContains(decoded, "HostPID")
+		})
+		containers, ok := decoded["Containers"].([]any)
+		s.Require().Truef(ok, "expected Containers list, got %v", decoded["Containers"])
+		s.Require().Len(containers, 3)
+		s.Run("init container overrides the pod-level user", func() {
+			initContainer := containers[0].(map[string]any)
+			s.Equal("init-permissions", initContainer["Container"])
+			s.Equal(true, initContainer["InitContainer"])
+			s.Equal(float64(0), initContainer["RunAsUser"])
+			s.Equal(false, initContainer["RunAsNonRoot"])
+			s.Equal(float64(3000), initContainer["RunAsGroup"])
+		})
+		s.Run("container inherits the pod-level settings", func() {
+			app := containers[1].(map[string]any)
+			s.Equal("app", app["Container"])
+			s.Equal(float64(1000), app["RunAsUser"])
+			s.Equal(true, app["RunAsNonRoot"])
+			s.Equal("RuntimeDefault", app["SeccompProfile"])
+			s.Equal(false, app["Privileged"])
+			s.Equal(false, app["AllowPrivilegeEscalation"])
+			s.Equal(true, app["ReadOnlyRootFilesystem"])
+			s.Equal([]any{"NET_BIND_SERVICE"}, app["CapabilitiesAdded"])
+			s.Equal([]any{"ALL"}, app["CapabilitiesDropped"])
+		})
+		s.Run("privileged container with a localhost seccomp profile", func() {
+			debugger := containers[2].(map[string]any)
+			s.Equal("debugger", debugger["Container"])
+			s.Equal(true, debugger["Privileged"])
+			s.Equal("Localhost/profiles/audit.json", debugger["SeccompProfile"])
+			s.NotContains(debugger, "AllowPrivilegeEscalation")
+			s.NotContains(debugger, "CapabilitiesAdded")
+		})
+	})
+}
+
+func (s *PodsSecurityContextSuite) TestPodsSecurityContextDenied() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		denied_resources = [ { version = "v1", kind = "Pod" } ]
+	`), s.Cfg), "Expected to parse denied resources config")
+	s.InitMcpClient()
+	s.Run("pods_security_context (denied)", func() {
+		toolResult, err := s.CallTool("pods_security_context", map[string]interface{}{"name": "a-secured-pod", "namespace": "default"})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes denial", func() {
+			msg := toolResult.Content[0].(mcp.TextContent).Text
+			expectedMessage := "failed to get security context for pod a-secured-pod in namespace default:(.+:)? resource not allowed: /v1, Kind=Pod"
+			s.Regexpf(expectedMessage, msg, "expected descriptive error '%s', got %v", expectedMessage, msg)
+		})
+	})
+}
+
+func TestPodsSecurityContext(t *testing.T) {
+	suite.Run(t, new(PodsSecurityContextSuite))
+}
@@ -1,21 +1,29 @@
 package mcp
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/BurntSushi/toml"
+	"github.com/containers/kubernetes-mcp-server/internal/test"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/mark3labs/mcp-go/mcp"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/yaml"
 )
 
@@ -284,6 +292,90 @@ func (s *PodsSuite) TestPodsListAsTable() {
 	})
 }
 
+// createPodInKubeSystem creates a Pod in the kube-system namespace that is deleted once the test completes
+func (s *PodsSuite) createPodInKubeSystem() {
+	kc := kubernetes.NewForConfigOrDie(envTestRestConfig)
+	_, err := kc.CoreV1().Pods("kube-system").Create(s.T().Context(), &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "a-pod-in-kube-system"},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "pause", Image: "registry.k8s.io/pause:3.9"}}},
+	}, metav1.CreateOptions{})
+	s.Require().NoError(err, "failed to create pod in kube-system")
+	s.T().Cleanup(func() {
+		_ = kc.CoreV1().Pods("kube-system").Delete(context.Background(), "a-pod-in-kube-system", metav1.DeleteOptions{
+			GracePeriodSeconds: ptr.To(int64(0)),
+		})
+	})
+}
+
+func (s *PodsSuite) TestPodsListIncludesSystemNamespacesByDefault() {
+	s.createPodInKubeSystem()
+	s.InitMcpClient()
+	s.Run("pods_list returns pods in system namespaces", func() {
+		toolResult, err := s.CallTool("pods_list", map[string]interface{}{})
+		s.Require().Nilf(err, "call tool failed %v", err)
+		s.Require().Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Contains(toolResult.Content[0].(mcp.TextContent).Text, "a-pod-in-kube-system")
+	})
+}
+
+func (s *PodsSuite) TestPodsListExcludeSystemNamespaces() {
+	s.createPodInKubeSystem()
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		exclude_system_namespaces = true
+	`), s.Cfg), "Expected to parse exclude system namespaces config")
+	s.InitMcpClient()
+	s.Run("pods_list excludes pods in system namespaces", func() {
+		toolResult, err := s.CallTool("pods_list", map[string]interface{}{})
+		s.Require().Nilf(err, "call tool failed %v", err)
+		s.Require().Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		text := toolResult.Content[0].(mcp.TextContent).Text
+		s.NotContains(text, "a-pod-in-kube-system")
+		s.Contains(text, "a-pod-in-ns-1")
+		s.Contains(text, "a-pod-in-ns-2")
+	})
+	s.Run("pods_list_in_namespace(namespace=kube-system) returns pods in the requested system namespace", func() {
+		toolResult, err := s.CallTool("pods_list_in_namespace", map[string]interface{}{"namespace": "kube-system"})
+		s.Require().Nilf(err, "call tool failed %v", err)
+		s.Require().Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Contains(toolResult.Content[0].(mcp.TextContent).Text, "a-pod-in-kube-system")
+	})
+}
+
+func (s *PodsSuite) TestPodsListExcludeSystemNamespacesAsTable() {
+	s.createPodInKubeSystem()
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		list_output = "table"
+		exclude_system_namespaces = true
+	`), s.Cfg), "Expected to parse exclude system namespaces config")
+	s.InitMcpClient()
+	s.Run("pods_list (list_output=table) excludes pods in system namespaces", func() {
+		toolResult, err := s.CallTool("pods_list", map[string]interface{}{})
+		s.Require().Nilf(err, "call tool failed %v", err)
+		s.Require().Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		text := toolResult.Content[0].(mcp.TextContent).Text
+		s.NotContains(text, "a-pod-in-kube-system")
+		s.Contains(text, "a-pod-in-ns-1")
+	})
+}
+
+func (s *PodsSuite) TestPodsListExcludeSystemNamespacesCustomPrefixes() {
+	s.createPodInKubeSystem()
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		exclude_system_namespaces = true
+		system_namespace_prefixes = [ "ns-1" ]
+	`), s.Cfg), "Expected to parse system namespace prefixes config")
+	s.InitMcpClient()
+	s.Run("pods_list excludes pods in namespaces matching the configured prefixes", func() {
+		toolResult, err := s.CallTool("pods_list", map[string]interface{}{})
+		s.Require().Nilf(err, "call tool failed %v", err)
+		s.Require().Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		text := toolResult.Content[0].(mcp.TextContent).Text
+		s.NotContains(text, "a-pod-in-ns-1")
+		s.Contains(text, "a-pod-in-ns-2")
+		s.Contains(text, "a-pod-in-kube-system")
+	})
+}
+
 func (s *PodsSuite) TestPodsGet() {
 	s.InitMcpClient()
 	s.Run("pods_get with nil name returns error", func() {
@@ -509,6 +601,81 @@ func (s *PodsSuite) TestPodsDeleteInOpenShift() {
 	})
 }
 
+func (s *PodsSuite) TestPodsDebug() {
+	s.InitMcpClient()
+	s.Run("pods_debug with nil name returns error", func() {
+		toolResult, _ := s.CallTool("pods_debug", map[string]interface{}{})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equalf("failed to debug pod, missing argument name", toolResult.Content[0].(mcp.TextContent).Text, "invalid error message, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("pods_debug(name=not-found) with not found name returns error", func() {
+		toolResult, _ := s.CallTool("pods_debug", map[string]interface{}{"name": "not-found"})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equalf("failed to debug pod not-found in namespace : pods \"not-found\" not found", toolResult.Content[0].(mcp.TextContent).Text, "invalid error message, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("pods_debug(name=a-pod-to-debug, namespace=ns-1)", func() {
+		kc := kubernetes.NewForConfigOrDie(envTestRestConfig)
+		_, err := kc.CoreV1().Pods("ns-1").Create(s.T().Context(), &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "a-pod-to-debug"},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "nginx", Image: "nginx"}}},
+		}, metav1.CreateOptions{})
+		s.Require().NoError(err)
+		toolResult, err := s.CallTool("pods_debug", map[string]interface{}{
+			"namespace": "ns-1",
+			"name":      "a-pod-to-debug",
+			"container": "nginx",
+		})
+		s.Run("returns success", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed %v", toolResult.Content)
+			s.Regexpf(`^Ephemeral debug container debugger-[a-z0-9]{5} added to pod a-pod-to-debug$`, toolResult.Content[0].(mcp.TextContent).Text,
+				"invalid tool result content, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+		})
+		s.Run("adds ephemeral container to Pod", func() {
+			pod, err := kc.CoreV1().Pods("ns-1").Get(s.T().Context(), "a-pod-to-debug", metav1.GetOptions{})
+			s.Require().NoError(err)
+			s.Require().Len(pod.Spec.EphemeralContainers, 1, "expected 1 ephemeral container")
+			s.Truef(strings.HasPrefix(pod.Spec.EphemeralContainers[0].Name, "debugger-"), "invalid ephemeral container name %s", pod.Spec.EphemeralContainers[0].Name)
+			s.Equal("busybox", pod.Spec.EphemeralContainers[0].Image, "invalid ephemeral container image")
+			s.Equal("nginx", pod.Spec.EphemeralContainers[0].TargetContainerName, "invalid ephemeral container target")
+		})
+	})
+	s.Run("pods_debug(name=a-pod-to-debug, namespace=ns-1, image=alpine) adds another ephemeral container", func() {
+		toolResult, err := s.CallTool("pods_debug", map[string]interface{}{
+			"namespace": "ns-1",
+			"name":      "a-pod-to-debug",
+			"image":     "alpine",
+		})
+		s.Require().NoError(err)
+		s.Falsef(toolResult.IsError, "call tool failed %v", toolResult.Content)
+		pod, err := kubernetes.NewForConfigOrDie(envTestRestConfig).CoreV1().Pods("ns-1").Get(s.T().Context(), "a-pod-to-debug", metav1.GetOptions{})
+		s.Require().NoError(err)
+		s.Require().Len(pod.Spec.EphemeralContainers, 2, "expected 2 ephemeral containers")
+		s.Equal("alpine", pod.Spec.EphemeralContainers[1].Image, "invalid ephemeral container image")
+	})
+}
+
+func (s *PodsSuite) TestPodsDebugDenied() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		denied_resources = [ { version = "v1", kind = "Pod" } ]
+	`), s.Cfg), "Expected to parse denied resources config")
+	s.InitMcpClient()
+	s.Run("pods_debug (denied)", func() {
+		podsDebug, err := s.CallTool("pods_debug", map[string]interface{}{"name": "a-pod-in-default"})
+		s.Run("has error", func() {
+			s.Truef(podsDebug.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes denial", func() {
+			msg := podsDebug.Content[0].(mcp.TextContent).Text
+			s.Contains(msg, "resource not allowed:")
+			expectedMessage := "failed to debug pod a-pod-in-default in namespace :(.+:)? resource not allowed: /v1, Kind=Pod"
+			s.Regexpf(expectedMessage, msg,
+				"expected descriptive error '%s', got %v", expectedMessage, msg)
+		})
+	})
+}
+
 func (s *PodsSuite) TestPodsLog() {
 	s.InitMcpClient()
 	s.Run("pods_log with nil name returns error", func() {
@@ -595,6 +762,120 @@ func (s *PodsSuite) TestPodsLog() {
 	})
 }
 
+func (s *PodsSuite) TestPodsLogDefaultTailLines() {
+	mockServer := test.NewMockServer()
+	s.T().Cleanup(mockServer.Close)
+	mockServer.Handle(test.NewDiscoveryClientHandler())
+	mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		// Echo the requested tailLines
+		if req.URL.Path == "/api/v1/namespaces/default/pods/a-pod/log" {
+			w.Header().Set("Content-Type", "text/plain")
+			_, _ = w.Write([]byte("tailLines=" + req.URL.Query().Get("tailLines")))
+		}
+	}))
+	s.Cfg.KubeConfig = mockServer.KubeconfigFile(s.T())
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		default_log_tail_lines = 5
+	`), s.Cfg), "Expected to parse default log tail lines config")
+	s.InitMcpClient()
+	s.Run("pods_log(name=a-pod) applies configured default", func() {
+		toolResult, err := s.CallTool("pods_log", map[string]interface{}{"name": "a-pod"})
+		s.Require().NoError(err)
+		s.Falsef(toolResult.IsError, "call tool failed %v", toolResult.Content)
+		s.Equal("tailLines=5", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("pods_log(name=a-pod, tail=10) overrides configured default", func() {
+		toolResult, err := s.CallTool("pods_log", map[string]interface{}{"name": "a-pod", "tail": 10})
+		s.Require().NoError(err)
+		s.Falsef(toolResult.IsError, "call tool failed %v", toolResult.Content)
+		s.Equal("tailLines=10", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("pods_log(name=a-pod, tail=-1) retrieves all logs", func() {
+		toolResult, err := s.CallTool("pods_log", map[string]interface{}{"name": "a-pod", "tail": -1})
+		s.Require().NoError(err)
+		s.Falsef(toolResult.IsError, "call tool failed %v", toolResult.Content)
+		s.Equal("tailLines=", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+}
+
+func (s *PodsSuite) TestPodsLogDefaultContainer() {
+	mockServer := test.NewMockServer()
+	s.T().Cleanup(mockServer.Close)
+	mockServer.Handle(test.NewDiscoveryClientHandler())
+	mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/api/v1/namespaces/default/pods/a-pod-with-default-container":
+			test.WriteObject(w, &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   "default",
+					Name:        "a-pod-with-default-container",
+					Annotations: map[string]string{"kubectl.kubernetes.io/default-container": "app"},
+				},
+				Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "istio-proxy"}, {Name: "app"}}},
+			})
+		case "/api/v1/namespaces/default/pods/a-pod-with-default-container/log":
+			// Echo the requested container
+			w.Header().Set("Content-Type", "text/plain")
+			_, _ = w.Write([]byte("container=" + req.URL.Query().Get("container")))
+		}
+	}))
+	s.Cfg.KubeConfig = mockServer.KubeconfigFile(s.T())
+	s.InitMcpClient()
+	s.Run("pods_log(name=a-pod-with-default-container) uses the default container annotation", func() {
+		toolResult, err := s.CallTool("pods_log", map[string]interface{}{"name": "a-pod-with-default-container"})
+		s.Require().NoError(err)
+		s.Falsef(toolResult.IsError, "call tool failed %v", toolResult.Content)
+		s.Equal("container=app", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("pods_log(name=a-pod-with-default-container, container=istio-proxy) uses the provided container", func() {
+		toolResult, err := s.CallTool("pods_log", map[string]interface{}{"name": "a-pod-with-default-container", "container": "istio-proxy"})
+		s.Require().NoError(err)
+		s.Falsef(toolResult.IsError, "call tool failed %v", toolResult.Content)
+		s.Equal("container=istio-proxy", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+}
+
+func (s *PodsSuite) TestPodsLogPreviousNotFound() {
+	mockServer := test.NewMockServer()
+	s.T().Cleanup(mockServer.Close)
+	mockServer.Handle(test.NewDiscoveryClientHandler())
+	mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/api/v1/namespaces/default/pods/a-pod/log" {
+			return
+		}
+		if req.URL.Query().Get("previous") != "true" {
+			w.Header().Set("Content-Type", "text/plain")
+			_, _ = w.Write([]byte("current container log"))
+			return
+		}
+		status := apierrors.NewBadRequest(`previous terminated container "container" in pod "a-pod" not found`).ErrStatus
+		status.APIVersion, status.Kind = "v1", "Status"
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(&status)
+	}))
+	s.Cfg.KubeConfig = mockServer.KubeconfigFile(s.T())
+	s.InitMcpClient()
+	s.Run("pods_log(namespace=default, name=a-pod, previous=true) without previous instance", func() {
+		toolResult, err := s.CallTool("pods_log", map[string]interface{}{"namespace": "default", "name": "a-pod", "previous": true})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed %v", toolResult.Content)
+		})
+		s.Run("returns friendly message", func() {
+			s.Equal("The pod a-pod in namespace default has no previous terminated container instance "+
+				"(the container has not been restarted yet), retry with previous=false to get the logs of the current container",
+				toolResult.Content[0].(mcp.TextContent).Text)
+		})
+	})
+	s.Run("pods_log(name=a-pod, previous=false) returns current log", func() {
+		toolResult, err := s.CallTool("pods_log", map[string]interface{}{"name": "a-pod", "previous": false})
+		s.Require().Nilf(err, "call tool failed %v", err)
+		s.Require().Falsef(toolResult.IsError, "call tool failed %v", toolResult.Content)
+		s.Equal("current container log", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+}
+
 func (s *PodsSuite) TestPodsLogDenied() {
 	s.Require().NoError(toml.Unmarshal([]byte(`
 		denied_resources = [ { version = "v1", kind = "Pod" } ]
@@ -616,6 +897,219 @@ func (s *PodsSuite) TestPodsLogDenied() {
 	})
 }
 
+func (s *PodsSuite) TestPodsDiagnose() {
+	mockServer := test.NewMockServer()
+	s.T().Cleanup(mockServer.Close)
+	discovery := test.NewDiscoveryClientHandler()
+	discovery.APIResourceLists[0].APIResources = append(discovery.APIResourceLists[0].APIResources,
+		metav1.APIResource{Name: "events", Kind: "Event", Namespaced: true, Verbs: metav1.Verbs{"get", "list", "watch"}})
+	mockServer.Handle(discovery)
+	tailLines := make(chan string, 10)
+	mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/api/v1/namespaces/default/pods/a-failing-pod":
+			test.WriteObject(w, &corev1.Pod{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "a-failing-pod", UID: "a-failing-pod-uid"},
+				Spec:       corev1.PodSpec{NodeName: "node-1", Containers: []corev1.Container{{Name: "app"}, {Name: "sidecar"}}},
+				Status: corev1.PodStatus{
+					Phase: corev1.PodRunning,
+					Conditions: []corev1.PodCondition{
+						{Type: corev1.PodReady, Status: corev1.ConditionFalse, Reason: "ContainersNotReady", Message: "containers with unready status: [app]"},
+					},
+					ContainerStatuses: []corev1.ContainerStatus{
+						{
+							Name:         "app",
+							RestartCount: 3,
+							State:        corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+							LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+								Reason: "Error", ExitCode: 1, FinishedAt: metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+							}},
+						},
+						{Name: "sidecar", Ready: true, State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+					},
+				},
+			})
+		case "/api/v1/namespaces/default/events":
+			test.WriteObject(w, &corev1.EventList{
+				TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "EventList"},
+				Items: []corev1.Event{{
+					ObjectMeta:     metav1.ObjectMeta{Namespace: "default", Name: "a-failing-pod.backoff"},
+					InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "a-failing-pod", Namespace: "default", UID: "a-failing-pod-uid"},
+					Type:           corev1.EventTypeWarning,
+					Reason:         "BackOff",
+					Message:        "Back-off restarting failed container app",
+					FirstTimestamp: metav1.NewTime(time.Now().Add(-time.Minute)),
+				}},
+			})
+		case "/api/v1/namespaces/default/pods/a-failing-pod/log":
+			tailLines <- req.URL.Query().Get("tailLines")
+			w.Header().Set("Content-Type", "text/plain")
+			_, _ = w.Write([]byte(req.URL.Query().Get("container") + " log, previous=" + req.URL.Query().Get("previous")))
+		}
+	}))
+	s.Cfg.KubeConfig = mockServer.KubeconfigFile(s.T())
+	s.InitMcpClient()
+	s.Run("pods_diagnose(name=a-failing-pod)", func() {
+		toolResult, err := s.CallTool("pods_diagnose", map[string]interface{}{"name": "a-failing-pod"})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed %v", toolResult.Content)
+		})
+		text := toolResult.Content[0].(mcp.TextContent).Text
+		s.Run("returns status section with conditions and last restart reason", func() {
+			s.Contains(text, "## Status (YAML format)\n")
+			s.Contains(text, "Phase: Running\n")
+			s.Contains(text, "Reason: ContainersNotReady\n")
+			s.Regexp(`LastTermination: '?Terminated: Error \(exit code 1\)'?\n`, text)
+			s.Regexp(`LastTerminationTime: "?2024-01-01T00:00:00Z"?\n`, text)
+			s.Regexp(`State: '?Waiting: CrashLoopBackOff'?\n`, text)
+		})
+		s.Run("returns events section", func() {
+			s.Contains(text, "## Events (YAML format, oldest first)\n")
+			s.Contains(text, "Message: Back-off restarting failed container app\n")
+		})
+		s.Run("returns logs section", func() {
+			s.Contains(text, "## Logs (last 50 lines)\n")
+			s.Contains(text, "### Container app (current instance)\napp log, previous=\n")
+			s.Contains(text, "### Container app (previous terminated instance)\napp log, previous=true\n")
+			s.Contains(text, "### Container sidecar (current instance)\nsidecar log, previous=\n")
+			s.NotContains(text, "### Container sidecar (previous terminated instance)")
+		})
+		s.Run("bounds the log tail", func() {
+			s.Equal("50", <-tailLines)
+		})
+	})
+	s.Run("pods_diagnose(name=a-failing-pod, tail=100000) bounds the log tail", func() {
+		for len(tailLines) > 0 {
+			<-tailLines
+		}
+		toolResult, err := s.CallTool("pods_diagnose", map[string]interface{}{"name": "a-failing-pod", "tail": 100000})
+		s.Require().Nilf(err, "call tool failed %v", err)
+		s.Require().Falsef(toolResult.IsError, "call tool failed %v", toolResult.Content)
+		s.Contains(toolResult.Content[0].(mcp.TextContent).Text, "## Logs (last 500 lines)\n")
+		s.Equal("500", <-tailLines)
+	})
+}
+
+func (s *PodsSuite) TestPodsDiagnoseDenied() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		denied_resources = [ { version = "v1", kind = "Pod" } ]
+	`), s.Cfg), "Expected to parse denied resources config")
+	s.InitMcpClient()
+	s.Run("pods_diagnose (denied)", func() {
+		toolResult, err := s.CallTool("pods_diagnose", map[string]interface{}{"name": "a-pod-in-default"})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes denial", func() {
+			msg := toolResult.Content[0].(mcp.TextContent).Text
+			expectedMessage := "failed to diagnose pod a-pod-in-default in namespace :(.+:)? resource not allowed: /v1, Kind=Pod"
+			s.Regexpf(expectedMessage, msg, "expected descriptive error '%s', got %v", expectedMessage, msg)
+		})
+	})
+}
+
+func (s *PodsSuite) TestPodsRestarts() {
+	mockServer := test.NewMockServer()
+	s.T().Cleanup(mockServer.Close)
+	mockServer.Handle(test.NewDiscoveryClientHandler())
+	restartedPod := func(namespace, name string, restarts ...int32) corev1.Pod {
+		pod := corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+		for i, r := range restarts {
+			status := corev1.ContainerStatus{Name: fmt.Sprintf("container-%d", i), RestartCount: r}
+			if r > 0 {
+				status.LastTerminationState.Terminated = &corev1.ContainerStateTerminated{
+					Reason: "OOMKilled", ExitCode: 137, FinishedAt: metav1.NewTime(time.Now().Add(-5 * time.Minute)),
+				}
+			}
+			pod.Status.ContainerStatuses = append(pod.Status.ContainerStatuses, status)
+		}
+		return pod
+	}
+	pods := []corev1.Pod{
+		restartedPod("default", "stable", 0),
+		restartedPod("default", "flapping", 7, 5),
+		restartedPod("monitoring", "restarted-once", 1),
+		restartedPod("default", "crash-looping", 3),
+	}
+	mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var items []corev1.Pod
+		switch req.URL.Path {
+		case "/api/v1/pods":
+			items = pods
+		case "/api/v1/namespaces/default/pods":
+			for _, pod := range pods {
+				if pod.Namespace == "default" {
+					items = append(items, pod)
+				}
+			}
+		default:
+			return
+		}
+		test.WriteObject(w, &corev1.PodList{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "PodList"}, Items: items})
+	}))
+	s.Cfg.KubeConfig = mockServer.KubeconfigFile(s.T())
+	s.InitMcpClient()
+	s.Run("pods_restarts(all_namespaces=true)", func() {
+		toolResult, err := s.CallTool("pods_restarts", map[string]interface{}{"all_namespaces": true})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed %v", toolResult.Content)
+		})
+		text := toolResult.Content[0].(mcp.TextContent).Text
+		s.Run("returns headers", func() {
+			expectedHeaders := regexp.MustCompile(`(?m)^NAMESPACE\s+POD\s+POD RESTARTS\s+CONTAINER\s+RESTARTS\s+LAST TERMINATION\s+LAST TERMINATED\s*$`)
+			s.Regexpf(expectedHeaders, text, "expected headers not found in output:\n%s", text)
+		})
+		s.Run("returns pods sorted by restart count", func() {
+			expected := regexp.MustCompile(`(?s)` +
+				`\ndefault\s+flapping\s+12\s+container-0\s+7\s+OOMKilled \(exit code 137\)\s+5m ago\s*\n` +
+				`default\s+flapping\s+12\s+container-1\s+5\s+OOMKilled \(exit code 137\)\s+5m ago\s*\n` +
+				`default\s+crash-looping\s+3\s+container-0\s+3\s+OOMKilled \(exit code 137\)\s+5m ago\s*\n` +
+				`monitoring\s+restarted-once\s+1\s+container-0\s+1\s+OOMKilled \(exit code 137\)\s+5m ago\s*\n` +
+				`default\s+stable\s+0\s+container-0\s+0\s+<none>\s+<none>\s*\n$`)
+			s.Regexpf(expected, text, "expected sorted rows not found in output:\n%s", text)
+		})
+	})
+	s.Run("pods_restarts(namespace=default, all_namespaces=false, min_restarts=2)", func() {
+		toolResult, err := s.CallTool("pods_restarts", map[string]interface{}{"namespace": "default", "all_namespaces": false, "min_restarts": 2})
+		s.Require().Nilf(err, "call tool failed %v", err)
+		s.Require().Falsef(toolResult.IsError, "call tool failed %v", toolResult.Content)
+		text := toolResult.Content[0].(mcp.TextContent).Text
+		s.Contains(text, "flapping")
+		s.Contains(text, "crash-looping")
+		s.NotContains(text, "restarted-once")
+		s.NotContains(text, "stable")
+	})
+	s.Run("pods_restarts(min_restarts=100)", func() {
+		toolResult, err := s.CallTool("pods_restarts", map[string]interface{}{"min_restarts": 100})
+		s.Require().Nilf(err, "call tool failed %v", err)
+		s.Require().Falsef(toolResult.IsError, "call tool failed %v", toolResult.Content)
+		s.Equal("No pods found", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+}
+
+func (s *PodsSuite) TestPodsRestartsDenied() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		denied_resources = [ { version = "v1", kind = "Pod" } ]
+	`), s.Cfg), "Expected to parse denied resources config")
+	s.InitMcpClient()
+	s.Run("pods_restarts (denied)", func() {
+		toolResult, err := s.CallTool("pods_restarts", map[string]interface{}{})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes denial", func() {
+			msg := toolResult.Content[0].(mcp.TextContent).Text
+			expectedMessage := "failed to list pod restarts:(.+:)? resource not allowed: /v1, Kind=Pod"
+			s.Regexpf(expectedMessage, msg, "expected descriptive error '%s', got %v", expectedMessage, msg)
+		})
+	})
+}
+
 func (s *PodsSuite) TestPodsListWithLabelSelector() {
 	s.InitMcpClient()
 	kc := kubernetes.NewForConfigOrDie(envTestRestConfig)
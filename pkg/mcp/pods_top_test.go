@@ -1,14 +1,21 @@
 package mcp
 
 import (
+	"fmt"
 	"net/http"
 	"regexp"
+	"slices"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/BurntSushi/toml"
 	"github.com/containers/kubernetes-mcp-server/internal/test"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/stretchr/testify/suite"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -47,6 +54,44 @@ func (s *PodsTopSuite) TestPodsTopMetricsUnavailable() {
 	})
 }
 
+func (s *PodsTopSuite) TestPodsTopMetricsUnavailableBehaviorError() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		metrics_unavailable_behavior = "error"
+	`), s.Cfg), "Expected to parse metrics unavailable behavior config")
+	s.InitMcpClient()
+
+	s.Run("pods_top with metrics_unavailable_behavior=error", func() {
+		result, err := s.CallTool("pods_top", map[string]interface{}{})
+		s.Require().NoError(err)
+		s.True(result.IsError, "call tool should have returned an error")
+		s.Equalf("failed to get pods top: metrics API is not available", result.Content[0].(mcp.TextContent).Text,
+			"call tool returned unexpected content: %s", result.Content[0].(mcp.TextContent).Text)
+	})
+}
+
+func (s *PodsTopSuite) TestPodsTopMetricsUnavailableBehaviorEmpty() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		metrics_unavailable_behavior = "empty"
+	`), s.Cfg), "Expected to parse metrics unavailable behavior config")
+	s.InitMcpClient()
+
+	s.Run("pods_top with metrics_unavailable_behavior=empty", func() {
+		result, err := s.CallTool("pods_top", map[string]interface{}{})
+		s.Require().NoError(err)
+		textContent := result.Content[0].(mcp.TextContent).Text
+		s.Falsef(result.IsError, "call tool failed %v", textContent)
+		s.Run("returns explanatory note", func() {
+			s.True(strings.HasPrefix(textContent, "# metrics API is not available, no resource consumption data to display\n"),
+				"expected note not found in output:\n%s", textContent)
+		})
+		s.Run("returns zero-row table", func() {
+			expectedHeaders := regexp.MustCompile(`(?m)^NAMESPACE\s+POD\s+NAME\s+CPU\(cores\)\s+MEMORY\(bytes\)\s*$`)
+			s.Regexpf(expectedHeaders, textContent, "expected headers '%s' not found in output:\n%s", expectedHeaders.String(), textContent)
+			s.Lenf(strings.Split(strings.TrimSpace(textContent), "\n"), 2, "expected only note and headers in output:\n%s", textContent)
+		})
+	})
+}
+
 func (s *PodsTopSuite) TestPodsTopMetricsAvailable() {
 	s.discoveryHandler.AddAPIResourceList(metav1.APIResourceList{
 		GroupVersion: "metrics.k8s.io/v1beta1",
@@ -189,6 +234,142 @@ func (s *PodsTopSuite) TestPodsTopMetricsAvailable() {
 	})
 }
 
+func (s *PodsTopSuite) TestPodsTopDefaultAllNamespacesDisabled() {
+	s.discoveryHandler.AddAPIResourceList(metav1.APIResourceList{
+		GroupVersion: "metrics.k8s.io/v1beta1",
+		APIResources: []metav1.APIResource{
+			{Name: "pods", Kind: "PodMetrics", Namespaced: true, Verbs: metav1.Verbs{"get", "list"}},
+		},
+	})
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		// Pod Metrics from all namespaces
+		if req.URL.Path == "/apis/metrics.k8s.io/v1beta1/pods" {
+			_, _ = w.Write([]byte(`{"kind":"PodMetricsList","apiVersion":"metrics.k8s.io/v1beta1","items":[` +
+				`{"metadata":{"name":"pod-1","namespace":"default"},"containers":[{"name":"container-1","usage":{"cpu":"100m","memory":"200Mi"}}]},` +
+				`{"metadata":{"name":"pod-2","namespace":"ns-1"},"containers":[{"name":"container-1-ns-1","usage":{"cpu":"300m","memory":"400Mi"}}]}` +
+				`]}`))
+			return
+		}
+		// Pod Metrics from configured namespace
+		if req.URL.Path == "/apis/metrics.k8s.io/v1beta1/namespaces/default/pods" {
+			_, _ = w.Write([]byte(`{"kind":"PodMetricsList","apiVersion":"metrics.k8s.io/v1beta1","items":[` +
+				`{"metadata":{"name":"pod-1","namespace":"default"},"containers":[{"name":"container-1","usage":{"cpu":"10m","memory":"20Mi"}}]}` +
+				`]}`))
+		}
+	}))
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		default_all_namespaces = false
+	`), s.Cfg), "Expected to parse default all namespaces config")
+	s.InitMcpClient()
+
+	s.Run("pods_top schema defaults all_namespaces to false", func() {
+		tools, err := s.ListTools(s.T().Context(), mcp.ListToolsRequest{})
+		s.Require().NoError(err)
+		idx := slices.IndexFunc(tools.Tools, func(tool mcp.Tool) bool { return tool.Name == "pods_top" })
+		s.Require().GreaterOrEqual(idx, 0, "expected pods_top tool")
+		allNamespaces, ok := tools.Tools[idx].InputSchema.Properties["all_namespaces"].(map[string]any)
+		s.Require().True(ok, "expected all_namespaces property")
+		s.Equal(false, allNamespaces["default"], "expected all_namespaces to default to false")
+	})
+
+	s.Run("pods_top(defaults) returns pod metrics from configured namespace", func() {
+		result, err := s.CallTool("pods_top", map[string]interface{}{})
+		s.Require().NotNil(result)
+		s.NoErrorf(err, "call tool failed %v", err)
+		textContent := result.Content[0].(mcp.TextContent).Text
+		s.Falsef(result.IsError, "call tool failed %v", textContent)
+		s.Regexpf(`default\s+pod-1\s+container-1\s+10m\s+20Mi`, textContent, "expected row from configured namespace not found in output:\n%s", textContent)
+		s.NotContainsf(textContent, "pod-2", "unexpected pod from other namespace in output:\n%s", textContent)
+	})
+
+	s.Run("pods_top(allNamespaces=true) returns pod metrics from all namespaces", func() {
+		result, err := s.CallTool("pods_top", map[string]interface{}{
+			"all_namespaces": true,
+		})
+		s.Require().NotNil(result)
+		s.NoErrorf(err, "call tool failed %v", err)
+		textContent := result.Content[0].(mcp.TextContent).Text
+		s.Falsef(result.IsError, "call tool failed %v", textContent)
+		s.Regexpf(`default\s+pod-1\s+container-1\s+100m\s+200Mi`, textContent, "expected row '%s' not found in output:\n%s", "pod-1", textContent)
+		s.Regexpf(`ns-1\s+pod-2\s+container-1-ns-1\s+300m\s+400Mi`, textContent, "expected row '%s' not found in output:\n%s", "pod-2", textContent)
+	})
+}
+
+func (s *PodsTopSuite) TestPodsTopAllNamespacesConcurrency() {
+	const namespaceCount = 20
+	s.discoveryHandler.APIResourceLists[0].APIResources = append(s.discoveryHandler.APIResourceLists[0].APIResources,
+		metav1.APIResource{Name: "namespaces", Kind: "Namespace", Namespaced: false, Verbs: metav1.Verbs{"get", "list", "watch"}})
+	s.discoveryHandler.AddAPIResourceList(metav1.APIResourceList{
+		GroupVersion: "metrics.k8s.io/v1beta1",
+		APIResources: []metav1.APIResource{
+			{Name: "pods", Kind: "PodMetrics", Namespaced: true, Verbs: metav1.Verbs{"get", "list"}},
+		},
+	})
+	var inFlight, maxInFlight, clusterWideRequests atomic.Int32
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/api/v1/namespaces" {
+			namespaces := &corev1.NamespaceList{}
+			for i := 0; i < namespaceCount; i++ {
+				namespaces.Items = append(namespaces.Items, corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("ns-%d", i)}})
+			}
+			test.WriteObject(w, namespaces)
+			return
+		}
+		if req.URL.Path == "/apis/metrics.k8s.io/v1beta1/pods" {
+			clusterWideRequests.Add(1)
+			return
+		}
+		namespace, found := strings.CutPrefix(req.URL.Path, "/apis/metrics.k8s.io/v1beta1/namespaces/")
+		if !found || !strings.HasSuffix(namespace, "/pods") {
+			return
+		}
+		namespace = strings.TrimSuffix(namespace, "/pods")
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			previous := maxInFlight.Load()
+			if current <= previous || maxInFlight.CompareAndSwap(previous, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"kind":"PodMetricsList","apiVersion":"metrics.k8s.io/v1beta1","items":[` +
+			`{"metadata":{"name":"pod-` + namespace + `","namespace":"` + namespace + `"},"containers":[{"name":"container-1","usage":{"cpu":"10m","memory":"10Mi"}}]}` +
+			`]}`))
+	}))
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		pods_top_concurrency = 3
+	`), s.Cfg), "Expected to parse pods top concurrency config")
+	s.InitMcpClient()
+
+	s.Run("pods_top(all_namespaces=true) aggregates per-namespace pod metrics", func() {
+		result, err := s.CallTool("pods_top", map[string]interface{}{"all_namespaces": true})
+		s.Require().NotNil(result)
+		s.NoErrorf(err, "call tool failed %v", err)
+		textContent := result.Content[0].(mcp.TextContent).Text
+		s.Falsef(result.IsError, "call tool failed %v", textContent)
+		s.Run("returns a row for each namespace", func() {
+			for i := 0; i < namespaceCount; i++ {
+				row := fmt.Sprintf(`ns-%d\s+pod-ns-%d\s+container-1\s+10m\s+10Mi`, i, i)
+				s.Regexpf(row, textContent, "expected row '%s' not found in output:\n%s", row, textContent)
+			}
+		})
+		s.Run("returns the correct totals", func() {
+			expectedTotal := regexp.MustCompile(`(?m)^\s+200m\s+200Mi\s*$`)
+			s.Regexpf(expectedTotal, textContent, "expected total row '%s' not found in output:\n%s", expectedTotal.String(), textContent)
+		})
+		s.Run("bounds the number of concurrent requests", func() {
+			s.LessOrEqual(maxInFlight.Load(), int32(3))
+			s.Positive(maxInFlight.Load())
+		})
+		s.Run("does not perform a cluster-wide request", func() {
+			s.Zero(clusterWideRequests.Load())
+		})
+	})
+}
+
 func (s *PodsTopSuite) TestPodsTopDenied() {
 	s.Require().NoError(toml.Unmarshal([]byte(`
 		denied_resources = [ { group = "metrics.k8s.io", version = "v1beta1" } ]
@@ -218,6 +399,88 @@ func (s *PodsTopSuite) TestPodsTopDenied() {
 	})
 }
 
+func (s *PodsTopSuite) TestPodsUsageVsRequestsMetricsUnavailable() {
+	s.InitMcpClient()
+
+	s.Run("pods_usage_vs_requests with metrics API not available", func() {
+		result, err := s.CallTool("pods_usage_vs_requests", map[string]interface{}{})
+		s.Require().NoError(err)
+		s.True(result.IsError, "call tool should have returned an error")
+		s.Equalf("failed to get pods usage vs requests: metrics API is not available", result.Content[0].(mcp.TextContent).Text,
+			"call tool returned unexpected content: %s", result.Content[0].(mcp.TextContent).Text)
+	})
+}
+
+func (s *PodsTopSuite) TestPodsUsageVsRequests() {
+	s.discoveryHandler.AddAPIResourceList(metav1.APIResourceList{
+		GroupVersion: "metrics.k8s.io/v1beta1",
+		APIResources: []metav1.APIResource{
+			{Name: "pods", Kind: "PodMetrics", Namespaced: true, Verbs: metav1.Verbs{"get", "list"}},
+		},
+	})
+	pod := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod-1", Namespace: "default"},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{
+			{Name: "container-1", Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m"), corev1.ResourceMemory: resource.MustParse("128Mi")},
+				Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("200m"), corev1.ResourceMemory: resource.MustParse("256Mi")},
+			}},
+			{Name: "container-2"},
+		}},
+	}
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/api/v1/namespaces/default/pods":
+			test.WriteObject(w, &corev1.PodList{Items: []corev1.Pod{pod}})
+		case "/api/v1/namespaces/default/pods/pod-1":
+			test.WriteObject(w, &pod)
+		case "/apis/metrics.k8s.io/v1beta1/namespaces/default/pods":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"kind":"PodMetricsList","apiVersion":"metrics.k8s.io/v1beta1","items":[` +
+				`{"metadata":{"name":"pod-1","namespace":"default"},"containers":[{"name":"container-1","usage":{"cpu":"50m","memory":"64Mi"}},{"name":"container-2","usage":{"cpu":"300m","memory":"100Mi"}}]}` +
+				`]}`))
+		case "/apis/metrics.k8s.io/v1beta1/namespaces/default/pods/pod-1":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"kind":"PodMetrics","apiVersion":"metrics.k8s.io/v1beta1",` +
+				`"metadata":{"name":"pod-1","namespace":"default"},` +
+				`"containers":[{"name":"container-1","usage":{"cpu":"150m","memory":"192Mi"}},{"name":"container-2","usage":{"cpu":"300m","memory":"100Mi"}}]` +
+				`}`))
+		}
+	}))
+	s.InitMcpClient()
+
+	s.Run("pods_usage_vs_requests(defaults) returns usage vs requests for pods in configured namespace", func() {
+		result, err := s.CallTool("pods_usage_vs_requests", map[string]interface{}{})
+		s.Require().NotNil(result)
+		s.NoErrorf(err, "call tool failed %v", err)
+		textContent := result.Content[0].(mcp.TextContent).Text
+		s.Falsef(result.IsError, "call tool failed %v", textContent)
+
+		expectedHeaders := regexp.MustCompile(`(?m)^NAMESPACE\s+POD\s+NAME\s+CPU\(usage\)\s+CPU\(request\)\s+CPU\(limit\)\s+CPU\(%request\)\s+MEMORY\(usage\)\s+MEMORY\(request\)\s+MEMORY\(limit\)\s+MEMORY\(%request\)\s*$`)
+		s.Regexpf(expectedHeaders, textContent, "expected headers '%s' not found in output:\n%s", expectedHeaders.String(), textContent)
+		expectedRows := []string{
+			"default\\s+pod-1\\s+container-1\\s+50m\\s+100m\\s+200m\\s+50%\\s+64Mi\\s+128Mi\\s+256Mi\\s+50%",
+			"default\\s+pod-1\\s+container-2\\s+300m\\s+-\\s+-\\s+-\\s+100Mi\\s+-\\s+-\\s+-",
+		}
+		for _, row := range expectedRows {
+			s.Regexpf(row, textContent, "expected row '%s' not found in output:\n%s", row, textContent)
+		}
+	})
+
+	s.Run("pods_usage_vs_requests(name=pod-1) returns usage vs requests for the provided pod", func() {
+		result, err := s.CallTool("pods_usage_vs_requests", map[string]interface{}{
+			"name": "pod-1",
+		})
+		s.Require().NotNil(result)
+		s.NoErrorf(err, "call tool failed %v", err)
+		textContent := result.Content[0].(mcp.TextContent).Text
+		s.Falsef(result.IsError, "call tool failed %v", textContent)
+
+		expectedRow := "default\\s+pod-1\\s+container-1\\s+150m\\s+100m\\s+200m\\s+150%\\s+192Mi\\s+128Mi\\s+256Mi\\s+150%"
+		s.Regexpf(expectedRow, textContent, "expected row '%s' not found in output:\n%s", expectedRow, textContent)
+	})
+}
+
 func TestPodsTop(t *testing.T) {
 	suite.Run(t, new(PodsTopSuite))
 }
@@ -42,7 +42,11 @@ func ServerPromptToGoSdkPrompt(s *Server, serverPrompt api.ServerPrompt) (*mcp.P
 
 	// Create the handler that wraps the ServerPrompt handler
 	handler := func(ctx context.Context, request *mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
-		clusterParam := s.p.GetTargetParameterName()
+		if err := validatePromptArguments(serverPrompt.Prompt, (&promptCallRequestAdapter{request: request}).GetArguments()); err != nil {
+			return nil, err
+		}
+
+		clusterParam := s.GetTargetParameterName()
 		var cluster string
 		if request.Params != nil && request.Params.Arguments != nil {
 			if val, ok := request.Params.Arguments[clusterParam]; ok {
@@ -99,3 +103,13 @@ func ServerPromptToGoSdkPrompt(s *Server, serverPrompt api.ServerPrompt) (*mcp.P
 
 	return mcpPrompt, handler, nil
 }
+
+// validatePromptArguments verifies that all the required arguments of the prompt are provided
+func validatePromptArguments(prompt api.Prompt, args map[string]string) error {
+	for _, arg := range prompt.Arguments {
+		if _, ok := args[arg.Name]; arg.Required && !ok {
+			return fmt.Errorf("failed to get prompt %s: required argument '%s' is missing", prompt.Name, arg.Name)
+		}
+	}
+	return nil
+}
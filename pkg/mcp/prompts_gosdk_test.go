@@ -115,6 +115,38 @@ func TestServerPromptToGoSdkPrompt_Conversion(t *testing.T) {
 	assert.False(t, mcpPrompt.Arguments[1].Required)
 }
 
+func TestServerPromptToGoSdkPrompt_MissingRequiredArgument(t *testing.T) {
+	handlerCalled := false
+	serverPrompt := api.ServerPrompt{
+		Prompt: api.Prompt{
+			Name: "required-args-prompt",
+			Arguments: []api.PromptArgument{
+				{Name: "namespace", Required: true},
+				{Name: "pod_name", Required: false},
+			},
+		},
+		Handler: func(params api.PromptHandlerParams) (*api.PromptCallResult, error) {
+			handlerCalled = true
+			return api.NewPromptCallResult("Result", []api.PromptMessage{}, nil), nil
+		},
+	}
+
+	_, handler, err := ServerPromptToGoSdkPrompt(&Server{}, serverPrompt)
+	require.NoError(t, err)
+
+	result, err := handler(t.Context(), &mcp.GetPromptRequest{
+		Params: &mcp.GetPromptParams{
+			Name:      "required-args-prompt",
+			Arguments: map[string]string{"pod_name": "test-pod"},
+		},
+	})
+
+	require.Error(t, err)
+	assert.Equal(t, "failed to get prompt required-args-prompt: required argument 'namespace' is missing", err.Error())
+	assert.Nil(t, result)
+	assert.False(t, handlerCalled, "prompt handler should not be called when a required argument is missing")
+}
+
 func TestServerPromptToGoSdkPrompt_EmptyArguments(t *testing.T) {
 	serverPrompt := api.ServerPrompt{
 		Prompt: api.Prompt{
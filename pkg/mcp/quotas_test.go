@@ -0,0 +1,116 @@
+package mcp
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type QuotasSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+}
+
+func (s *QuotasSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	discoveryClientHandler := test.NewDiscoveryClientHandler()
+	discoveryClientHandler.APIResourceLists[0].APIResources = append(discoveryClientHandler.APIResourceLists[0].APIResources,
+		metav1.APIResource{Name: "resourcequotas", Kind: "ResourceQuota", Namespaced: true, Verbs: metav1.Verbs{"get", "list", "watch"}})
+	s.mockServer.Handle(discoveryClientHandler)
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/api/v1/namespaces/default/resourcequotas":
+			test.WriteObject(w, &corev1.ResourceQuotaList{Items: []corev1.ResourceQuota{{
+				ObjectMeta: metav1.ObjectMeta{Name: "compute", Namespace: "default"},
+				Status: corev1.ResourceQuotaStatus{
+					Hard: corev1.ResourceList{
+						corev1.ResourceLimitsCPU:    resource.MustParse("2"),
+						corev1.ResourceLimitsMemory: resource.MustParse("4Gi"),
+						corev1.ResourcePods:         resource.MustParse("10"),
+					},
+					Used: corev1.ResourceList{
+						corev1.ResourceLimitsCPU:    resource.MustParse("1900m"),
+						corev1.ResourceLimitsMemory: resource.MustParse("1Gi"),
+						corev1.ResourcePods:         resource.MustParse("10"),
+					},
+				},
+			}}})
+		case "/api/v1/namespaces/empty/resourcequotas":
+			test.WriteObject(w, &corev1.ResourceQuotaList{})
+		}
+	}))
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+}
+
+func (s *QuotasSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *QuotasSuite) TestQuotaUsage() {
+	s.InitMcpClient()
+	s.Run("quota_usage(namespace=default)", func() {
+		toolResult, err := s.CallTool("quota_usage", map[string]interface{}{"namespace": "default"})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		textContent := toolResult.Content[0].(mcp.TextContent).Text
+		s.Run("returns used and hard columns", func() {
+			expectedHeaders := regexp.MustCompile(`(?m)^NAMESPACE\s+QUOTA\s+RESOURCE\s+USED\s+HARD\s+USAGE\s+STATUS\s*$`)
+			s.Regexpf(expectedHeaders, textContent, "expected headers not found in output:\n%s", textContent)
+		})
+		s.Run("returns usage of each resource", func() {
+			expectedRows := []string{
+				`default\s+compute\s+limits.cpu\s+1900m\s+2\s+95%\s+NEAR LIMIT`,
+				`default\s+compute\s+limits.memory\s+1Gi\s+4Gi\s+25%\s+OK`,
+				`default\s+compute\s+pods\s+10\s+10\s+100%\s+AT LIMIT`,
+			}
+			for _, row := range expectedRows {
+				s.Regexpf(row, textContent, "expected row '%s' not found in output:\n%s", row, textContent)
+			}
+		})
+	})
+	s.Run("quota_usage(namespace=empty)", func() {
+		toolResult, err := s.CallTool("quota_usage", map[string]interface{}{"namespace": "empty"})
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		s.Equal("No ResourceQuotas found in the namespace", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+}
+
+func (s *QuotasSuite) TestQuotaUsageDenied() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		denied_resources = [ { version = "v1", kind = "ResourceQuota" } ]
+	`), s.Cfg), "Expected to parse denied resources config")
+	s.InitMcpClient()
+	s.Run("quota_usage (denied)", func() {
+		toolResult, err := s.CallTool("quota_usage", map[string]interface{}{"namespace": "default"})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes denial", func() {
+			msg := toolResult.Content[0].(mcp.TextContent).Text
+			s.Contains(msg, "resource not allowed:")
+			expectedMessage := "failed to get quota usage in namespace default:(.+:)? resource not allowed: /v1, Kind=ResourceQuota"
+			s.Regexpf(expectedMessage, msg,
+				"expected descriptive error '%s', got %v", expectedMessage, msg)
+		})
+	})
+}
+
+func TestQuotas(t *testing.T) {
+	suite.Run(t, new(QuotasSuite))
+}
@@ -0,0 +1,162 @@
+package mcp
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+type ResourcesEventsSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+}
+
+func (s *ResourcesEventsSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	discoveryClientHandler := test.NewDiscoveryClientHandler()
+	discoveryClientHandler.APIResourceLists[0].APIResources = append(discoveryClientHandler.APIResourceLists[0].APIResources,
+		metav1.APIResource{Name: "events", Kind: "Event", Namespaced: true, Verbs: metav1.Verbs{"get", "list", "watch"}})
+	s.mockServer.Handle(discoveryClientHandler)
+	now := time.Now()
+	event := func(name, reason, involvedObject string, uid types.UID, ago time.Duration) corev1.Event {
+		return corev1.Event{
+			TypeMeta:       metav1.TypeMeta{APIVersion: "v1", Kind: "Event"},
+			ObjectMeta:     metav1.ObjectMeta{Name: name, Namespace: "default"},
+			InvolvedObject: corev1.ObjectReference{APIVersion: "v1", Kind: "Pod", Name: involvedObject, Namespace: "default", UID: uid},
+			Type:           corev1.EventTypeNormal,
+			Reason:         reason,
+			Message:        "The " + reason + " message",
+			FirstTimestamp: metav1.NewTime(now.Add(-ago)),
+		}
+	}
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/api/v1/namespaces/default/pods/a-pod":
+			test.WriteObject(w, &corev1.Pod{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+				ObjectMeta: metav1.ObjectMeta{Name: "a-pod", Namespace: "default", UID: "a-pod-uid"},
+			})
+		case "/api/v1/namespaces/default/pods/a-pod-without-events":
+			test.WriteObject(w, &corev1.Pod{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+				ObjectMeta: metav1.ObjectMeta{Name: "a-pod-without-events", Namespace: "default", UID: "another-uid"},
+			})
+		case "/api/v1/namespaces/default/events":
+			// Events are intentionally returned out of chronological order and include events of other objects
+			test.WriteObject(w, &corev1.EventList{
+				TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "EventList"},
+				Items: []corev1.Event{
+					event("started", "Started", "a-pod", "a-pod-uid", 1*time.Minute),
+					event("scheduled", "Scheduled", "a-pod", "a-pod-uid", 3*time.Hour),
+					event("other-pod", "Killing", "another-pod", "another-pod-uid", 2*time.Hour),
+					event("previous-pod", "Evicted", "a-pod", "previous-a-pod-uid", 4*time.Hour),
+					event("pulled", "Pulled", "a-pod", "a-pod-uid", 30*time.Minute),
+				},
+			})
+		}
+	}))
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+}
+
+func (s *ResourcesEventsSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *ResourcesEventsSuite) TestResourcesEvents() {
+	s.InitMcpClient()
+	s.Run("resources_events with missing name returns error", func() {
+		toolResult, _ := s.CallTool("resources_events", map[string]interface{}{"apiVersion": "v1", "kind": "Pod"})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to get resource events, missing argument name", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("resources_events(apiVersion=v1, kind=Pod, name=a-pod)", func() {
+		toolResult, err := s.CallTool("resources_events", map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"namespace":  "default",
+			"name":       "a-pod",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		textContent := toolResult.Content[0].(mcp.TextContent).Text
+		s.Run("has yaml comment indicating output format", func() {
+			s.Truef(strings.HasPrefix(textContent, "# The following events (YAML format, oldest first) were found for Pod a-pod:\n"), "unexpected result %v", textContent)
+		})
+		s.Run("returns the events of the object in chronological order", func() {
+			scheduled := strings.Index(textContent, "Reason: Scheduled")
+			pulled := strings.Index(textContent, "Reason: Pulled")
+			started := strings.Index(textContent, "Reason: Started")
+			s.Truef(scheduled >= 0 && pulled >= 0 && started >= 0, "expected all the events of the object in output:\n%s", textContent)
+			s.Lessf(scheduled, pulled, "expected Scheduled before Pulled in output:\n%s", textContent)
+			s.Lessf(pulled, started, "expected Pulled before Started in output:\n%s", textContent)
+		})
+		s.Run("returns age and message", func() {
+			s.Contains(textContent, "Age: 3h")
+			s.Contains(textContent, "Message: The Scheduled message")
+		})
+		s.Run("does not return the events of other objects", func() {
+			s.NotContains(textContent, "Killing")
+		})
+		s.Run("does not return the events of previous objects with the same name", func() {
+			s.NotContains(textContent, "Evicted")
+		})
+	})
+	s.Run("resources_events(apiVersion=v1, kind=Pod, name=a-pod-without-events)", func() {
+		toolResult, err := s.CallTool("resources_events", map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"namespace":  "default",
+			"name":       "a-pod-without-events",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		s.Run("returns no events message", func() {
+			s.Equal("# No events found for Pod a-pod-without-events", toolResult.Content[0].(mcp.TextContent).Text)
+		})
+	})
+}
+
+func (s *ResourcesEventsSuite) TestResourcesEventsDenied() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		denied_resources = [ { version = "v1", kind = "Event" } ]
+	`), s.Cfg), "Expected to parse denied resources config")
+	s.InitMcpClient()
+	s.Run("resources_events (denied)", func() {
+		toolResult, err := s.CallTool("resources_events", map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"namespace":  "default",
+			"name":       "a-pod",
+		})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes denial", func() {
+			msg := toolResult.Content[0].(mcp.TextContent).Text
+			expectedMessage := "failed to get resource events:(.+:)? resource not allowed: /v1, Kind=Event"
+			s.Regexpf(expectedMessage, msg, "expected descriptive error '%s', got %v", expectedMessage, msg)
+		})
+	})
+}
+
+func TestResourcesEvents(t *testing.T) {
+	suite.Run(t, new(ResourcesEventsSuite))
+}
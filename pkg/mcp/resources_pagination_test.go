@@ -0,0 +1,201 @@
+package mcp
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type ResourcesPaginationSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+	// requestedLimits receives the limit of each of the list requests
+	requestedLimits chan string
+}
+
+func (s *ResourcesPaginationSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.requestedLimits = make(chan string, 10)
+	s.mockServer = test.NewMockServer()
+	s.mockServer.Handle(test.NewDiscoveryClientHandler())
+	// The API server paginates the pods of the namespace when a limit is requested,
+	// returning at most 2 items per page (the API server may return fewer items than the requested limit)
+	pods := []string{"pod-1", "pod-2", "pod-3"}
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/api/v1/namespaces/ns-1/pods" {
+			return
+		}
+		s.requestedLimits <- req.URL.Query().Get("limit")
+		offset, _ := strconv.Atoi(req.URL.Query().Get("continue"))
+		end := len(pods)
+		if limit, _ := strconv.Atoi(req.URL.Query().Get("limit")); limit > 0 {
+			end = min(end, offset+min(limit, 2))
+		}
+		next := ""
+		if end < len(pods) {
+			next = strconv.Itoa(end)
+		}
+		page := pods[offset:end]
+		if strings.Contains(req.Header.Get("Accept"), "as=Table") {
+			table := &metav1.Table{
+				TypeMeta:          metav1.TypeMeta{APIVersion: "meta.k8s.io/v1", Kind: "Table"},
+				ListMeta:          metav1.ListMeta{Continue: next},
+				ColumnDefinitions: []metav1.TableColumnDefinition{{Name: "Name", Type: "string"}},
+			}
+			for _, name := range page {
+				raw, _ := json.Marshal(metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns-1"}})
+				table.Rows = append(table.Rows, metav1.TableRow{Cells: []interface{}{name}, Object: runtime.RawExtension{Raw: raw}})
+			}
+			test.WriteObject(w, table)
+			return
+		}
+		list := &corev1.PodList{
+			TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "PodList"},
+			ListMeta: metav1.ListMeta{Continue: next},
+		}
+		for _, name := range page {
+			list.Items = append(list.Items, corev1.Pod{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns-1"},
+			})
+		}
+		test.WriteObject(w, list)
+	}))
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+}
+
+// limits returns the limits of the list requests performed so far.
+func (s *ResourcesPaginationSuite) limits() []string {
+	limits := make([]string, 0)
+	for len(s.requestedLimits) > 0 {
+		limits = append(limits, <-s.requestedLimits)
+	}
+	return limits
+}
+
+func (s *ResourcesPaginationSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *ResourcesPaginationSuite) TestMergesPages() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		list_output = "yaml"
+	`), s.Cfg), "Expected to parse list output config")
+	s.InitMcpClient()
+	toolResult, err := s.CallTool("pods_list_in_namespace", map[string]interface{}{"namespace": "ns-1"})
+	s.Run("no error", func() {
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+	})
+	s.Run("returns the items of all the pages", func() {
+		textContent := toolResult.Content[0].(mcp.TextContent).Text
+		for _, name := range []string{"pod-1", "pod-2", "pod-3"} {
+			s.Containsf(textContent, "name: "+name, "expected %s in the merged list, got %v", name, textContent)
+		}
+	})
+	s.Run("doesn't report the list as incomplete", func() {
+		s.NotContains(toolResult.Content[0].(mcp.TextContent).Text, "The list is incomplete")
+	})
+	s.Run("requests the pages with the page size", func() {
+		s.Equal([]string{"500", "500"}, s.limits())
+	})
+}
+
+func (s *ResourcesPaginationSuite) TestMergesPagesAsTable() {
+	s.InitMcpClient()
+	toolResult, err := s.CallTool("pods_list_in_namespace", map[string]interface{}{"namespace": "ns-1"})
+	s.Run("no error", func() {
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+	})
+	s.Run("returns the rows of all the pages", func() {
+		textContent := toolResult.Content[0].(mcp.TextContent).Text
+		for _, name := range []string{"pod-1", "pod-2", "pod-3"} {
+			s.Regexpf(`(?m)\bv1\s+Pod\s+`+name+`\b`, textContent, "expected %s row in the merged table, got %v", name, textContent)
+		}
+	})
+	s.Run("requests the pages with the page size", func() {
+		s.Equal([]string{"500", "500"}, s.limits())
+	})
+}
+
+func (s *ResourcesPaginationSuite) TestMaxListItems() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		list_output = "yaml"
+		max_list_items = 2
+	`), s.Cfg), "Expected to parse max list items config")
+	s.InitMcpClient()
+	toolResult, err := s.CallTool("pods_list_in_namespace", map[string]interface{}{"namespace": "ns-1"})
+	s.Run("no error", func() {
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+	})
+	s.Run("returns up to max_list_items items", func() {
+		textContent := toolResult.Content[0].(mcp.TextContent).Text
+		s.Contains(textContent, "name: pod-1")
+		s.Contains(textContent, "name: pod-2")
+		s.NotContains(textContent, "name: pod-3")
+	})
+	s.Run("reports the list as incomplete", func() {
+		s.Contains(toolResult.Content[0].(mcp.TextContent).Text,
+			"# The list is incomplete, only 2 items are shown (max_list_items is 2) and more items are available (continue token: 2).")
+	})
+	s.Run("requests a single page of max_list_items items", func() {
+		s.Equal([]string{"2"}, s.limits())
+	})
+}
+
+func (s *ResourcesPaginationSuite) TestMaxListItemsAcrossPages() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		list_output = "yaml"
+		max_list_items = 3
+	`), s.Cfg), "Expected to parse max list items config")
+	s.InitMcpClient()
+	toolResult, err := s.CallTool("pods_list_in_namespace", map[string]interface{}{"namespace": "ns-1"})
+	s.Run("no error", func() {
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+	})
+	s.Run("returns the items of all the pages", func() {
+		textContent := toolResult.Content[0].(mcp.TextContent).Text
+		for _, name := range []string{"pod-1", "pod-2", "pod-3"} {
+			s.Containsf(textContent, "name: "+name, "expected %s in the merged list, got %v", name, textContent)
+		}
+	})
+	s.Run("requests the next page bounded by the remaining items", func() {
+		s.Equal([]string{"3", "1"}, s.limits())
+	})
+}
+
+func (s *ResourcesPaginationSuite) TestMaxListItemsAsTable() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		max_list_items = 2
+	`), s.Cfg), "Expected to parse max list items config")
+	s.InitMcpClient()
+	toolResult, err := s.CallTool("pods_list_in_namespace", map[string]interface{}{"namespace": "ns-1"})
+	s.Run("no error", func() {
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+	})
+	s.Run("reports the table as incomplete", func() {
+		s.Contains(toolResult.Content[0].(mcp.TextContent).Text,
+			"# The list is incomplete, only 2 items are shown (max_list_items is 2) and more items are available (continue token: 2).")
+	})
+}
+
+func TestResourcesPagination(t *testing.T) {
+	suite.Run(t, new(ResourcesPaginationSuite))
+}
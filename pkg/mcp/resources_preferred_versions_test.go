@@ -0,0 +1,144 @@
+package mcp
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+type ResourcesPreferredVersionsSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+	// requestedPaths receives the path of each of the widget requests
+	requestedPaths chan string
+}
+
+func (s *ResourcesPreferredVersionsSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.requestedPaths = make(chan string, 10)
+	s.mockServer = test.NewMockServer()
+	// The example.com group is served in v1 (discovery-preferred) and v1beta1
+	widgets := []metav1.APIResource{{Name: "widgets", Kind: "Widget", Namespaced: true, Verbs: metav1.Verbs{"get", "list"}}}
+	discoveryHandler := test.NewDiscoveryClientHandler(
+		metav1.APIResourceList{GroupVersion: "example.com/v1", APIResources: widgets},
+		metav1.APIResourceList{GroupVersion: "example.com/v1beta1", APIResources: widgets},
+	)
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/apis" {
+			discoveryHandler.ServeHTTP(w, req)
+			return
+		}
+		// A single example.com group with both versions (the discovery handler lists each group version as a group)
+		test.WriteObject(w, &metav1.APIGroupList{Groups: []metav1.APIGroup{
+			{
+				Name:             "apps",
+				Versions:         []metav1.GroupVersionForDiscovery{{GroupVersion: "apps/v1", Version: "v1"}},
+				PreferredVersion: metav1.GroupVersionForDiscovery{GroupVersion: "apps/v1", Version: "v1"},
+			},
+			{
+				Name: "example.com",
+				Versions: []metav1.GroupVersionForDiscovery{
+					{GroupVersion: "example.com/v1", Version: "v1"},
+					{GroupVersion: "example.com/v1beta1", Version: "v1beta1"},
+				},
+				PreferredVersion: metav1.GroupVersionForDiscovery{GroupVersion: "example.com/v1", Version: "v1"},
+			},
+		}})
+	}))
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var version string
+		switch req.URL.Path {
+		case "/apis/example.com/v1/namespaces/ns-1/widgets/widget-1", "/apis/example.com/v1/namespaces/ns-1/widgets":
+			version = "v1"
+		case "/apis/example.com/v1beta1/namespaces/ns-1/widgets/widget-1", "/apis/example.com/v1beta1/namespaces/ns-1/widgets":
+			version = "v1beta1"
+		default:
+			return
+		}
+		s.requestedPaths <- req.URL.Path
+		widget := unstructured.Unstructured{}
+		widget.SetAPIVersion("example.com/" + version)
+		widget.SetKind("Widget")
+		widget.SetName("widget-1")
+		widget.SetNamespace("ns-1")
+		if req.URL.Path == "/apis/example.com/"+version+"/namespaces/ns-1/widgets" {
+			list := unstructured.UnstructuredList{Items: []unstructured.Unstructured{widget}}
+			list.SetAPIVersion("example.com/" + version)
+			list.SetKind("WidgetList")
+			test.WriteObject(w, &list)
+			return
+		}
+		test.WriteObject(w, &widget)
+	}))
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		list_output = "yaml"
+		[preferred_versions]
+		"example.com" = "v1beta1"
+	`), s.Cfg), "Expected to parse preferred versions config")
+}
+
+// paths returns the paths of the widget requests performed so far.
+func (s *ResourcesPreferredVersionsSuite) paths() []string {
+	paths := make([]string, 0)
+	for len(s.requestedPaths) > 0 {
+		paths = append(paths, <-s.requestedPaths)
+	}
+	return paths
+}
+
+func (s *ResourcesPreferredVersionsSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *ResourcesPreferredVersionsSuite) TestResourcesGet() {
+	s.InitMcpClient()
+	toolResult, err := s.CallTool("resources_get", map[string]interface{}{
+		"apiVersion": "example.com/v1",
+		"kind":       "Widget",
+		"namespace":  "ns-1",
+		"name":       "widget-1",
+	})
+	s.Run("no error", func() {
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+	})
+	s.Run("returns the resource in the pinned version", func() {
+		s.Contains(toolResult.Content[0].(mcp.TextContent).Text, "apiVersion: example.com/v1beta1")
+	})
+	s.Run("requests the pinned version instead of the requested one", func() {
+		s.Equal([]string{"/apis/example.com/v1beta1/namespaces/ns-1/widgets/widget-1"}, s.paths())
+	})
+}
+
+func (s *ResourcesPreferredVersionsSuite) TestResourcesList() {
+	s.InitMcpClient()
+	toolResult, err := s.CallTool("resources_list", map[string]interface{}{
+		"apiVersion": "example.com/v1",
+		"kind":       "Widget",
+		"namespace":  "ns-1",
+	})
+	s.Run("no error", func() {
+		s.Nilf(err, "call tool failed %v", err)
+		s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+	})
+	s.Run("returns the resources in the pinned version", func() {
+		s.Contains(toolResult.Content[0].(mcp.TextContent).Text, "apiVersion: example.com/v1beta1")
+	})
+	s.Run("requests the pinned version instead of the requested one", func() {
+		s.Equal([]string{"/apis/example.com/v1beta1/namespaces/ns-1/widgets"}, s.paths())
+	})
+}
+
+func TestResourcesPreferredVersions(t *testing.T) {
+	suite.Run(t, new(ResourcesPreferredVersionsSuite))
+}
@@ -2,6 +2,7 @@ package mcp
 
 import (
 	"regexp"
+	"slices"
 	"strings"
 	"testing"
 
@@ -12,6 +13,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/rbac/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/typed/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -273,6 +275,50 @@ func (s *ResourcesSuite) TestResourcesGet() {
 	})
 }
 
+func (s *ResourcesSuite) TestResourcesGetMaskedMetadataKeys() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		masked_metadata_keys = [ "kubectl.kubernetes.io/last-applied-configuration", "secret-label" ]
+	`), s.Cfg), "Expected to parse masked metadata keys config")
+	s.InitMcpClient()
+	kc := kubernetes.NewForConfigOrDie(envTestRestConfig)
+	_, _ = kc.CoreV1().ConfigMaps("default").Create(s.T().Context(), &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "a-configmap-with-masked-metadata",
+			Annotations: map[string]string{
+				"kubectl.kubernetes.io/last-applied-configuration": `{"apiVersion":"v1","kind":"ConfigMap","data":{"password":"s3cr3t"}}`,
+				"an-annotation": "visible",
+			},
+			Labels: map[string]string{"secret-label": "s3cr3t", "app": "visible"},
+		},
+	}, metav1.CreateOptions{})
+	s.Run("resources_get masks the configured annotations and labels", func() {
+		toolResult, err := s.CallTool("resources_get", map[string]interface{}{
+			"apiVersion": "v1", "kind": "ConfigMap", "namespace": "default", "name": "a-configmap-with-masked-metadata",
+		})
+		s.Require().Nilf(err, "call tool failed %v", err)
+		s.Require().Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		text := toolResult.Content[0].(mcp.TextContent).Text
+		var decoded unstructured.Unstructured
+		s.Require().NoError(yaml.Unmarshal([]byte(text), &decoded), "invalid tool result content")
+		s.Run("masks the last-applied-configuration annotation", func() {
+			s.Equal("[masked]", decoded.GetAnnotations()["kubectl.kubernetes.io/last-applied-configuration"])
+			s.NotContains(text, "s3cr3t")
+		})
+		s.Run("masks the configured label", func() {
+			s.Equal("[masked]", decoded.GetLabels()["secret-label"])
+		})
+		s.Run("keeps the other annotations and labels", func() {
+			s.Equal("visible", decoded.GetAnnotations()["an-annotation"])
+			s.Equal("visible", decoded.GetLabels()["app"])
+		})
+	})
+	s.Run("resources_get does not modify the resource in the cluster", func() {
+		cm, err := kc.CoreV1().ConfigMaps("default").Get(s.T().Context(), "a-configmap-with-masked-metadata", metav1.GetOptions{})
+		s.Require().NoError(err)
+		s.Equal("s3cr3t", cm.Labels["secret-label"])
+	})
+}
+
 func (s *ResourcesSuite) TestResourcesGetDenied() {
 	s.Require().NoError(toml.Unmarshal([]byte(`
 		denied_resources = [
@@ -323,6 +369,218 @@ func (s *ResourcesSuite) TestResourcesGetDenied() {
 	})
 }
 
+func (s *ResourcesSuite) TestResourcesFields() {
+	s.Cfg.ListOutput = "table"
+	s.InitMcpClient()
+	s.Run("resources_list(apiVersion=v1, kind=Namespace, fields=metadata.name,status.phase)", func() {
+		toolResult, err := s.CallTool("resources_list", map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Namespace",
+			"fields":     "metadata.name, .status.phase",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		var decoded []map[string]interface{}
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content (regardless of the list output)", func() {
+			s.Nilf(err, "invalid tool result content %v", err)
+			s.GreaterOrEqual(len(decoded), 3, "expected at least 3 namespaces")
+		})
+		s.Run("returns only the requested fields and the item apiVersion and kind", func() {
+			for _, ns := range decoded {
+				s.Len(ns, 4, "expected only apiVersion, kind, metadata and status, got %v", ns)
+				s.Equal("v1", ns["apiVersion"])
+				s.Equal("Namespace", ns["kind"])
+				s.Equal([]string{"name"}, mapKeys(ns["metadata"]), "expected only metadata.name, got %v", ns["metadata"])
+				s.Equal(map[string]interface{}{"phase": "Active"}, ns["status"])
+			}
+		})
+	})
+	s.Run("resources_get(apiVersion=v1, kind=Namespace, name=default, fields=metadata.name,metadata.labels,spec.nonexistent)", func() {
+		toolResult, err := s.CallTool("resources_get", map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Namespace",
+			"name":       "default",
+			"fields":     "metadata.name,metadata.labels,spec.nonexistent",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		var decoded map[string]interface{}
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "invalid tool result content %v", err)
+		})
+		s.Run("returns only the requested fields", func() {
+			s.Equal([]string{"metadata"}, mapKeys(decoded), "expected only metadata, got %v", decoded)
+			s.Equal([]string{"labels", "name"}, mapKeys(decoded["metadata"]), "expected only metadata.name and metadata.labels, got %v", decoded["metadata"])
+			s.Equal("default", decoded["metadata"].(map[string]interface{})["name"])
+		})
+	})
+}
+
+func mapKeys(m interface{}) []string {
+	keys := make([]string, 0)
+	if mm, ok := m.(map[string]interface{}); ok {
+		for k := range mm {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func (s *ResourcesSuite) TestResourcesYaml() {
+	s.InitMcpClient()
+	kc := kubernetes.NewForConfigOrDie(envTestRestConfig)
+	_, _ = kc.CoreV1().ConfigMaps("default").Create(s.T().Context(), &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "a-configmap-to-export"},
+		Data:       map[string]string{"key": "value"},
+	}, metav1.CreateOptions{})
+	s.Run("resources_yaml with missing name returns error", func() {
+		toolResult, _ := s.CallTool("resources_yaml", map[string]interface{}{"apiVersion": "v1", "kind": "ConfigMap"})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equalf("failed to get resource YAML, missing argument name", toolResult.Content[0].(mcp.TextContent).Text,
+			"invalid error message, got %v", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("resources_yaml returns resource without managed fields", func() {
+		toolResult, err := s.CallTool("resources_yaml", map[string]interface{}{
+			"apiVersion": "v1", "kind": "ConfigMap", "namespace": "default", "name": "a-configmap-to-export",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed")
+		})
+		text := toolResult.Content[0].(mcp.TextContent).Text
+		var decoded unstructured.Unstructured
+		err = yaml.Unmarshal([]byte(text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "invalid tool result content %v", err)
+		})
+		s.Run("returns the resource", func() {
+			s.Equal("a-configmap-to-export", decoded.GetName())
+			s.Equal(map[string]interface{}{"key": "value"}, decoded.Object["data"])
+		})
+		s.Run("managedFields are absent", func() {
+			s.NotContains(text, "managedFields")
+			s.Empty(decoded.GetManagedFields())
+		})
+	})
+	s.Run("resources_yaml(strip_status=true) returns resource without status", func() {
+		toolResult, err := s.CallTool("resources_yaml", map[string]interface{}{
+			"apiVersion": "v1", "kind": "Namespace", "name": "default", "strip_status": true,
+		})
+		s.Require().Nilf(err, "call tool failed %v", err)
+		s.Require().Falsef(toolResult.IsError, "call tool failed")
+		var decoded unstructured.Unstructured
+		s.Require().NoError(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded))
+		s.Equal("default", decoded.GetName())
+		s.NotContains(decoded.Object, "status")
+		s.Empty(decoded.GetManagedFields())
+	})
+	s.Run("resources_yaml(strip_status=false) keeps the status", func() {
+		toolResult, err := s.CallTool("resources_yaml", map[string]interface{}{
+			"apiVersion": "v1", "kind": "Namespace", "name": "default",
+		})
+		s.Require().Nilf(err, "call tool failed %v", err)
+		s.Require().Falsef(toolResult.IsError, "call tool failed")
+		var decoded unstructured.Unstructured
+		s.Require().NoError(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded))
+		s.Contains(decoded.Object, "status")
+	})
+}
+
+func (s *ResourcesSuite) TestResourcesYamlDenied() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		denied_resources = [ { version = "v1", kind = "Secret" } ]
+	`), s.Cfg), "Expected to parse denied resources config")
+	s.InitMcpClient()
+	s.Run("resources_yaml (denied)", func() {
+		toolResult, err := s.CallTool("resources_yaml", map[string]interface{}{"apiVersion": "v1", "kind": "Secret", "namespace": "default", "name": "denied-secret"})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes denial", func() {
+			msg := toolResult.Content[0].(mcp.TextContent).Text
+			expectedMessage := "failed to get resource YAML:(.+:)? resource not allowed: /v1, Kind=Secret"
+			s.Regexpf(expectedMessage, msg,
+				"expected descriptive error '%s', got %v", expectedMessage, msg)
+		})
+	})
+}
+
+func (s *ResourcesSuite) TestResourcesLastModified() {
+	s.InitMcpClient()
+	kc := kubernetes.NewForConfigOrDie(envTestRestConfig)
+	_, _ = kc.CoreV1().ConfigMaps("default").Create(s.T().Context(), &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "a-configmap-with-managers"},
+		Data:       map[string]string{"key": "value"},
+	}, metav1.CreateOptions{FieldManager: "a-test-creator"})
+	s.Run("resources_last_modified with missing name returns error", func() {
+		toolResult, _ := s.CallTool("resources_last_modified", map[string]interface{}{"apiVersion": "v1", "kind": "ConfigMap"})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to get resource field managers, missing argument name", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("resources_last_modified after apply", func() {
+		applyResult, err := s.CallTool("resources_create_or_update", map[string]interface{}{
+			"resource": "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: a-configmap-with-managers\n  namespace: default\n  labels:\n    drift: detected\n",
+		})
+		s.Require().Nilf(err, "call tool failed %v", err)
+		s.Require().Falsef(applyResult.IsError, "call tool failed: %v", applyResult.Content)
+		toolResult, err := s.CallTool("resources_last_modified", map[string]interface{}{
+			"apiVersion": "v1", "kind": "ConfigMap", "namespace": "default", "name": "a-configmap-with-managers",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		text := toolResult.Content[0].(mcp.TextContent).Text
+		s.Run("has header", func() {
+			s.True(strings.HasPrefix(text, "# The following field managers (YAML format, most recent first) modified ConfigMap a-configmap-with-managers:\n"))
+		})
+		var decoded []map[string]any
+		s.Require().NoError(yaml.Unmarshal([]byte(text), &decoded), "invalid tool result content")
+		managers := map[string]map[string]any{}
+		for _, m := range decoded {
+			managers[m["Manager"].(string)] = m
+		}
+		s.Run("returns the creator field manager", func() {
+			s.Require().Contains(managers, "a-test-creator")
+			s.Equal("Update", managers["a-test-creator"]["Operation"])
+			s.Contains(managers["a-test-creator"]["Fields"], "data.key")
+			s.NotEmpty(managers["a-test-creator"]["Time"])
+		})
+		s.Run("returns the apply field manager", func() {
+			s.Require().Contains(managers, "kubernetes-mcp-server")
+			s.Equal("Apply", managers["kubernetes-mcp-server"]["Operation"])
+			s.Equal([]any{"metadata.labels"}, managers["kubernetes-mcp-server"]["Fields"])
+		})
+	})
+}
+
+func (s *ResourcesSuite) TestResourcesLastModifiedDenied() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		denied_resources = [ { version = "v1", kind = "Secret" } ]
+	`), s.Cfg), "Expected to parse denied resources config")
+	s.InitMcpClient()
+	s.Run("resources_last_modified (denied)", func() {
+		toolResult, err := s.CallTool("resources_last_modified", map[string]interface{}{"apiVersion": "v1", "kind": "Secret", "namespace": "default", "name": "denied-secret"})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes denial", func() {
+			msg := toolResult.Content[0].(mcp.TextContent).Text
+			expectedMessage := "failed to get resource field managers:(.+:)? resource not allowed: /v1, Kind=Secret"
+			s.Regexpf(expectedMessage, msg, "expected descriptive error '%s', got %v", expectedMessage, msg)
+		})
+	})
+}
+
 func (s *ResourcesSuite) TestResourcesCreateOrUpdate() {
 	s.InitMcpClient()
 	client := kubernetes.NewForConfigOrDie(envTestRestConfig)
@@ -490,6 +748,86 @@ func (s *ResourcesSuite) TestResourcesCreateOrUpdateDenied() {
 	})
 }
 
+func (s *ResourcesSuite) TestResourcesValidate() {
+	s.InitMcpClient()
+	client := kubernetes.NewForConfigOrDie(envTestRestConfig)
+
+	s.Run("resources_validate with nil resource returns error", func() {
+		toolResult, _ := s.CallTool("resources_validate", map[string]interface{}{})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to validate resources, missing argument resource", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("resources_validate with valid resource", func() {
+		configMapYaml := "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: a-cm-to-validate\n  namespace: default\ndata:\n  key: value\n"
+		toolResult, err := s.CallTool("resources_validate", map[string]interface{}{"resource": configMapYaml})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		var decoded []map[string]any
+		s.Require().NoError(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded), "invalid tool result content")
+		s.Run("reports the resource as valid", func() {
+			s.Require().Len(decoded, 1)
+			s.Equal("a-cm-to-validate", decoded[0]["Name"])
+			s.Equal(true, decoded[0]["Valid"])
+			s.Nil(decoded[0]["Errors"])
+		})
+		s.Run("does not create the resource", func() {
+			_, err := client.CoreV1().ConfigMaps("default").Get(s.T().Context(), "a-cm-to-validate", metav1.GetOptions{})
+			s.Truef(apierrors.IsNotFound(err), "expected ConfigMap not to be created, got %v", err)
+		})
+	})
+	s.Run("resources_validate with invalid resources", func() {
+		manifest := "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: a-deployment-to-validate\n  namespace: default\nspec:\n  replicas: 1\n" +
+			"---\n" +
+			"apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: a-cm-with-unknown-field\n  namespace: default\nunknownField: value\n"
+		toolResult, err := s.CallTool("resources_validate", map[string]interface{}{"resource": manifest})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		var decoded []map[string]any
+		s.Require().NoError(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded), "invalid tool result content")
+		s.Require().Len(decoded, 2)
+		s.Run("reports missing required fields", func() {
+			s.Equal("a-deployment-to-validate", decoded[0]["Name"])
+			s.Equal(false, decoded[0]["Valid"])
+			s.Contains(decoded[0]["Errors"], "spec.selector: Required value")
+			s.Contains(decoded[0]["Errors"], "spec.template.spec.containers: Required value")
+		})
+		s.Run("reports unknown fields", func() {
+			s.Equal("a-cm-with-unknown-field", decoded[1]["Name"])
+			s.Equal(false, decoded[1]["Valid"])
+			s.Require().Len(decoded[1]["Errors"], 1)
+			s.Contains(decoded[1]["Errors"].([]any)[0], "unknownField")
+		})
+		s.Run("does not create the resources", func() {
+			_, err := client.AppsV1().Deployments("default").Get(s.T().Context(), "a-deployment-to-validate", metav1.GetOptions{})
+			s.Truef(apierrors.IsNotFound(err), "expected Deployment not to be created, got %v", err)
+		})
+	})
+}
+
+func (s *ResourcesSuite) TestResourcesValidateDenied() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		denied_resources = [ { version = "v1", kind = "Secret" } ]
+	`), s.Cfg), "Expected to parse denied resources config")
+	s.InitMcpClient()
+	s.Run("resources_validate (denied)", func() {
+		secretYaml := "apiVersion: v1\nkind: Secret\nmetadata:\n  name: a-denied-secret\n  namespace: default\n"
+		toolResult, err := s.CallTool("resources_validate", map[string]interface{}{"resource": secretYaml})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes denial", func() {
+			msg := toolResult.Content[0].(mcp.TextContent).Text
+			expectedMessage := "failed to validate resources:(.+:)? resource not allowed: /v1, Kind=Secret"
+			s.Regexpf(expectedMessage, msg, "expected descriptive error '%s', got %v", expectedMessage, msg)
+		})
+	})
+}
+
 func (s *ResourcesSuite) TestResourcesDelete() {
 	s.InitMcpClient()
 	client := kubernetes.NewForConfigOrDie(envTestRestConfig)
@@ -0,0 +1,134 @@
+package mcp
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/yaml"
+)
+
+type ResourcesWatchSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+	watchQuery string
+}
+
+func (s *ResourcesWatchSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.watchQuery = ""
+	s.mockServer = test.NewMockServer()
+	s.mockServer.Handle(test.NewDiscoveryClientHandler())
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/api/v1/namespaces/default/pods" || req.URL.Query().Get("watch") != "true" {
+			return
+		}
+		s.watchQuery = req.URL.RawQuery
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		encoder := json.NewEncoder(w)
+		for _, event := range []struct {
+			eventType watch.EventType
+			phase     corev1.PodPhase
+		}{
+			{watch.Added, corev1.PodPending},
+			{watch.Modified, corev1.PodRunning},
+			{watch.Deleted, corev1.PodSucceeded},
+		} {
+			_ = encoder.Encode(map[string]any{"type": event.eventType, "object": &corev1.Pod{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+				ObjectMeta: metav1.ObjectMeta{Name: "a-pod", Namespace: "default", ResourceVersion: string(event.phase)},
+				Status:     corev1.PodStatus{Phase: event.phase},
+			}})
+			w.(http.Flusher).Flush()
+		}
+	}))
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+}
+
+func (s *ResourcesWatchSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *ResourcesWatchSuite) TestResourcesWatch() {
+	s.InitMcpClient()
+	s.Run("resources_watch with missing apiVersion returns error", func() {
+		toolResult, _ := s.CallTool("resources_watch", map[string]interface{}{})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to watch resources, missing argument apiVersion", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("resources_watch(apiVersion=v1, kind=Pod, namespace=default, name=a-pod)", func() {
+		toolResult, err := s.CallTool("resources_watch", map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"namespace":  "default",
+			"name":       "a-pod",
+			"duration":   5,
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		s.Run("watches the named resource", func() {
+			s.Contains(s.watchQuery, "fieldSelector=metadata.name%3Da-pod")
+			s.Contains(s.watchQuery, "timeoutSeconds=5")
+		})
+		var events []map[string]any
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &events)
+		s.Run("returns the collected events", func() {
+			s.Require().NoErrorf(err, "invalid tool result content %v", err)
+			s.Require().Len(events, 3)
+			for i, expected := range []struct {
+				eventType string
+				phase     string
+			}{
+				{"ADDED", "Pending"},
+				{"MODIFIED", "Running"},
+				{"DELETED", "Succeeded"},
+			} {
+				s.Equal(expected.eventType, events[i]["type"])
+				s.Equal("a-pod", events[i]["name"])
+				s.Equal("default", events[i]["namespace"])
+				s.Equal(expected.phase, events[i]["status"].(map[string]any)["phase"])
+			}
+		})
+	})
+}
+
+func (s *ResourcesWatchSuite) TestResourcesWatchDenied() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		denied_resources = [ { version = "v1", kind = "Pod" } ]
+	`), s.Cfg), "Expected to parse denied resources config")
+	s.InitMcpClient()
+	s.Run("resources_watch (denied)", func() {
+		toolResult, err := s.CallTool("resources_watch", map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"namespace":  "default",
+		})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes denial", func() {
+			s.Contains(toolResult.Content[0].(mcp.TextContent).Text, "resource not allowed: /v1, Kind=Pod")
+		})
+		s.Run("does not open the watch", func() {
+			s.Empty(s.watchQuery)
+		})
+	})
+}
+
+func TestResourcesWatch(t *testing.T) {
+	suite.Run(t, new(ResourcesWatchSuite))
+}
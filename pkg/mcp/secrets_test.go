@@ -0,0 +1,190 @@
+package mcp
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type SecretsSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+}
+
+func (s *SecretsSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	discovery := test.NewDiscoveryClientHandler()
+	discovery.APIResourceLists[0].APIResources = append(discovery.APIResourceLists[0].APIResources,
+		metav1.APIResource{Name: "secrets", Kind: "Secret", Namespaced: true, Verbs: metav1.Verbs{"get", "list", "watch"}})
+	s.mockServer.Handle(discovery)
+	secrets := []corev1.Secret{
+		s.tlsSecret("default", "expiring-tls", "expiring.example.com", 10*24*time.Hour),
+		s.tlsSecret("monitoring", "valid-tls", "valid.example.com", 365*24*time.Hour),
+		s.tlsSecret("default", "expired-tls", "expired.example.com", -24*time.Hour),
+		{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+			ObjectMeta: metav1.ObjectMeta{Name: "broken-tls", Namespace: "default"},
+			Type:       corev1.SecretTypeTLS,
+			Data:       map[string][]byte{corev1.TLSCertKey: []byte("not a certificate"), corev1.TLSPrivateKeyKey: []byte("private-key-material")},
+		},
+		{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+			ObjectMeta: metav1.ObjectMeta{Name: "opaque", Namespace: "default"},
+			Type:       corev1.SecretTypeOpaque,
+			Data:       map[string][]byte{"password": []byte("private-key-material")},
+		},
+	}
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		var items []corev1.Secret
+		switch req.URL.Path {
+		case "/api/v1/secrets":
+			items = secrets
+		case "/api/v1/namespaces/default/secrets":
+			for _, secret := range secrets {
+				if secret.Namespace == "default" {
+					items = append(items, secret)
+				}
+			}
+		case "/api/v1/namespaces/monitoring/secrets/valid-tls":
+			test.WriteObject(w, &secrets[1])
+			return
+		default:
+			return
+		}
+		// The field selector is deliberately ignored, the tool must filter out the non-TLS Secrets by itself
+		test.WriteObject(w, &corev1.SecretList{
+			TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "SecretList"},
+			Items:    items,
+		})
+	}))
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+}
+
+func (s *SecretsSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+// tlsSecret returns a TLS Secret with a self-signed certificate for the provided DNS name expiring after the provided duration.
+func (s *SecretsSuite) tlsSecret(namespace, name, dnsName string, expiresIn time.Duration) corev1.Secret {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.Require().NoError(err, "failed to generate key")
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: dnsName, Organization: []string{"Example"}},
+		DNSNames:     []string{dnsName},
+		NotBefore:    time.Now().Add(-30 * 24 * time.Hour),
+		NotAfter:     time.Now().Add(expiresIn),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	s.Require().NoError(err, "failed to create certificate")
+	return corev1.Secret{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Type:       corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+			corev1.TLSPrivateKeyKey: []byte("private-key-material"),
+		},
+	}
+}
+
+func (s *SecretsSuite) TestSecretsCertExpiry() {
+	s.InitMcpClient()
+	s.Run("secrets_cert_expiry(all_namespaces=true)", func() {
+		toolResult, err := s.CallTool("secrets_cert_expiry", map[string]interface{}{"all_namespaces": true})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		textContent := toolResult.Content[0].(mcp.TextContent).Text
+		s.Run("returns the parsed certificates, the soonest to expire first", func() {
+			expected := regexp.MustCompile(`(?s)` +
+				`- DNSNames:\n  - expired.example.com\n  DaysRemaining: -[12]\n.+Issuer: CN=expired.example.com,O=Example\n  Name: expired-tls\n  Namespace: default\n.+Status: Expired\n.+` +
+				`- DNSNames:\n  - expiring.example.com\n  DaysRemaining: (9|10)\n.+Issuer: CN=expiring.example.com,O=Example\n  Name: expiring-tls\n.+Status: ExpiringSoon\n  Subject: CN=expiring.example.com,O=Example\n.+` +
+				`- DNSNames:\n  - valid.example.com\n  DaysRemaining: (364|365)\n.+Name: valid-tls\n  Namespace: monitoring\n.+Status: Valid\n.+` +
+				`- DaysRemaining: 0\n  Error: no PEM encoded certificate found in tls.crt\n.+Name: broken-tls\n.+Status: Invalid\n`)
+			s.Regexpf(expected, textContent, "expected certificates not found in output:\n%s", textContent)
+		})
+		s.Run("returns the expiry date", func() {
+			s.Regexpf(`NotAfter: "?\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}Z"?`, textContent, "expected expiry date not found in output:\n%s", textContent)
+		})
+		s.Run("ignores non-TLS Secrets", func() {
+			s.NotContains(textContent, "opaque")
+		})
+		s.Run("never returns private keys", func() {
+			s.NotContains(textContent, "private-key-material")
+			s.NotContains(textContent, "tls.key")
+		})
+	})
+	s.Run("secrets_cert_expiry(namespace=default, all_namespaces=false)", func() {
+		toolResult, err := s.CallTool("secrets_cert_expiry", map[string]interface{}{"namespace": "default", "all_namespaces": false})
+		s.Require().Nilf(err, "call tool failed %v", err)
+		s.Require().Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		textContent := toolResult.Content[0].(mcp.TextContent).Text
+		s.Contains(textContent, "expiring-tls")
+		s.NotContains(textContent, "valid-tls")
+	})
+	s.Run("secrets_cert_expiry(expiring_within_days=5)", func() {
+		toolResult, err := s.CallTool("secrets_cert_expiry", map[string]interface{}{"namespace": "default", "all_namespaces": false, "expiring_within_days": 5})
+		s.Require().Nilf(err, "call tool failed %v", err)
+		s.Require().Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		textContent := toolResult.Content[0].(mcp.TextContent).Text
+		s.Regexpf(`(?s)Name: expiring-tls\n.+?Status: Valid\n`, textContent, "expected expiring-tls to be valid in output:\n%s", textContent)
+	})
+	s.Run("secrets_cert_expiry(namespace=monitoring, name=valid-tls)", func() {
+		toolResult, err := s.CallTool("secrets_cert_expiry", map[string]interface{}{"namespace": "monitoring", "name": "valid-tls"})
+		s.Require().Nilf(err, "call tool failed %v", err)
+		s.Require().Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		textContent := toolResult.Content[0].(mcp.TextContent).Text
+		s.Contains(textContent, "Subject: CN=valid.example.com,O=Example")
+		s.NotContains(textContent, "expiring-tls")
+		s.NotContains(textContent, "private-key-material")
+	})
+}
+
+func (s *SecretsSuite) TestSecretsCertExpiryDenied() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		denied_resources = [ { version = "v1", kind = "Secret" } ]
+	`), s.Cfg), "Expected to parse denied resources config")
+	s.InitMcpClient()
+	for _, args := range []map[string]interface{}{
+		{"all_namespaces": true},
+		{"namespace": "monitoring", "name": "valid-tls"},
+	} {
+		s.Run("secrets_cert_expiry (denied)", func() {
+			toolResult, err := s.CallTool("secrets_cert_expiry", args)
+			s.Run("has error", func() {
+				s.Truef(toolResult.IsError, "call tool should fail")
+				s.Nilf(err, "call tool should not return error object")
+			})
+			s.Run("describes denial", func() {
+				msg := toolResult.Content[0].(mcp.TextContent).Text
+				expectedMessage := "failed to check certificate expiry:(.+:)? resource not allowed: /v1, Kind=Secret"
+				s.Regexpf(expectedMessage, msg, "expected descriptive error '%s', got %v", expectedMessage, msg)
+			})
+		})
+	}
+}
+
+func TestSecrets(t *testing.T) {
+	suite.Run(t, new(SecretsSuite))
+}
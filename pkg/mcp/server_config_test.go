@@ -0,0 +1,66 @@
+package mcp
+
+import (
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+)
+
+type ServerConfigSuite struct {
+	BaseMcpSuite
+}
+
+func (s *ServerConfigSuite) TestServerConfigNotAvailableByDefault() {
+	s.InitMcpClient()
+	tools, err := s.ListTools(s.T().Context(), mcp.ListToolsRequest{})
+	s.Require().NoError(err)
+	for _, tool := range tools.Tools {
+		s.NotEqual("server_config", tool.Name, "server_config should not be exposed unless expose_config is enabled")
+	}
+}
+
+func (s *ServerConfigSuite) TestServerConfig() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		expose_config = true
+		read_only = true
+		toolsets = [ "core", "config" ]
+		sts_client_id = "the-client-id"
+		sts_client_secret = "the-client-secret"
+	`), s.Cfg), "Expected to parse expose_config config")
+	s.InitMcpClient()
+	s.Run("server_config is available (read-only)", func() {
+		tools, err := s.ListTools(s.T().Context(), mcp.ListToolsRequest{})
+		s.Require().NoError(err)
+		var names []string
+		for _, tool := range tools.Tools {
+			names = append(names, tool.Name)
+		}
+		s.Contains(names, "server_config")
+	})
+	s.Run("server_config()", func() {
+		toolResult, err := s.CallTool("server_config", map[string]interface{}{})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		textContent := toolResult.Content[0].(mcp.TextContent).Text
+		s.Run("returns header", func() {
+			s.Regexp(`^# Effective server configuration \(TOML format, secrets redacted\):\n`, textContent)
+		})
+		s.Run("returns the effective options", func() {
+			s.Contains(textContent, "read_only = true")
+			s.Contains(textContent, `toolsets = ["core", "config"]`)
+			s.Contains(textContent, `sts_client_id = "the-client-id"`)
+		})
+		s.Run("redacts the STS client secret", func() {
+			s.Contains(textContent, `sts_client_secret = "[REDACTED]"`)
+			s.NotContains(textContent, "the-client-secret")
+		})
+	})
+}
+
+func TestServerConfig(t *testing.T) {
+	suite.Run(t, new(ServerConfigSuite))
+}
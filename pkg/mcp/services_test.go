@@ -0,0 +1,259 @@
+package mcp
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+)
+
+type ServicesSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+}
+
+func (s *ServicesSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	discoveryClientHandler := test.NewDiscoveryClientHandler(metav1.APIResourceList{
+		GroupVersion: "discovery.k8s.io/v1",
+		APIResources: []metav1.APIResource{
+			{Name: "endpointslices", Kind: "EndpointSlice", Namespaced: true, Verbs: metav1.Verbs{"get", "list", "watch"}},
+		},
+	})
+	discoveryClientHandler.APIResourceLists[0].APIResources = append(discoveryClientHandler.APIResourceLists[0].APIResources,
+		metav1.APIResource{Name: "services", Kind: "Service", Namespaced: true, Verbs: metav1.Verbs{"get", "list", "watch"}})
+	s.mockServer.Handle(discoveryClientHandler)
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/api/v1/namespaces/default/services/a-service":
+			test.WriteObject(w, &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "a-service", Namespace: "default"},
+				Spec: corev1.ServiceSpec{
+					Type:      corev1.ServiceTypeClusterIP,
+					ClusterIP: "10.96.0.10",
+					Selector:  map[string]string{"app": "a-app"},
+					Ports: []corev1.ServicePort{
+						{Name: "http", Protocol: corev1.ProtocolTCP, Port: 80, TargetPort: intstr.FromInt32(8080)},
+					},
+				},
+			})
+		case "/api/v1/namespaces/default/services/a-load-balancer":
+			test.WriteObject(w, &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "a-load-balancer", Namespace: "default"},
+				Spec: corev1.ServiceSpec{
+					Type:      corev1.ServiceTypeLoadBalancer,
+					ClusterIP: "10.96.0.11",
+					Ports:     []corev1.ServicePort{{Name: "https", Protocol: corev1.ProtocolTCP, Port: 443, NodePort: 30443}},
+				},
+				Status: corev1.ServiceStatus{LoadBalancer: corev1.LoadBalancerStatus{Ingress: []corev1.LoadBalancerIngress{
+					{IP: "203.0.113.10"},
+					{Hostname: "a-load-balancer.example.com"},
+				}}},
+			})
+		case "/api/v1/namespaces/default/services/a-pending-load-balancer":
+			test.WriteObject(w, &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "a-pending-load-balancer", Namespace: "default"},
+				Spec: corev1.ServiceSpec{
+					Type:      corev1.ServiceTypeLoadBalancer,
+					ClusterIP: "10.96.0.12",
+					Ports:     []corev1.ServicePort{{Name: "http", Protocol: corev1.ProtocolTCP, Port: 80, NodePort: 30081}},
+				},
+			})
+		case "/api/v1/namespaces/default/services/a-node-port":
+			test.WriteObject(w, &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "a-node-port", Namespace: "default"},
+				Spec: corev1.ServiceSpec{
+					Type:                  corev1.ServiceTypeNodePort,
+					ClusterIP:             "10.96.0.13",
+					ExternalTrafficPolicy: corev1.ServiceExternalTrafficPolicyLocal,
+					Ports: []corev1.ServicePort{
+						{Name: "http", Protocol: corev1.ProtocolTCP, Port: 80, NodePort: 30080},
+						{Name: "dns", Protocol: corev1.ProtocolUDP, Port: 53, NodePort: 30053},
+					},
+				},
+			})
+		case "/api/v1/nodes":
+			test.WriteObject(w, &corev1.NodeList{Items: []corev1.Node{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+					Status: corev1.NodeStatus{Addresses: []corev1.NodeAddress{
+						{Type: corev1.NodeInternalIP, Address: "10.0.0.1"},
+						{Type: corev1.NodeExternalIP, Address: "198.51.100.1"},
+					}},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "node-2"},
+					Status: corev1.NodeStatus{Addresses: []corev1.NodeAddress{
+						{Type: corev1.NodeHostName, Address: "node-2"},
+						{Type: corev1.NodeInternalIP, Address: "10.0.0.2"},
+					}},
+				},
+			}})
+		case "/apis/discovery.k8s.io/v1/namespaces/default/endpointslices":
+			if req.URL.Query().Get("labelSelector") != "kubernetes.io/service-name=a-service" {
+				test.WriteObject(w, &discoveryv1.EndpointSliceList{})
+				return
+			}
+			test.WriteObject(w, &discoveryv1.EndpointSliceList{Items: []discoveryv1.EndpointSlice{{
+				ObjectMeta:  metav1.ObjectMeta{Name: "a-service-abcde", Namespace: "default"},
+				AddressType: discoveryv1.AddressTypeIPv4,
+				Endpoints: []discoveryv1.Endpoint{
+					{
+						Addresses:  []string{"10.244.0.5"},
+						Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(true)},
+						TargetRef:  &corev1.ObjectReference{Kind: "Pod", Name: "a-pod-ready"},
+						NodeName:   ptr.To("a-node"),
+					},
+					{
+						Addresses:  []string{"10.244.0.6"},
+						Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(false)},
+						TargetRef:  &corev1.ObjectReference{Kind: "Pod", Name: "a-pod-not-ready"},
+					},
+				},
+			}}})
+		}
+	}))
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+}
+
+func (s *ServicesSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *ServicesSuite) TestServicesDescribe() {
+	s.InitMcpClient()
+	s.Run("services_describe(name=nil)", func() {
+		toolResult, err := s.CallTool("services_describe", map[string]interface{}{})
+		s.Require().Nil(err, "call tool should not return error object")
+		s.True(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to describe service, missing argument name", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("services_describe(name=a-service, namespace=default)", func() {
+		toolResult, err := s.CallTool("services_describe", map[string]interface{}{
+			"name":      "a-service",
+			"namespace": "default",
+		})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed %v", toolResult.Content)
+		})
+		var decoded map[string]any
+		err = yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded)
+		s.Run("has yaml content", func() {
+			s.Nilf(err, "invalid tool result content %v", err)
+		})
+		s.Run("returns service spec", func() {
+			s.Equal("ClusterIP", decoded["Type"])
+			s.Equal("10.96.0.10", decoded["ClusterIP"])
+			s.Equal(map[string]any{"app": "a-app"}, decoded["Selector"])
+			s.Equal([]any{map[string]any{"Name": "http", "Protocol": "TCP", "Port": float64(80), "TargetPort": "8080"}}, decoded["Ports"])
+		})
+		s.Run("resolves ready endpoints", func() {
+			s.Equal([]any{map[string]any{"Address": "10.244.0.5", "TargetRef": "Pod/a-pod-ready", "NodeName": "a-node"}},
+				decoded["Endpoints"].(map[string]any)["Ready"])
+		})
+		s.Run("resolves not ready endpoints", func() {
+			s.Equal([]any{map[string]any{"Address": "10.244.0.6", "TargetRef": "Pod/a-pod-not-ready"}},
+				decoded["Endpoints"].(map[string]any)["NotReady"])
+		})
+	})
+}
+
+func (s *ServicesSuite) TestServicesDescribeDenied() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		denied_resources = [ { group = "discovery.k8s.io", version = "v1", kind = "EndpointSlice" } ]
+	`), s.Cfg), "Expected to parse denied resources config")
+	s.InitMcpClient()
+	s.Run("services_describe (denied)", func() {
+		toolResult, err := s.CallTool("services_describe", map[string]interface{}{"name": "a-service", "namespace": "default"})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes denial", func() {
+			msg := toolResult.Content[0].(mcp.TextContent).Text
+			s.Contains(msg, "resource not allowed:")
+			s.Contains(msg, "failed to describe service a-service in namespace default:")
+		})
+	})
+}
+
+func (s *ServicesSuite) TestServicesEndpoint() {
+	s.InitMcpClient()
+	servicesEndpoint := func(name string) map[string]any {
+		toolResult, err := s.CallTool("services_endpoint", map[string]interface{}{"name": name, "namespace": "default"})
+		s.Require().Nilf(err, "call tool failed %v", err)
+		s.Require().Falsef(toolResult.IsError, "call tool failed %v", toolResult.Content)
+		var decoded map[string]any
+		s.Require().NoErrorf(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded), "invalid tool result content")
+		return decoded
+	}
+	s.Run("services_endpoint(name=nil)", func() {
+		toolResult, err := s.CallTool("services_endpoint", map[string]interface{}{})
+		s.Require().Nil(err, "call tool should not return error object")
+		s.True(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to get service endpoint, missing argument name", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("services_endpoint(name=a-load-balancer) returns the LoadBalancer ingress addresses", func() {
+		decoded := servicesEndpoint("a-load-balancer")
+		s.Equal("LoadBalancer", decoded["Type"])
+		s.Equal([]any{"203.0.113.10:443/TCP", "a-load-balancer.example.com:443/TCP"}, decoded["Addresses"])
+		s.Nil(decoded["Note"])
+	})
+	s.Run("services_endpoint(name=a-pending-load-balancer) returns the NodePort addresses", func() {
+		decoded := servicesEndpoint("a-pending-load-balancer")
+		s.Equal("LoadBalancer", decoded["Type"])
+		s.Equal([]any{"198.51.100.1:30081/TCP", "10.0.0.2:30081/TCP"}, decoded["Addresses"])
+		s.Equal("The LoadBalancer ingress isn't provisioned yet, the Service is reachable through the NodePorts in the meantime", decoded["Note"])
+	})
+	s.Run("services_endpoint(name=a-node-port) returns the node addresses with the NodePorts", func() {
+		decoded := servicesEndpoint("a-node-port")
+		s.Equal("NodePort", decoded["Type"])
+		s.Equal([]any{"198.51.100.1:30080/TCP", "198.51.100.1:30053/UDP", "10.0.0.2:30080/TCP", "10.0.0.2:30053/UDP"}, decoded["Addresses"])
+		s.Contains(decoded["Note"], "NodePort Services are reachable on the NodePorts of the cluster nodes")
+		s.Contains(decoded["Note"], "with externalTrafficPolicy Local only the nodes running a ready endpoint accept the traffic")
+	})
+	s.Run("services_endpoint(name=a-service) returns the ClusterIP with a note", func() {
+		decoded := servicesEndpoint("a-service")
+		s.Equal("ClusterIP", decoded["Type"])
+		s.Equal([]any{"10.96.0.10:80/TCP"}, decoded["Addresses"])
+		s.Contains(decoded["Note"], "ClusterIP Services are only reachable from within the cluster")
+		s.Contains(decoded["Note"], "kubectl port-forward service/a-service")
+	})
+}
+
+func (s *ServicesSuite) TestServicesEndpointDenied() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		denied_resources = [ { version = "v1", kind = "Service" } ]
+	`), s.Cfg), "Expected to parse denied resources config")
+	s.InitMcpClient()
+	s.Run("services_endpoint (denied)", func() {
+		toolResult, err := s.CallTool("services_endpoint", map[string]interface{}{"name": "a-service", "namespace": "default"})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes denial", func() {
+			msg := toolResult.Content[0].(mcp.TextContent).Text
+			expectedMessage := "failed to get endpoint of service a-service in namespace default:(.+:)? resource not allowed: /v1, Kind=Service"
+			s.Regexpf(expectedMessage, msg, "expected descriptive error '%s', got %v", expectedMessage, msg)
+		})
+	})
+}
+
+func TestServices(t *testing.T) {
+	suite.Run(t, new(ServicesSuite))
+}
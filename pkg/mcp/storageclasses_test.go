@@ -0,0 +1,146 @@
+package mcp
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+type StorageClassesSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+}
+
+func (s *StorageClassesSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.mockServer.Handle(test.NewDiscoveryClientHandler(metav1.APIResourceList{
+		GroupVersion: "storage.k8s.io/v1",
+		APIResources: []metav1.APIResource{
+			{Name: "storageclasses", Kind: "StorageClass", Namespaced: false, Verbs: metav1.Verbs{"get", "list", "watch"}},
+		},
+	}))
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/apis/storage.k8s.io/v1/storageclasses" {
+			return
+		}
+		storageClasses := []storagev1.StorageClass{
+			{
+				TypeMeta: metav1.TypeMeta{APIVersion: "storage.k8s.io/v1", Kind: "StorageClass"},
+				ObjectMeta: metav1.ObjectMeta{Name: "gp3", Labels: map[string]string{"tier": "standard"},
+					Annotations: map[string]string{"storageclass.kubernetes.io/is-default-class": "true"}},
+				Provisioner:          "ebs.csi.aws.com",
+				ReclaimPolicy:        ptr.To(corev1.PersistentVolumeReclaimDelete),
+				VolumeBindingMode:    ptr.To(storagev1.VolumeBindingWaitForFirstConsumer),
+				AllowVolumeExpansion: ptr.To(true),
+			},
+			{
+				TypeMeta:          metav1.TypeMeta{APIVersion: "storage.k8s.io/v1", Kind: "StorageClass"},
+				ObjectMeta:        metav1.ObjectMeta{Name: "io2-retain", Labels: map[string]string{"tier": "premium"}},
+				Provisioner:       "ebs.csi.aws.com",
+				ReclaimPolicy:     ptr.To(corev1.PersistentVolumeReclaimRetain),
+				VolumeBindingMode: ptr.To(storagev1.VolumeBindingImmediate),
+			},
+			{
+				TypeMeta:    metav1.TypeMeta{APIVersion: "storage.k8s.io/v1", Kind: "StorageClass"},
+				ObjectMeta:  metav1.ObjectMeta{Name: "nfs", Labels: map[string]string{"tier": "shared"}},
+				Provisioner: "nfs.csi.k8s.io",
+			},
+		}
+		items := storageClasses
+		if req.URL.Query().Get("labelSelector") == "tier=premium" {
+			items = storageClasses[1:2]
+		}
+		test.WriteObject(w, &storagev1.StorageClassList{
+			TypeMeta: metav1.TypeMeta{APIVersion: "storage.k8s.io/v1", Kind: "StorageClassList"},
+			Items:    items,
+		})
+	}))
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+}
+
+func (s *StorageClassesSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *StorageClassesSuite) TestStorageClassesList() {
+	s.InitMcpClient()
+	s.Run("storageclasses_list()", func() {
+		toolResult, err := s.CallTool("storageclasses_list", map[string]interface{}{})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		textContent := toolResult.Content[0].(mcp.TextContent).Text
+		s.Run("returns headers", func() {
+			expectedHeaders := regexp.MustCompile(`(?m)^NAME\s+PROVISIONER\s+RECLAIMPOLICY\s+VOLUMEBINDINGMODE\s+ALLOWVOLUMEEXPANSION\s+DEFAULT\s*$`)
+			s.Regexpf(expectedHeaders, textContent, "expected headers not found in output:\n%s", textContent)
+		})
+		s.Run("flags the default class", func() {
+			expectedRows := []string{
+				`(?m)^gp3\s+ebs.csi.aws.com\s+Delete\s+WaitForFirstConsumer\s+true\s+true\s*$`,
+				`(?m)^io2-retain\s+ebs.csi.aws.com\s+Retain\s+Immediate\s+false\s+false\s*$`,
+				`(?m)^nfs\s+nfs.csi.k8s.io\s+Delete\s+Immediate\s+false\s+false\s*$`,
+			}
+			for _, row := range expectedRows {
+				s.Regexpf(row, textContent, "expected row '%s' not found in output:\n%s", row, textContent)
+			}
+		})
+	})
+	s.Run("storageclasses_list(label_selector=tier=premium)", func() {
+		toolResult, err := s.CallTool("storageclasses_list", map[string]interface{}{"label_selector": "tier=premium"})
+		s.Require().Nilf(err, "call tool failed %v", err)
+		s.Require().Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		textContent := toolResult.Content[0].(mcp.TextContent).Text
+		s.Contains(textContent, "io2-retain")
+		s.NotContains(textContent, "gp3")
+	})
+	s.Run("storageclasses_list(provisioner=nfs.csi.k8s.io)", func() {
+		toolResult, err := s.CallTool("storageclasses_list", map[string]interface{}{"provisioner": "nfs.csi.k8s.io"})
+		s.Require().Nilf(err, "call tool failed %v", err)
+		s.Require().Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		textContent := toolResult.Content[0].(mcp.TextContent).Text
+		s.Contains(textContent, "nfs")
+		s.NotContains(textContent, "ebs.csi.aws.com")
+	})
+	s.Run("storageclasses_list(provisioner=unknown)", func() {
+		toolResult, err := s.CallTool("storageclasses_list", map[string]interface{}{"provisioner": "unknown.csi.k8s.io"})
+		s.Require().Nilf(err, "call tool failed %v", err)
+		s.Equal("No StorageClasses found", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+}
+
+func (s *StorageClassesSuite) TestStorageClassesListDenied() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		denied_resources = [ { group = "storage.k8s.io", version = "v1" } ]
+	`), s.Cfg), "Expected to parse denied resources config")
+	s.InitMcpClient()
+	s.Run("storageclasses_list (denied)", func() {
+		toolResult, err := s.CallTool("storageclasses_list", map[string]interface{}{})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes denial", func() {
+			msg := toolResult.Content[0].(mcp.TextContent).Text
+			expectedMessage := "failed to list storageclasses:(.+:)? resource not allowed: storage.k8s.io/v1, Kind=StorageClass"
+			s.Regexpf(expectedMessage, msg, "expected descriptive error '%s', got %v", expectedMessage, msg)
+		})
+	})
+}
+
+func TestStorageClasses(t *testing.T) {
+	suite.Run(t, new(StorageClassesSuite))
+}
@@ -2,6 +2,7 @@ package mcp
 
 import (
 	"fmt"
+	"maps"
 	"sort"
 
 	"github.com/containers/kubernetes-mcp-server/pkg/api"
@@ -10,10 +11,23 @@ import (
 
 type ToolMutator func(tool api.ServerTool) api.ServerTool
 
+func CompositeMutator(mutators ...ToolMutator) ToolMutator {
+	return func(tool api.ServerTool) api.ServerTool {
+		for _, m := range mutators {
+			tool = m(tool)
+		}
+
+		return tool
+	}
+}
+
+// maxTargetsInEnum is the default maximum number of targets listed as enum values of the target parameter
 const maxTargetsInEnum = 5 // TODO: test and validate that this is a reasonable cutoff
 
-// WithTargetParameter adds a target selection parameter to the tool's input schema if the tool is cluster-aware
-func WithTargetParameter(defaultCluster, targetParameterName string, targets []string) ToolMutator {
+// WithTargetParameter adds a target selection parameter to the tool's input schema if the tool is cluster-aware.
+// The targets are listed as enum values of the parameter unless there are more than maxEnumSize targets (or the
+// maxTargetsInEnum default if maxEnumSize is not positive), in which case a free-form string parameter is used instead.
+func WithTargetParameter(defaultCluster, targetParameterName string, targets []string, maxEnumSize int) ToolMutator {
 	return func(tool api.ServerTool) api.ServerTool {
 		if !tool.IsClusterAware() {
 			return tool
@@ -32,6 +46,7 @@ func WithTargetParameter(defaultCluster, targetParameterName string, targets []s
 				defaultCluster,
 				targetParameterName,
 				targets,
+				maxEnumSize,
 			)
 		}
 
@@ -39,7 +54,7 @@ func WithTargetParameter(defaultCluster, targetParameterName string, targets []s
 	}
 }
 
-func createTargetProperty(defaultCluster, targetName string, targets []string) *jsonschema.Schema {
+func createTargetProperty(defaultCluster, targetName string, targets []string, maxEnumSize int) *jsonschema.Schema {
 	baseSchema := &jsonschema.Schema{
 		Type: "string",
 		Description: fmt.Sprintf(
@@ -49,7 +64,10 @@ func createTargetProperty(defaultCluster, targetName string, targets []string) *
 		),
 	}
 
-	if len(targets) <= maxTargetsInEnum {
+	if maxEnumSize <= 0 {
+		maxEnumSize = maxTargetsInEnum
+	}
+	if len(targets) <= maxEnumSize {
 		// Sort clusters to ensure consistent enum ordering
 		sort.Strings(targets)
 
@@ -62,3 +80,40 @@ func createTargetProperty(defaultCluster, targetName string, targets []string) *
 
 	return baseSchema
 }
+
+// WithOpenShiftToolPrefix prepends the provided prefix to the name of the OpenShift-specific tools (e.g. projects_list)
+// so that their origin is clear to clients federating OpenShift and vanilla Kubernetes clusters.
+func WithOpenShiftToolPrefix(prefix string) ToolMutator {
+	return func(tool api.ServerTool) api.ServerTool {
+		if prefix == "" || !tool.IsOpenShiftOnly() {
+			return tool
+		}
+
+		tool.Tool.Name = prefix + tool.Tool.Name
+		return tool
+	}
+}
+
+// WithAllNamespacesDefault sets the configured default (default_all_namespaces) as the schema default of the
+// all_namespaces argument, so that clients filling in the schema defaults don't override the configuration.
+// Only the tools honoring the configured default are mutated, they declare the all_namespaces argument with a default.
+func WithAllNamespacesDefault(defaultAllNamespaces bool) ToolMutator {
+	return func(tool api.ServerTool) api.ServerTool {
+		if tool.Tool.InputSchema == nil {
+			return tool
+		}
+		property, ok := tool.Tool.InputSchema.Properties["all_namespaces"]
+		if !ok || property.Default == nil {
+			return tool
+		}
+
+		// The tool definitions might be shared, replace the schema instead of modifying it
+		allNamespaces := *property
+		allNamespaces.Default = api.ToRawMessage(defaultAllNamespaces)
+		inputSchema := *tool.Tool.InputSchema
+		inputSchema.Properties = maps.Clone(inputSchema.Properties)
+		inputSchema.Properties["all_namespaces"] = &allNamespaces
+		tool.Tool.InputSchema = &inputSchema
+		return tool
+	}
+}
@@ -135,7 +135,7 @@ func TestWithClusterParameter(t *testing.T) {
 			if tt.targetParameterName == "" {
 				tt.targetParameterName = "cluster"
 			}
-			mutator := WithTargetParameter(tt.defaultCluster, tt.targetParameterName, tt.clusters)
+			mutator := WithTargetParameter(tt.defaultCluster, tt.targetParameterName, tt.clusters, maxTargetsInEnum)
 			tool := tt.toolFactory(tt.toolName)
 			originalTool := tool // Keep reference to check if tool was unchanged
 
@@ -237,7 +237,7 @@ func TestCreateClusterProperty(t *testing.T) {
 				}
 			}
 
-			property := createTargetProperty(tt.defaultCluster, tt.targetName, tt.clusters)
+			property := createTargetProperty(tt.defaultCluster, tt.targetName, tt.clusters, maxTargetsInEnum)
 
 			assert.Equal(t, "string", property.Type)
 			assert.Contains(t, property.Description, tt.defaultCluster)
@@ -283,7 +283,7 @@ type TargetParameterToolMutatorSuite struct {
 }
 
 func (s *TargetParameterToolMutatorSuite) TestClusterAwareTool() {
-	tm := WithTargetParameter("default-cluster", "cluster", []string{"cluster-1", "cluster-2", "cluster-3"})
+	tm := WithTargetParameter("default-cluster", "cluster", []string{"cluster-1", "cluster-2", "cluster-3"}, maxTargetsInEnum)
 	tool := createTestTool("cluster-aware-tool")
 	// Tools are cluster-aware by default
 	tm(tool)
@@ -308,7 +308,7 @@ func (s *TargetParameterToolMutatorSuite) TestClusterAwareTool() {
 }
 
 func (s *TargetParameterToolMutatorSuite) TestClusterAwareToolSingleCluster() {
-	tm := WithTargetParameter("default", "cluster", []string{"only-cluster"})
+	tm := WithTargetParameter("default", "cluster", []string{"only-cluster"}, maxTargetsInEnum)
 	tool := createTestTool("cluster-aware-tool-single-cluster")
 	// Tools are cluster-aware by default
 	tm(tool)
@@ -318,7 +318,7 @@ func (s *TargetParameterToolMutatorSuite) TestClusterAwareToolSingleCluster() {
 }
 
 func (s *TargetParameterToolMutatorSuite) TestClusterAwareToolMultipleClusters() {
-	tm := WithTargetParameter("default", "cluster", []string{"cluster-1", "cluster-2", "cluster-3", "cluster-4", "cluster-5", "cluster-6"})
+	tm := WithTargetParameter("default", "cluster", []string{"cluster-1", "cluster-2", "cluster-3", "cluster-4", "cluster-5", "cluster-6"}, maxTargetsInEnum)
 	tool := createTestTool("cluster-aware-tool-multiple-clusters")
 	// Tools are cluster-aware by default
 	tm(tool)
@@ -333,7 +333,7 @@ func (s *TargetParameterToolMutatorSuite) TestClusterAwareToolMultipleClusters()
 }
 
 func (s *TargetParameterToolMutatorSuite) TestNonClusterAwareTool() {
-	tm := WithTargetParameter("default", "cluster", []string{"cluster-1", "cluster-2"})
+	tm := WithTargetParameter("default", "cluster", []string{"cluster-1", "cluster-2"}, maxTargetsInEnum)
 	tool := createTestTool("non-cluster-aware-tool")
 	tool.ClusterAware = ptr.To(false)
 	tm(tool)
@@ -345,3 +345,48 @@ func (s *TargetParameterToolMutatorSuite) TestNonClusterAwareTool() {
 func TestTargetParameterToolMutator(t *testing.T) {
 	suite.Run(t, new(TargetParameterToolMutatorSuite))
 }
+
+func TestWithOpenShiftToolPrefix(t *testing.T) {
+	openShiftTool := createTestTool("projects_list")
+	openShiftTool.OpenShiftOnly = ptr.To(true)
+	t.Run("prefixes OpenShift-specific tools", func(t *testing.T) {
+		assert.Equal(t, "openshift_projects_list", WithOpenShiftToolPrefix("openshift_")(openShiftTool).Tool.Name)
+	})
+	t.Run("does not prefix other tools", func(t *testing.T) {
+		assert.Equal(t, "pods_list", WithOpenShiftToolPrefix("openshift_")(createTestTool("pods_list")).Tool.Name)
+	})
+	t.Run("does not prefix without a prefix", func(t *testing.T) {
+		assert.Equal(t, "projects_list", WithOpenShiftToolPrefix("")(openShiftTool).Tool.Name)
+	})
+	t.Run("does not modify the original tool", func(t *testing.T) {
+		assert.Equal(t, "projects_list", openShiftTool.Tool.Name)
+	})
+}
+
+func TestWithAllNamespacesDefault(t *testing.T) {
+	allNamespacesTool := createTestTool("pods_top")
+	allNamespacesTool.Tool.InputSchema.Properties["all_namespaces"] = &jsonschema.Schema{Type: "boolean", Default: api.ToRawMessage(true)}
+	t.Run("sets the configured default", func(t *testing.T) {
+		mutated := WithAllNamespacesDefault(false)(allNamespacesTool)
+		assert.JSONEq(t, "false", string(mutated.Tool.InputSchema.Properties["all_namespaces"].Default))
+		assert.Equal(t, "boolean", mutated.Tool.InputSchema.Properties["all_namespaces"].Type)
+	})
+	t.Run("keeps the default if enabled", func(t *testing.T) {
+		mutated := WithAllNamespacesDefault(true)(allNamespacesTool)
+		assert.JSONEq(t, "true", string(mutated.Tool.InputSchema.Properties["all_namespaces"].Default))
+	})
+	t.Run("does not set a default for tools without a declared default", func(t *testing.T) {
+		helmList := createTestTool("helm_list")
+		helmList.Tool.InputSchema.Properties["all_namespaces"] = &jsonschema.Schema{Type: "boolean"}
+		mutated := WithAllNamespacesDefault(false)(helmList)
+		assert.Nil(t, mutated.Tool.InputSchema.Properties["all_namespaces"].Default)
+	})
+	t.Run("does not modify tools without all_namespaces", func(t *testing.T) {
+		mutated := WithAllNamespacesDefault(false)(createTestToolWithNilSchema("pods_list"))
+		assert.Nil(t, mutated.Tool.InputSchema)
+	})
+	t.Run("does not modify the original tool", func(t *testing.T) {
+		_ = WithAllNamespacesDefault(false)(allNamespacesTool)
+		assert.JSONEq(t, "true", string(allNamespacesTool.Tool.InputSchema.Properties["all_namespaces"].Default))
+	})
+}
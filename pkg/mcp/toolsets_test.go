@@ -17,9 +17,11 @@ import (
 	"github.com/containers/kubernetes-mcp-server/pkg/toolsets/helm"
 	"github.com/containers/kubernetes-mcp-server/pkg/toolsets/kiali"
 	"github.com/containers/kubernetes-mcp-server/pkg/toolsets/kubevirt"
+	"github.com/google/jsonschema-go/jsonschema"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/stretchr/testify/suite"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/utils/ptr"
 )
 
 const updateJsonEnvVar = "UPDATE_TOOLSETS_JSON"
@@ -108,6 +110,36 @@ func (s *ToolsetsSuite) TestDefaultToolsetsToolsInOpenShift() {
 	})
 }
 
+func (s *ToolsetsSuite) TestOpenShiftToolPrefix() {
+	s.Run("OpenShift toolsets with openshift_tool_prefix", func() {
+		s.Handle(test.NewInOpenShiftHandler())
+		s.Cfg.OpenShiftToolPrefix = "openshift_"
+		s.InitMcpClient()
+		tools, err := s.ListTools(s.T().Context(), mcp.ListToolsRequest{})
+		s.Require().NoError(err, "Expected no error from ListTools")
+		toolNames := make([]string, 0, len(tools.Tools))
+		for _, tool := range tools.Tools {
+			toolNames = append(toolNames, tool.Name)
+		}
+		s.Run("prefixes the OpenShift-specific tools", func() {
+			s.Contains(toolNames, "openshift_projects_list")
+			s.Contains(toolNames, "openshift_routes_list")
+			s.NotContains(toolNames, "projects_list")
+			s.NotContains(toolNames, "routes_list")
+		})
+		s.Run("does not prefix the other tools", func() {
+			s.Contains(toolNames, "namespaces_list")
+			s.Contains(toolNames, "ingresses_list")
+			s.Contains(toolNames, "pods_list")
+			for _, name := range toolNames {
+				if name != "openshift_projects_list" && name != "openshift_routes_list" {
+					s.NotContainsf(name, "openshift_", "Expected tool %s not to be prefixed", name)
+				}
+			}
+		})
+	})
+}
+
 func (s *ToolsetsSuite) TestDefaultToolsetsToolsInMultiCluster() {
 	if configuration.HasDefaultOverrides() {
 		s.T().Skip("Skipping test because default configuration overrides are present (this is a downstream fork)")
@@ -152,6 +184,48 @@ func (s *ToolsetsSuite) TestDefaultToolsetsToolsInMultiClusterEnum() {
 	})
 }
 
+func (s *ToolsetsSuite) TestMultiClusterMaxTargetEnumSize() {
+	kubeconfig := s.Kubeconfig()
+	for i := 0; i < 3; i++ {
+		// Add multiple fake contexts to force multi-cluster behavior (4 clusters)
+		kubeconfig.Contexts[strconv.Itoa(i)] = clientcmdapi.NewContext()
+	}
+	contextProperty := func() map[string]any {
+		s.InitMcpClient()
+		tools, err := s.ListTools(s.T().Context(), mcp.ListToolsRequest{})
+		s.Require().NoError(err, "Expected no error from ListTools")
+		for _, tool := range tools.Tools {
+			if tool.Name == "pods_list" {
+				property, ok := tool.InputSchema.Properties["context"].(map[string]any)
+				s.Require().True(ok, "Expected context property in pods_list tool")
+				return property
+			}
+		}
+		s.Fail("Expected pods_list tool")
+		return nil
+	}
+	s.Run("with max_target_enum_size above the number of contexts uses enum", func() {
+		s.Cfg.KubeConfig = test.KubeconfigFile(s.T(), kubeconfig)
+		s.Cfg.MaxTargetEnumSize = 4
+		property := contextProperty()
+		s.Equal("string", property["type"])
+		s.Len(property["enum"], 4)
+	})
+	s.Run("with max_target_enum_size below the number of contexts uses free-form string", func() {
+		s.Cfg.KubeConfig = test.KubeconfigFile(s.T(), kubeconfig)
+		s.Cfg.MaxTargetEnumSize = 3
+		property := contextProperty()
+		s.Equal("string", property["type"])
+		s.NotContains(property, "enum")
+	})
+	s.Run("without max_target_enum_size uses the default", func() {
+		s.Cfg.KubeConfig = test.KubeconfigFile(s.T(), kubeconfig)
+		s.Cfg.MaxTargetEnumSize = 0
+		property := contextProperty()
+		s.Len(property["enum"], 4)
+	})
+}
+
 func (s *ToolsetsSuite) TestGranularToolsetsTools() {
 	testCases := []api.Toolset{
 		&core.Toolset{},
@@ -178,6 +252,89 @@ func (s *ToolsetsSuite) TestGranularToolsetsTools() {
 	}
 }
 
+func (s *ToolsetsSuite) TestToolsetDependencies() {
+	s.Run("Toolset with dependencies", func() {
+		toolsets.Clear()
+		toolsets.Register(&config.Toolset{})
+		toolsets.Register(&dependentToolset{})
+		s.Cfg.Toolsets = []string{"dependent"}
+		s.InitMcpClient()
+		tools, err := s.ListTools(s.T().Context(), mcp.ListToolsRequest{})
+		s.Run("ListTools returns tools", func() {
+			s.NotNil(tools, "Expected tools from ListTools")
+			s.NoError(err, "Expected no error from ListTools")
+		})
+		toolNames := make([]string, 0, len(tools.Tools))
+		for _, tool := range tools.Tools {
+			toolNames = append(toolNames, tool.Name)
+		}
+		s.Run("ListTools returns tools from toolset", func() {
+			s.Contains(toolNames, "dependent_tool")
+		})
+		s.Run("ListTools returns tools from auto-enabled dependency", func() {
+			s.Contains(toolNames, "configuration_view")
+			s.Contains(toolNames, "configuration_contexts_list")
+		})
+	})
+	s.Run("Toolset with unknown dependencies", func() {
+		toolsets.Clear()
+		toolsets.Register(&dependentToolset{})
+		s.Cfg.Toolsets = []string{"dependent"}
+		s.InitMcpClient()
+		tools, err := s.ListTools(s.T().Context(), mcp.ListToolsRequest{})
+		s.Run("ListTools ignores unknown dependency", func() {
+			s.NoError(err, "Expected no error from ListTools")
+			s.Require().Len(tools.Tools, 1)
+			s.Equal("dependent_tool", tools.Tools[0].Name)
+		})
+	})
+}
+
+func (s *ToolsetsSuite) TestToolNameConflicts() {
+	s.Run("Tool name conflict with default policy", func() {
+		toolsets.Clear()
+		toolsets.Register(&config.Toolset{})
+		toolsets.Register(&conflictingToolset{})
+		s.Cfg.Toolsets = []string{"config", "conflicting"}
+		s.InitMcpClient()
+		tools, err := s.ListTools(s.T().Context(), mcp.ListToolsRequest{})
+		s.Run("ListTools returns tools", func() {
+			s.NotNil(tools, "Expected tools from ListTools")
+			s.NoError(err, "Expected no error from ListTools")
+		})
+		var configurationView []mcp.Tool
+		for _, tool := range tools.Tools {
+			if tool.Name == "configuration_view" {
+				configurationView = append(configurationView, tool)
+			}
+		}
+		s.Run("ListTools returns conflicting tool once", func() {
+			s.Require().Len(configurationView, 1)
+		})
+		s.Run("ListTools returns conflicting tool from last toolset", func() {
+			s.Equal("Tool provided by the conflicting toolset", configurationView[0].Description)
+		})
+		s.Run("Calling conflicting tool uses the last toolset handler", func() {
+			toolResult, err := s.CallTool("configuration_view", map[string]interface{}{})
+			s.Require().NoError(err, "Expected no error from CallTool")
+			s.Equal("conflicting", toolResult.Content[0].(mcp.TextContent).Text)
+		})
+	})
+	s.Run("Tool name conflict with error policy", func() {
+		toolsets.Clear()
+		toolsets.Register(&config.Toolset{})
+		toolsets.Register(&conflictingToolset{})
+		s.Cfg.Toolsets = []string{"config", "conflicting"}
+		s.Cfg.ToolConflictPolicy = api.ToolConflictPolicyError
+		mcpServer, err := NewServer(s.T().Context(), Configuration{StaticConfig: s.Cfg}, nil, nil)
+		s.Run("NewServer returns error", func() {
+			s.Require().Error(err, "Expected error creating MCP server")
+			s.Nil(mcpServer, "Expected no MCP server")
+			s.Equal("tool configuration_view is registered by multiple toolsets: config, conflicting", err.Error())
+		})
+	})
+}
+
 func (s *ToolsetsSuite) TestInputSchemaEdgeCases() {
 	//https://github.com/containers/kubernetes-mcp-server/issues/340
 	s.Run("InputSchema for no-arg tool is object with empty properties", func() {
@@ -202,7 +359,7 @@ func (s *ToolsetsSuite) TestInputSchemaEdgeCases() {
 
 func (s *ToolsetsSuite) InitMcpClient() {
 	var err error
-	s.mcpServer, err = NewServer(Configuration{StaticConfig: s.Cfg}, nil, nil)
+	s.mcpServer, err = NewServer(s.T().Context(), Configuration{StaticConfig: s.Cfg}, nil, nil)
 	s.Require().NoError(err, "Expected no error creating MCP server")
 	s.McpClient = test.NewMcpClient(s.T(), s.mcpServer.ServeHTTP())
 }
@@ -232,6 +389,62 @@ func (s *ToolsetsSuite) assertJsonSnapshot(snapshotFile string, actual any) {
 	)
 }
 
+type dependentToolset struct{}
+
+var _ api.Toolset = (*dependentToolset)(nil)
+
+func (t *dependentToolset) GetName() string { return "dependent" }
+
+func (t *dependentToolset) GetDescription() string {
+	return "Toolset that depends on the config toolset"
+}
+
+func (t *dependentToolset) GetTools(_ api.Openshift) []api.ServerTool {
+	return []api.ServerTool{{
+		Tool: api.Tool{
+			Name:        "dependent_tool",
+			Description: "Tool provided by the dependent toolset",
+			Annotations: api.ToolAnnotations{ReadOnlyHint: ptr.To(true)},
+			InputSchema: &jsonschema.Schema{Type: "object"},
+		},
+		Handler: func(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+			return api.NewToolCallResult("dependent", nil), nil
+		},
+	}}
+}
+
+func (t *dependentToolset) GetPrompts() []api.ServerPrompt { return nil }
+
+func (t *dependentToolset) GetDependencies() []string { return []string{"config"} }
+
+type conflictingToolset struct{}
+
+var _ api.Toolset = (*conflictingToolset)(nil)
+
+func (t *conflictingToolset) GetName() string { return "conflicting" }
+
+func (t *conflictingToolset) GetDescription() string {
+	return "Toolset that registers a tool with the same name as the config toolset"
+}
+
+func (t *conflictingToolset) GetTools(_ api.Openshift) []api.ServerTool {
+	return []api.ServerTool{{
+		Tool: api.Tool{
+			Name:        "configuration_view",
+			Description: "Tool provided by the conflicting toolset",
+			Annotations: api.ToolAnnotations{ReadOnlyHint: ptr.To(true)},
+			InputSchema: &jsonschema.Schema{Type: "object"},
+		},
+		Handler: func(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+			return api.NewToolCallResult("conflicting", nil), nil
+		},
+	}}
+}
+
+func (t *conflictingToolset) GetPrompts() []api.ServerPrompt { return nil }
+
+func (t *conflictingToolset) GetDependencies() []string { return nil }
+
 func TestToolsets(t *testing.T) {
 	suite.Run(t, new(ToolsetsSuite))
 }
@@ -0,0 +1,162 @@
+package mcp
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/containers/kubernetes-mcp-server/internal/test"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+type WebhooksSuite struct {
+	BaseMcpSuite
+	mockServer *test.MockServer
+}
+
+func (s *WebhooksSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	s.mockServer = test.NewMockServer()
+	s.mockServer.Handle(test.NewDiscoveryClientHandler(metav1.APIResourceList{
+		GroupVersion: "admissionregistration.k8s.io/v1",
+		APIResources: []metav1.APIResource{
+			{Name: "validatingwebhookconfigurations", Kind: "ValidatingWebhookConfiguration", Namespaced: false, Verbs: metav1.Verbs{"get", "list", "watch"}},
+			{Name: "mutatingwebhookconfigurations", Kind: "MutatingWebhookConfiguration", Namespaced: false, Verbs: metav1.Verbs{"get", "list", "watch"}},
+		},
+	}))
+	s.mockServer.Handle(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/apis/admissionregistration.k8s.io/v1/validatingwebhookconfigurations":
+			test.WriteObject(w, &admissionregistrationv1.ValidatingWebhookConfigurationList{
+				TypeMeta: metav1.TypeMeta{APIVersion: "admissionregistration.k8s.io/v1", Kind: "ValidatingWebhookConfigurationList"},
+				Items: []admissionregistrationv1.ValidatingWebhookConfiguration{{
+					TypeMeta:   metav1.TypeMeta{APIVersion: "admissionregistration.k8s.io/v1", Kind: "ValidatingWebhookConfiguration"},
+					ObjectMeta: metav1.ObjectMeta{Name: "policy-engine"},
+					Webhooks: []admissionregistrationv1.ValidatingWebhook{{
+						Name: "validate.policy-engine.example.com",
+						ClientConfig: admissionregistrationv1.WebhookClientConfig{
+							Service: &admissionregistrationv1.ServiceReference{Namespace: "policy-system", Name: "policy-webhook", Path: ptr.To("/validate")},
+						},
+						Rules: []admissionregistrationv1.RuleWithOperations{{
+							Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Create, admissionregistrationv1.Update},
+							Rule: admissionregistrationv1.Rule{
+								APIGroups: []string{"apps"}, APIVersions: []string{"v1"}, Resources: []string{"deployments"},
+							},
+						}},
+						FailurePolicy:     ptr.To(admissionregistrationv1.Fail),
+						SideEffects:       ptr.To(admissionregistrationv1.SideEffectClassNone),
+						TimeoutSeconds:    ptr.To(int32(5)),
+						NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"policy": "enforced"}},
+					}},
+				}},
+			})
+		case "/apis/admissionregistration.k8s.io/v1/mutatingwebhookconfigurations":
+			test.WriteObject(w, &admissionregistrationv1.MutatingWebhookConfigurationList{
+				TypeMeta: metav1.TypeMeta{APIVersion: "admissionregistration.k8s.io/v1", Kind: "MutatingWebhookConfigurationList"},
+				Items: []admissionregistrationv1.MutatingWebhookConfiguration{{
+					TypeMeta:   metav1.TypeMeta{APIVersion: "admissionregistration.k8s.io/v1", Kind: "MutatingWebhookConfiguration"},
+					ObjectMeta: metav1.ObjectMeta{Name: "sidecar-injector"},
+					Webhooks: []admissionregistrationv1.MutatingWebhook{{
+						Name: "inject.sidecar.example.com",
+						ClientConfig: admissionregistrationv1.WebhookClientConfig{
+							URL: ptr.To("https://injector.example.com/mutate"),
+						},
+						Rules: []admissionregistrationv1.RuleWithOperations{{
+							Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Create},
+							Rule: admissionregistrationv1.Rule{
+								APIGroups: []string{""}, APIVersions: []string{"v1"}, Resources: []string{"pods"},
+								Scope: ptr.To(admissionregistrationv1.NamespacedScope),
+							},
+						}},
+						FailurePolicy: ptr.To(admissionregistrationv1.Ignore),
+						SideEffects:   ptr.To(admissionregistrationv1.SideEffectClassNoneOnDryRun),
+					}},
+				}},
+			})
+		}
+	}))
+	s.Cfg.KubeConfig = s.mockServer.KubeconfigFile(s.T())
+}
+
+func (s *WebhooksSuite) TearDownTest() {
+	s.BaseMcpSuite.TearDownTest()
+	if s.mockServer != nil {
+		s.mockServer.Close()
+	}
+}
+
+func (s *WebhooksSuite) TestWebhooksList() {
+	s.InitMcpClient()
+	s.Run("webhooks_list()", func() {
+		toolResult, err := s.CallTool("webhooks_list", map[string]interface{}{})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		textContent := toolResult.Content[0].(mcp.TextContent).Text
+		s.Run("returns header", func() {
+			s.Truef(regexp.MustCompile(`^# The following admission webhooks \(YAML format\) were found:\n`).MatchString(textContent),
+				"expected header, got %v", textContent)
+		})
+		s.Run("returns the validating webhook", func() {
+			s.Contains(textContent, "Configuration: policy-engine")
+			s.Contains(textContent, "Name: validate.policy-engine.example.com")
+			s.Contains(textContent, "FailurePolicy: Fail")
+			s.Contains(textContent, "Target: Service policy-system/policy-webhook:443/validate")
+			s.Contains(textContent, "TimeoutSeconds: 5")
+			s.Contains(textContent, "NamespaceSelector: policy=enforced")
+			s.Contains(textContent, "operations=CREATE,UPDATE resources=deployments.apps/v1 scope=*")
+		})
+		s.Run("returns the mutating webhook", func() {
+			s.Contains(textContent, "Configuration: sidecar-injector")
+			s.Contains(textContent, "Name: inject.sidecar.example.com")
+			s.Contains(textContent, "FailurePolicy: Ignore")
+			s.Contains(textContent, "Target: URL https://injector.example.com/mutate")
+			s.Contains(textContent, "TimeoutSeconds: 10")
+			s.Contains(textContent, "operations=CREATE resources=pods/v1 scope=Namespaced")
+		})
+	})
+	s.Run("webhooks_list(type=Mutating)", func() {
+		toolResult, err := s.CallTool("webhooks_list", map[string]interface{}{"type": "Mutating"})
+		s.Require().Nilf(err, "call tool failed %v", err)
+		s.Require().Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		textContent := toolResult.Content[0].(mcp.TextContent).Text
+		s.Contains(textContent, "inject.sidecar.example.com")
+		s.NotContains(textContent, "validate.policy-engine.example.com")
+	})
+	s.Run("webhooks_list(type=invalid)", func() {
+		toolResult, err := s.CallTool("webhooks_list", map[string]interface{}{"type": "Invalid"})
+		s.Require().Nilf(err, "call tool failed %v", err)
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to list webhooks, invalid type Invalid, valid types are: Validating, Mutating",
+			toolResult.Content[0].(mcp.TextContent).Text)
+	})
+}
+
+func (s *WebhooksSuite) TestWebhooksListDenied() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		denied_resources = [ { group = "admissionregistration.k8s.io", version = "v1" } ]
+	`), s.Cfg), "Expected to parse denied resources config")
+	s.InitMcpClient()
+	s.Run("webhooks_list (denied)", func() {
+		toolResult, err := s.CallTool("webhooks_list", map[string]interface{}{})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes denial", func() {
+			msg := toolResult.Content[0].(mcp.TextContent).Text
+			expectedMessage := "failed to list webhooks:(.+:)? resource not allowed: admissionregistration.k8s.io/v1, Kind=ValidatingWebhookConfiguration"
+			s.Regexpf(expectedMessage, msg, "expected descriptive error '%s', got %v", expectedMessage, msg)
+		})
+	})
+}
+
+func TestWebhooks(t *testing.T) {
+	suite.Run(t, new(WebhooksSuite))
+}
@@ -0,0 +1,340 @@
+package mcp
+
+import (
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/suite"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+)
+
+type WorkloadsSuite struct {
+	BaseMcpSuite
+}
+
+func (s *WorkloadsSuite) SetupTest() {
+	s.BaseMcpSuite.SetupTest()
+	kc := kubernetes.NewForConfigOrDie(envTestRestConfig)
+	ctx := s.T().Context()
+	_, _ = kc.CoreV1().Namespaces().Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "workloads"}}, metav1.CreateOptions{})
+	labels := map[string]string{"app": "a-workload"}
+	_, _ = kc.AppsV1().Deployments("workloads").Create(ctx, &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "a-workload"},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app", Image: "nginx"}}},
+			},
+		},
+	}, metav1.CreateOptions{})
+	pods := []struct {
+		name   string
+		labels map[string]string
+		status corev1.PodStatus
+	}{
+		{name: "running-1", labels: labels, status: corev1.PodStatus{Phase: corev1.PodRunning, ContainerStatuses: []corev1.ContainerStatus{
+			{Name: "app", RestartCount: 1, State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+		}}},
+		{name: "running-2", labels: labels, status: corev1.PodStatus{Phase: corev1.PodRunning, ContainerStatuses: []corev1.ContainerStatus{
+			{Name: "app", RestartCount: 5, State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}}},
+		}}},
+		{name: "pending-1", labels: labels, status: corev1.PodStatus{Phase: corev1.PodPending}},
+		{name: "failed-1", labels: labels, status: corev1.PodStatus{Phase: corev1.PodFailed}},
+		{name: "not-managed", labels: map[string]string{"app": "another-workload"}, status: corev1.PodStatus{Phase: corev1.PodRunning}},
+	}
+	for _, p := range pods {
+		pod, err := kc.CoreV1().Pods("workloads").Create(ctx, &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: p.name, Labels: p.labels},
+			Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app", Image: "nginx"}}},
+		}, metav1.CreateOptions{})
+		if err != nil {
+			continue // Already created by a previous test
+		}
+		pod.Status = p.status
+		_, _ = kc.CoreV1().Pods("workloads").UpdateStatus(ctx, pod, metav1.UpdateOptions{})
+	}
+}
+
+func (s *WorkloadsSuite) TestWorkloadPodsSummary() {
+	s.InitMcpClient()
+	s.Run("workload_pods_summary with missing kind returns error", func() {
+		toolResult, _ := s.CallTool("workload_pods_summary", map[string]interface{}{"name": "a-workload"})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to get workload pods summary, missing argument kind", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("workload_pods_summary with unsupported kind returns error", func() {
+		toolResult, _ := s.CallTool("workload_pods_summary", map[string]interface{}{"kind": "CronJob", "name": "a-workload", "namespace": "workloads"})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to get pods summary for CronJob a-workload in namespace workloads: unsupported workload kind CronJob", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("workload_pods_summary(kind=Deployment, name=a-workload)", func() {
+		toolResult, err := s.CallTool("workload_pods_summary", map[string]interface{}{"kind": "Deployment", "name": "a-workload", "namespace": "workloads"})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		var decoded map[string]any
+		s.Require().NoError(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded), "invalid tool result content")
+		s.Run("returns the label selector", func() {
+			s.Equal("app=a-workload", decoded["Selector"])
+		})
+		s.Run("returns the number of managed pods", func() {
+			s.EqualValues(4, decoded["Pods"])
+		})
+		s.Run("returns the phase breakdown", func() {
+			s.Equal(map[string]any{"Running": float64(2), "Pending": float64(1), "Succeeded": float64(0), "Failed": float64(1)}, decoded["Phases"])
+		})
+		s.Run("returns the total number of restarts", func() {
+			s.EqualValues(6, decoded["Restarts"])
+		})
+		s.Run("returns the containers in CrashLoopBackOff", func() {
+			s.Equal([]any{"running-2/app"}, decoded["CrashLoopBackOff"])
+		})
+	})
+}
+
+func (s *WorkloadsSuite) TestWorkloadPodsSummaryDenied() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		denied_resources = [ { version = "v1", kind = "Pod" } ]
+	`), s.Cfg), "Expected to parse denied resources config")
+	s.InitMcpClient()
+	s.Run("workload_pods_summary (denied)", func() {
+		toolResult, err := s.CallTool("workload_pods_summary", map[string]interface{}{"kind": "Deployment", "name": "a-workload", "namespace": "workloads"})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes denial", func() {
+			msg := toolResult.Content[0].(mcp.TextContent).Text
+			expectedMessage := "failed to get pods summary for Deployment a-workload in namespace workloads:(.+:)? resource not allowed: /v1, Kind=Pod"
+			s.Regexpf(expectedMessage, msg, "expected descriptive error '%s', got %v", expectedMessage, msg)
+		})
+	})
+}
+
+// createConfiguredWorkload creates a Deployment referencing an existing ConfigMap, a missing ConfigMap, and a missing Secret
+func (s *WorkloadsSuite) createConfiguredWorkload() {
+	kc := kubernetes.NewForConfigOrDie(envTestRestConfig)
+	ctx := s.T().Context()
+	_, _ = kc.CoreV1().ConfigMaps("workloads").Create(ctx, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "existing-config"},
+		Data:       map[string]string{"present-key": "value"},
+	}, metav1.CreateOptions{})
+	labels := map[string]string{"app": "a-configured-workload"}
+	_, _ = kc.AppsV1().Deployments("workloads").Create(ctx, &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "a-configured-workload"},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name:  "app",
+						Image: "nginx",
+						EnvFrom: []corev1.EnvFromSource{
+							{ConfigMapRef: &corev1.ConfigMapEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "existing-config"}}},
+						},
+						Env: []corev1.EnvVar{
+							{Name: "ABSENT", ValueFrom: &corev1.EnvVarSource{ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{Name: "existing-config"}, Key: "absent-key",
+							}}},
+							{Name: "PASSWORD", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{Name: "missing-secret"}, Key: "password", Optional: ptr.To(true),
+							}}},
+						},
+					}},
+					Volumes: []corev1.Volume{
+						{Name: "config", VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "missing-config"},
+						}}},
+					},
+				},
+			},
+		},
+	}, metav1.CreateOptions{})
+}
+
+func (s *WorkloadsSuite) TestWorkloadConfigRefs() {
+	s.createConfiguredWorkload()
+	s.InitMcpClient()
+	s.Run("workload_config_refs with missing name returns error", func() {
+		toolResult, _ := s.CallTool("workload_config_refs", map[string]interface{}{})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to get workload config references, missing argument name", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("workload_config_refs(name=a-configured-workload)", func() {
+		toolResult, err := s.CallTool("workload_config_refs", map[string]interface{}{"name": "a-configured-workload", "namespace": "workloads"})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		var decoded []map[string]any
+		s.Require().NoError(yaml.Unmarshal([]byte(toolResult.Content[0].(mcp.TextContent).Text), &decoded), "invalid tool result content")
+		s.Require().Len(decoded, 3)
+		s.Run("returns the existing ConfigMap with its missing keys", func() {
+			s.Equal("ConfigMap", decoded[0]["Kind"])
+			s.Equal("existing-config", decoded[0]["Name"])
+			s.Equal(true, decoded[0]["Exists"])
+			s.Equal([]any{"absent-key"}, decoded[0]["MissingKeys"])
+			s.Equal([]any{"container app envFrom", "container app env ABSENT"}, decoded[0]["References"])
+		})
+		s.Run("flags the missing ConfigMap", func() {
+			s.Equal("ConfigMap", decoded[1]["Kind"])
+			s.Equal("missing-config", decoded[1]["Name"])
+			s.Equal(false, decoded[1]["Exists"])
+			s.Nil(decoded[1]["Optional"])
+			s.Equal([]any{"volume config"}, decoded[1]["References"])
+		})
+		s.Run("flags the missing optional Secret", func() {
+			s.Equal("Secret", decoded[2]["Kind"])
+			s.Equal("missing-secret", decoded[2]["Name"])
+			s.Equal(false, decoded[2]["Exists"])
+			s.Equal(true, decoded[2]["Optional"])
+		})
+	})
+}
+
+func (s *WorkloadsSuite) TestWorkloadConfigRefsDenied() {
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		denied_resources = [ { version = "v1", kind = "ConfigMap" } ]
+	`), s.Cfg), "Expected to parse denied resources config")
+	s.createConfiguredWorkload()
+	s.InitMcpClient()
+	s.Run("workload_config_refs (denied)", func() {
+		toolResult, err := s.CallTool("workload_config_refs", map[string]interface{}{"name": "a-configured-workload", "namespace": "workloads"})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes denial", func() {
+			msg := toolResult.Content[0].(mcp.TextContent).Text
+			expectedMessage := "failed to get config references for Deployment a-configured-workload in namespace workloads:(.+:)? resource not allowed: /v1, Kind=ConfigMap"
+			s.Regexpf(expectedMessage, msg, "expected descriptive error '%s', got %v", expectedMessage, msg)
+		})
+	})
+}
+
+// createOwnedPods creates a Deployment with a ReplicaSet controlling two Pods, an unrelated ReplicaSet with a Pod,
+// and a Pod without owner (no controllers run in envtest, the ownerReferences are set explicitly)
+func (s *WorkloadsSuite) createOwnedPods() {
+	kc := kubernetes.NewForConfigOrDie(envTestRestConfig)
+	ctx := s.T().Context()
+	_, _ = kc.CoreV1().Namespaces().Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "owners"}}, metav1.CreateOptions{})
+	podSpec := corev1.PodSpec{Containers: []corev1.Container{{Name: "app", Image: "nginx"}}}
+	controllerRef := func(obj metav1.Object, kind string) []metav1.OwnerReference {
+		return []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: kind, Name: obj.GetName(), UID: obj.GetUID(), Controller: ptr.To(true)}}
+	}
+	labels := map[string]string{"app": "web"}
+	deployment, err := kc.AppsV1().Deployments("owners").Create(ctx, &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "web"},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{ObjectMeta: metav1.ObjectMeta{Labels: labels}, Spec: podSpec},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return // Already created by a previous test
+	}
+	replicaSets := map[string]*appsv1.ReplicaSet{}
+	for name, owners := range map[string][]metav1.OwnerReference{"web-5d8f9c7b4": controllerRef(deployment, "Deployment"), "other-6b7c8d9e5": nil} {
+		replicaSets[name], err = kc.AppsV1().ReplicaSets("owners").Create(ctx, &appsv1.ReplicaSet{
+			ObjectMeta: metav1.ObjectMeta{Name: name, OwnerReferences: owners},
+			Spec: appsv1.ReplicaSetSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: labels},
+				Template: corev1.PodTemplateSpec{ObjectMeta: metav1.ObjectMeta{Labels: labels}, Spec: podSpec},
+			},
+		}, metav1.CreateOptions{})
+		s.Require().NoError(err)
+	}
+	pods := map[string][]metav1.OwnerReference{
+		"web-5d8f9c7b4-abcde":   controllerRef(replicaSets["web-5d8f9c7b4"], "ReplicaSet"),
+		"web-5d8f9c7b4-fghij":   controllerRef(replicaSets["web-5d8f9c7b4"], "ReplicaSet"),
+		"other-6b7c8d9e5-klmno": controllerRef(replicaSets["other-6b7c8d9e5"], "ReplicaSet"),
+		"standalone":            nil,
+	}
+	for name, owners := range pods {
+		pod, err := kc.CoreV1().Pods("owners").Create(ctx, &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels, OwnerReferences: owners},
+			Spec:       podSpec,
+		}, metav1.CreateOptions{})
+		s.Require().NoError(err)
+		pod.Status = corev1.PodStatus{Phase: corev1.PodRunning, ContainerStatuses: []corev1.ContainerStatus{
+			{Name: "app", Ready: true, RestartCount: 2, State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+		}}
+		_, _ = kc.CoreV1().Pods("owners").UpdateStatus(ctx, pod, metav1.UpdateOptions{})
+	}
+}
+
+func (s *WorkloadsSuite) TestPodsByOwner() {
+	s.createOwnedPods()
+	s.InitMcpClient()
+	s.Run("pods_by_owner with missing kind returns error", func() {
+		toolResult, _ := s.CallTool("pods_by_owner", map[string]interface{}{"name": "web"})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to list pods by owner, missing argument kind", toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("pods_by_owner with unsupported kind returns error", func() {
+		toolResult, _ := s.CallTool("pods_by_owner", map[string]interface{}{"kind": "CronJob", "name": "web", "namespace": "owners"})
+		s.Truef(toolResult.IsError, "call tool should fail")
+		s.Equal("failed to list pods for CronJob web in namespace owners: unsupported owner kind CronJob, supported kinds are: Deployment, ReplicaSet, StatefulSet, DaemonSet, Job",
+			toolResult.Content[0].(mcp.TextContent).Text)
+	})
+	s.Run("pods_by_owner(kind=Deployment, name=web)", func() {
+		toolResult, err := s.CallTool("pods_by_owner", map[string]interface{}{"kind": "Deployment", "name": "web", "namespace": "owners"})
+		s.Run("no error", func() {
+			s.Nilf(err, "call tool failed %v", err)
+			s.Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		})
+		textContent := toolResult.Content[0].(mcp.TextContent).Text
+		s.Run("returns headers", func() {
+			s.Regexp(`(?m)^NAME\s+OWNER\s+STATUS\s+READY\s+RESTARTS\s+NODE\s*$`, textContent)
+		})
+		s.Run("returns the pods of the ReplicaSets of the Deployment", func() {
+			s.Regexp(`(?m)^web-5d8f9c7b4-abcde\s+ReplicaSet/web-5d8f9c7b4\s+Running\s+1/1\s+2\s+<none>\s*$`, textContent)
+			s.Regexp(`(?m)^web-5d8f9c7b4-fghij\s+ReplicaSet/web-5d8f9c7b4\s+Running\s+1/1\s+2\s+<none>\s*$`, textContent)
+		})
+		s.Run("doesn't return the pods of other owners (even if matching the labels)", func() {
+			s.NotContains(textContent, "other-6b7c8d9e5-klmno")
+			s.NotContains(textContent, "standalone")
+		})
+	})
+	s.Run("pods_by_owner(kind=ReplicaSet, name=other-6b7c8d9e5)", func() {
+		toolResult, err := s.CallTool("pods_by_owner", map[string]interface{}{"kind": "ReplicaSet", "name": "other-6b7c8d9e5", "namespace": "owners"})
+		s.Require().Nilf(err, "call tool failed %v", err)
+		s.Require().Falsef(toolResult.IsError, "call tool failed: %v", toolResult.Content)
+		textContent := toolResult.Content[0].(mcp.TextContent).Text
+		s.Contains(textContent, "other-6b7c8d9e5-klmno")
+		s.NotContains(textContent, "web-5d8f9c7b4-abcde")
+	})
+}
+
+func (s *WorkloadsSuite) TestPodsByOwnerDenied() {
+	s.createOwnedPods()
+	s.Require().NoError(toml.Unmarshal([]byte(`
+		denied_resources = [ { version = "v1", kind = "Pod" } ]
+	`), s.Cfg), "Expected to parse denied resources config")
+	s.InitMcpClient()
+	s.Run("pods_by_owner (denied)", func() {
+		toolResult, err := s.CallTool("pods_by_owner", map[string]interface{}{"kind": "Deployment", "name": "web", "namespace": "owners"})
+		s.Run("has error", func() {
+			s.Truef(toolResult.IsError, "call tool should fail")
+			s.Nilf(err, "call tool should not return error object")
+		})
+		s.Run("describes denial", func() {
+			msg := toolResult.Content[0].(mcp.TextContent).Text
+			expectedMessage := "failed to list pods for Deployment web in namespace owners:(.+:)? resource not allowed: /v1, Kind=Pod"
+			s.Regexpf(expectedMessage, msg, "expected descriptive error '%s', got %v", expectedMessage, msg)
+		})
+	})
+}
+
+func TestWorkloads(t *testing.T) {
+	suite.Run(t, new(WorkloadsSuite))
+}
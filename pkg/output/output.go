@@ -2,6 +2,8 @@ package output
 
 import (
 	"bytes"
+	"fmt"
+	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -30,6 +32,57 @@ var Outputs = []Output{
 
 var Names []string
 
+// MaskedValue is the value printed instead of the value of the masked annotations and labels.
+const MaskedValue = "[masked]"
+
+// MaskMetadata replaces the values of the provided annotation and label keys (e.g. kubectl.kubernetes.io/last-applied-configuration)
+// with MaskedValue in the provided object, in each of the items of a list, or in each of the rows of a Table.
+// The provided object is modified in place and returned.
+func MaskMetadata(obj runtime.Unstructured, keys []string) runtime.Unstructured {
+	if len(keys) == 0 || obj == nil {
+		return obj
+	}
+	switch t := obj.(type) {
+	case *unstructured.UnstructuredList:
+		for i := range t.Items {
+			maskMetadata(t.Items[i].Object, keys)
+		}
+	default:
+		content := obj.UnstructuredContent()
+		if obj.GetObjectKind().GroupVersionKind() != metav1.SchemeGroupVersion.WithKind("Table") {
+			maskMetadata(content, keys)
+			break
+		}
+		rows, _, _ := unstructured.NestedSlice(content, "rows")
+		for _, row := range rows {
+			if rowObject, ok := row.(map[string]interface{})["object"].(map[string]interface{}); ok {
+				maskMetadata(rowObject, keys)
+			}
+		}
+		_ = unstructured.SetNestedSlice(content, rows, "rows")
+	}
+	return obj
+}
+
+func maskMetadata(obj map[string]interface{}, keys []string) {
+	for _, field := range []string{"annotations", "labels"} {
+		values, found, err := unstructured.NestedStringMap(obj, "metadata", field)
+		if !found || err != nil {
+			continue
+		}
+		masked := false
+		for _, key := range keys {
+			if _, ok := values[key]; ok {
+				values[key] = MaskedValue
+				masked = true
+			}
+		}
+		if masked {
+			_ = unstructured.SetNestedStringMap(obj, values, "metadata", field)
+		}
+	}
+}
+
 func FromString(name string) Output {
 	for _, output := range Outputs {
 		if output.GetName() == name {
@@ -51,6 +104,110 @@ func (p *yaml) PrintObj(obj runtime.Unstructured) (string, error) {
 	return MarshalYaml(obj)
 }
 
+// WithMaskedMetadataKeys returns an Output that replaces the values of the provided annotation and label keys
+// with MaskedValue in the printed objects (see MaskMetadata).
+// The provided Output is returned unchanged if no keys are provided.
+func WithMaskedMetadataKeys(o Output, keys []string) Output {
+	if o == nil || len(keys) == 0 {
+		return o
+	}
+	return &maskedOutput{Output: o, keys: keys}
+}
+
+type maskedOutput struct {
+	Output
+	keys []string
+}
+
+func (p *maskedOutput) PrintObj(obj runtime.Unstructured) (string, error) {
+	return p.Output.PrintObj(MaskMetadata(obj, p.keys))
+}
+
+// WithListSummaryThreshold returns an Output that prints a compact summary (item count, names, and namespaces)
+// instead of the full YAML of the lists with more than threshold items.
+// The provided Output is returned unchanged if it's not the YAML output or if threshold is not positive.
+func WithListSummaryThreshold(o Output, threshold int) Output {
+	if o != Yaml || threshold <= 0 {
+		return o
+	}
+	return &summaryYaml{yaml: Yaml, threshold: threshold}
+}
+
+type summaryYaml struct {
+	*yaml
+	threshold int
+}
+
+func (p *summaryYaml) PrintObj(obj runtime.Unstructured) (string, error) {
+	list, ok := obj.(*unstructured.UnstructuredList)
+	if !ok || len(list.Items) <= p.threshold {
+		return p.yaml.PrintObj(obj)
+	}
+	items := make([]map[string]string, 0, len(list.Items))
+	for _, item := range list.Items {
+		summary := map[string]string{"name": item.GetName()}
+		if item.GetNamespace() != "" {
+			summary["namespace"] = item.GetNamespace()
+		}
+		if item.GetKind() != "" {
+			summary["kind"] = item.GetKind()
+		}
+		items = append(items, summary)
+	}
+	ret, err := MarshalYaml(map[string]any{"count": len(list.Items), "items": items})
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("# The list contains %d items (more than %d), only a summary of the items is shown.\n"+
+		"# Get the individual items by name (and namespace) to retrieve their full details, or narrow the list (e.g. with a namespace or label selector).\n%s",
+		len(list.Items), p.threshold, ret), nil
+}
+
+// WithTruncationNotice returns an Output that prepends a notice (see TruncationNotice) to the printed lists that were
+// truncated at maxListItems items.
+func WithTruncationNotice(o Output, maxListItems int) Output {
+	if o == nil {
+		return o
+	}
+	return &truncationNoticeOutput{Output: o, maxListItems: maxListItems}
+}
+
+type truncationNoticeOutput struct {
+	Output
+	maxListItems int
+}
+
+func (p *truncationNoticeOutput) PrintObj(obj runtime.Unstructured) (string, error) {
+	notice := TruncationNotice(obj, p.maxListItems)
+	ret, err := p.Output.PrintObj(obj)
+	if err != nil {
+		return ret, err
+	}
+	return notice + ret, nil
+}
+
+// TruncationNotice returns a notice with the number of items and the continue token of the remaining items if the
+// provided list (or Table) is incomplete (the retrieval stopped at maxListItems items), or an empty string otherwise.
+func TruncationNotice(obj runtime.Unstructured, maxListItems int) string {
+	if obj == nil {
+		return ""
+	}
+	continueToken, _, _ := unstructured.NestedString(obj.UnstructuredContent(), "metadata", "continue")
+	if continueToken == "" {
+		return ""
+	}
+	items := 0
+	if list, ok := obj.(*unstructured.UnstructuredList); ok {
+		items = len(list.Items)
+	} else {
+		rows, _, _ := unstructured.NestedSlice(obj.UnstructuredContent(), "rows")
+		items = len(rows)
+	}
+	return fmt.Sprintf("# The list is incomplete, only %d items are shown (max_list_items is %d) and more items are available (continue token: %s).\n"+
+		"# Narrow the list (e.g. with a namespace or label selector) to retrieve the remaining items.\n",
+		items, maxListItems, continueToken)
+}
+
 type table struct{}
 
 func (p *table) GetName() string {
@@ -73,12 +230,9 @@ func (p *table) PrintObj(obj runtime.Unstructured) (string, error) {
 					continue
 				}
 				row.Object.Object, err = runtime.Decode(unstructured.UnstructuredJSONScheme, row.Object.Raw)
-				// Print namespace if at least one row has it (object is namespaced)
-				if err == nil && !withNamespace {
-					switch rowObject := row.Object.Object.(type) {
-					case *unstructured.Unstructured:
-						withNamespace = rowObject.GetNamespace() != ""
-					}
+				if rowObject, ok := row.Object.Object.(*unstructured.Unstructured); err == nil && ok {
+					// Print namespace if at least one row has it (object is namespaced)
+					withNamespace = withNamespace || rowObject.GetNamespace() != ""
 				}
 			}
 		}
@@ -119,6 +273,51 @@ func MarshalYaml(v any) (string, error) {
 	return string(ret), nil
 }
 
+// ParseFields parses a comma-separated list of dot-separated field paths (e.g. "metadata.name,status.phase").
+// A leading dot (JSONPath style, e.g. ".metadata.name") is accepted and ignored.
+func ParseFields(fields string) [][]string {
+	ret := make([][]string, 0)
+	for _, field := range strings.Split(fields, ",") {
+		field = strings.TrimPrefix(strings.TrimSpace(field), ".")
+		if field == "" {
+			continue
+		}
+		ret = append(ret, strings.Split(field, "."))
+	}
+	return ret
+}
+
+// ProjectFields returns a copy of the provided object (or list of objects) containing only the provided field paths.
+// Fields that are not present in an object are omitted.
+// For lists, the apiVersion and kind of the list and of each of its items are always kept so that the items can be identified.
+func ProjectFields(obj runtime.Unstructured, fields [][]string) runtime.Unstructured {
+	if list, ok := obj.(*unstructured.UnstructuredList); ok {
+		ret := &unstructured.UnstructuredList{Object: map[string]interface{}{}, Items: make([]unstructured.Unstructured, 0, len(list.Items))}
+		ret.SetAPIVersion(list.GetAPIVersion())
+		ret.SetKind(list.GetKind())
+		for _, item := range list.Items {
+			projected := projectFields(&item, fields)
+			projected.SetAPIVersion(item.GetAPIVersion())
+			projected.SetKind(item.GetKind())
+			ret.Items = append(ret.Items, *projected)
+		}
+		return ret
+	}
+	return projectFields(&unstructured.Unstructured{Object: obj.UnstructuredContent()}, fields)
+}
+
+func projectFields(obj *unstructured.Unstructured, fields [][]string) *unstructured.Unstructured {
+	ret := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	for _, field := range fields {
+		value, found, err := unstructured.NestedFieldCopy(obj.Object, field...)
+		if !found || err != nil {
+			continue
+		}
+		_ = unstructured.SetNestedField(ret.Object, value, field...)
+	}
+	return ret
+}
+
 func init() {
 	Names = make([]string, 0)
 	for _, output := range Outputs {
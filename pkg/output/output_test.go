@@ -2,8 +2,10 @@ package output
 
 import (
 	"encoding/json"
+	"fmt"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"regexp"
+	"strings"
 	"testing"
 )
 
@@ -30,3 +32,199 @@ func TestPlainTextUnstructuredList(t *testing.T) {
 		}
 	})
 }
+
+func TestWithListSummaryThreshold(t *testing.T) {
+	podList := func(count int) *unstructured.UnstructuredList {
+		list := &unstructured.UnstructuredList{}
+		list.SetAPIVersion("v1")
+		list.SetKind("PodList")
+		for i := 0; i < count; i++ {
+			pod := unstructured.Unstructured{}
+			pod.SetAPIVersion("v1")
+			pod.SetKind("Pod")
+			pod.SetName(fmt.Sprintf("pod-%d", i))
+			pod.SetNamespace("default")
+			_ = unstructured.SetNestedField(pod.Object, "marcnuri/chuck-norris", "spec", "image")
+			list.Items = append(list.Items, pod)
+		}
+		return list
+	}
+	summaryOutput := WithListSummaryThreshold(Yaml, 3)
+	t.Run("returns the provided output if threshold is not positive", func(t *testing.T) {
+		if o := WithListSummaryThreshold(Yaml, 0); o != Yaml {
+			t.Errorf("Expected Yaml output, got %v", o)
+		}
+	})
+	t.Run("returns the provided output if it's not yaml", func(t *testing.T) {
+		if o := WithListSummaryThreshold(Table, 3); o != Table {
+			t.Errorf("Expected Table output, got %v", o)
+		}
+	})
+	t.Run("keeps the yaml output name", func(t *testing.T) {
+		if summaryOutput.GetName() != "yaml" || summaryOutput.AsTable() {
+			t.Errorf("Expected yaml output, got %s (as table %v)", summaryOutput.GetName(), summaryOutput.AsTable())
+		}
+	})
+	t.Run("prints full yaml for lists below the threshold", func(t *testing.T) {
+		out, err := summaryOutput.PrintObj(podList(3))
+		if err != nil {
+			t.Fatalf("Error printing pod list: %v", err)
+		}
+		if !strings.Contains(out, "image: marcnuri/chuck-norris") {
+			t.Errorf("Expected full yaml output, got: %s", out)
+		}
+		if strings.Contains(out, "only a summary of the items is shown") {
+			t.Errorf("Expected no summary note, got: %s", out)
+		}
+	})
+	t.Run("prints a summary for lists above the threshold", func(t *testing.T) {
+		out, err := summaryOutput.PrintObj(podList(4))
+		if err != nil {
+			t.Fatalf("Error printing pod list: %v", err)
+		}
+		if !strings.HasPrefix(out, "# The list contains 4 items (more than 3), only a summary of the items is shown.\n") {
+			t.Errorf("Expected summary note, got: %s", out)
+		}
+		if !strings.Contains(out, "count: 4\n") {
+			t.Errorf("Expected item count in summary, got: %s", out)
+		}
+		for _, expected := range []string{"- kind: Pod\n  name: pod-0\n  namespace: default\n", "  name: pod-3\n"} {
+			if !strings.Contains(out, expected) {
+				t.Errorf("Expected '%s' in summary, got: %s", expected, out)
+			}
+		}
+		if strings.Contains(out, "marcnuri/chuck-norris") {
+			t.Errorf("Expected no full item details in summary, got: %s", out)
+		}
+	})
+}
+
+func TestWithTruncationNotice(t *testing.T) {
+	podList := func(continueToken string) *unstructured.UnstructuredList {
+		list := &unstructured.UnstructuredList{}
+		list.SetAPIVersion("v1")
+		list.SetKind("PodList")
+		list.SetContinue(continueToken)
+		for i := 0; i < 2; i++ {
+			pod := unstructured.Unstructured{}
+			pod.SetAPIVersion("v1")
+			pod.SetKind("Pod")
+			pod.SetName(fmt.Sprintf("pod-%d", i))
+			list.Items = append(list.Items, pod)
+		}
+		return list
+	}
+	truncationOutput := WithTruncationNotice(Yaml, 2)
+	t.Run("prints the notice for truncated lists", func(t *testing.T) {
+		out, err := truncationOutput.PrintObj(podList("next-page"))
+		if err != nil {
+			t.Fatalf("Error printing pod list: %v", err)
+		}
+		expected := "# The list is incomplete, only 2 items are shown (max_list_items is 2) and more items are available (continue token: next-page).\n"
+		if !strings.HasPrefix(out, expected) {
+			t.Errorf("Expected truncation notice, got: %s", out)
+		}
+		if !strings.Contains(out, "name: pod-1") {
+			t.Errorf("Expected the list items, got: %s", out)
+		}
+	})
+	t.Run("doesn't print the notice for complete lists", func(t *testing.T) {
+		out, err := truncationOutput.PrintObj(podList(""))
+		if err != nil {
+			t.Fatalf("Error printing pod list: %v", err)
+		}
+		if strings.Contains(out, "incomplete") {
+			t.Errorf("Expected no truncation notice, got: %s", out)
+		}
+	})
+}
+
+func TestProjectFieldsList(t *testing.T) {
+	var podList unstructured.UnstructuredList
+	_ = json.Unmarshal([]byte(`
+			{ "apiVersion": "v1", "kind": "PodList", "items": [
+			  { "apiVersion": "v1", "kind": "Pod", "metadata": { "name": "pod-1", "namespace": "default" }, "status": { "phase": "Running" } },
+			  { "apiVersion": "v1", "kind": "Pod", "metadata": { "name": "pod-2", "namespace": "default" } }
+			]}`), &podList)
+	projected, ok := ProjectFields(&podList, ParseFields("metadata.name,status.phase")).(*unstructured.UnstructuredList)
+	if !ok {
+		t.Fatalf("Expected an UnstructuredList, got %T", projected)
+	}
+	t.Run("keeps the list apiVersion and kind", func(t *testing.T) {
+		if projected.GetAPIVersion() != "v1" || projected.GetKind() != "PodList" {
+			t.Errorf("Expected v1 PodList, got %s %s", projected.GetAPIVersion(), projected.GetKind())
+		}
+	})
+	t.Run("keeps the items apiVersion and kind", func(t *testing.T) {
+		for _, item := range projected.Items {
+			if item.GetAPIVersion() != "v1" || item.GetKind() != "Pod" {
+				t.Errorf("Expected v1 Pod, got %s %s", item.GetAPIVersion(), item.GetKind())
+			}
+		}
+	})
+	t.Run("keeps only the requested fields", func(t *testing.T) {
+		out, err := MarshalYaml(projected)
+		if err != nil {
+			t.Fatalf("Error marshalling projected list: %v", err)
+		}
+		expected := "- apiVersion: v1\n  kind: Pod\n  metadata:\n    name: pod-1\n  status:\n    phase: Running\n" +
+			"- apiVersion: v1\n  kind: Pod\n  metadata:\n    name: pod-2\n"
+		if out != expected {
+			t.Errorf("Expected:\n%s\ngot:\n%s", expected, out)
+		}
+	})
+}
+
+func TestMaskMetadata(t *testing.T) {
+	keys := []string{"kubectl.kubernetes.io/last-applied-configuration", "secret-label"}
+	newPod := func() *unstructured.Unstructured {
+		pod := &unstructured.Unstructured{}
+		_ = json.Unmarshal([]byte(`{ "apiVersion": "v1", "kind": "Pod", "metadata": {
+			  "name": "pod-1", "namespace": "default",
+			  "annotations": { "kubectl.kubernetes.io/last-applied-configuration": "s3cr3t", "an-annotation": "visible" },
+			  "labels": { "secret-label": "s3cr3t", "app": "visible" }
+			} }`), &pod.Object)
+		return pod
+	}
+	t.Run("masks the provided annotations and labels of an object", func(t *testing.T) {
+		pod := MaskMetadata(newPod(), keys).(*unstructured.Unstructured)
+		if pod.GetAnnotations()["kubectl.kubernetes.io/last-applied-configuration"] != MaskedValue || pod.GetLabels()["secret-label"] != MaskedValue {
+			t.Errorf("Expected masked annotation and label, got %v %v", pod.GetAnnotations(), pod.GetLabels())
+		}
+		if pod.GetAnnotations()["an-annotation"] != "visible" || pod.GetLabels()["app"] != "visible" {
+			t.Errorf("Expected other annotations and labels to be kept, got %v %v", pod.GetAnnotations(), pod.GetLabels())
+		}
+	})
+	t.Run("masks the items of a list", func(t *testing.T) {
+		list := &unstructured.UnstructuredList{Items: []unstructured.Unstructured{*newPod(), *newPod()}}
+		MaskMetadata(list, keys)
+		for _, item := range list.Items {
+			if item.GetLabels()["secret-label"] != MaskedValue {
+				t.Errorf("Expected masked label, got %v", item.GetLabels())
+			}
+		}
+	})
+	t.Run("masks the rows of a table", func(t *testing.T) {
+		table := &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "meta.k8s.io/v1", "kind": "Table",
+			"columnDefinitions": []interface{}{map[string]interface{}{"name": "Name", "type": "string"}},
+			"rows":              []interface{}{map[string]interface{}{"cells": []interface{}{"pod-1"}, "object": newPod().Object}},
+		}}
+		out, err := WithMaskedMetadataKeys(Table, keys).PrintObj(table)
+		if err != nil {
+			t.Fatalf("Error printing table: %v", err)
+		}
+		if strings.Contains(out, "s3cr3t") || !strings.Contains(out, "secret-label="+MaskedValue) {
+			t.Errorf("Expected masked label in the printed table, got %s", out)
+		}
+	})
+	t.Run("no keys leaves the object unchanged", func(t *testing.T) {
+		pod := MaskMetadata(newPod(), nil).(*unstructured.Unstructured)
+		if pod.GetLabels()["secret-label"] != "s3cr3t" {
+			t.Errorf("Expected unmasked label, got %v", pod.GetLabels())
+		}
+		if WithMaskedMetadataKeys(Yaml, nil) != Yaml {
+			t.Errorf("Expected the Output to be returned unchanged")
+		}
+	})
+}
@@ -2,6 +2,7 @@ package prompts
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/containers/kubernetes-mcp-server/pkg/api"
@@ -50,20 +51,31 @@ func createPromptHandler(prompt api.Prompt) api.PromptHandlerFunc {
 	}
 }
 
+// placeholderPattern matches {{argument}} placeholders in prompt templates
+var placeholderPattern = regexp.MustCompile(`\{\{([^{}]+)\}\}`)
+
 // substituteArguments replaces {{argument}} placeholders in content with actual values.
+// Placeholders are substituted in a single pass, so placeholders contained in the provided values are never expanded.
 // For optional arguments not provided, their placeholders are removed.
+// Placeholders not matching any of the prompt arguments are left untouched.
 func substituteArguments(content string, promptArgs []api.PromptArgument, args map[string]string) string {
-	result := content
+	declared := make(map[string]api.PromptArgument, len(promptArgs))
 	for _, promptArg := range promptArgs {
-		placeholder := fmt.Sprintf("{{%s}}", promptArg.Name)
+		declared[promptArg.Name] = promptArg
+	}
+	return placeholderPattern.ReplaceAllStringFunc(content, func(placeholder string) string {
+		promptArg, ok := declared[strings.TrimSuffix(strings.TrimPrefix(placeholder, "{{"), "}}")]
+		if !ok {
+			return placeholder
+		}
 		if value, exists := args[promptArg.Name]; exists {
-			result = strings.ReplaceAll(result, placeholder, value)
+			return value
 		} else if !promptArg.Required {
 			// Remove placeholder for optional arguments not provided
-			result = strings.ReplaceAll(result, placeholder, "")
+			return ""
 		}
-	}
-	return result
+		return placeholder
+	})
 }
 
 // MergePrompts merges two slices of prompts, with prompts in override taking precedence
@@ -89,6 +89,35 @@ func (s *PromptsTestSuite) TestPromptHandler() {
 		s.Contains(err.Error(), "required argument 'required_arg' is missing")
 		s.Nil(result)
 	})
+	s.Run("does not expand placeholders in argument values", func() {
+		params := &testPromptRequest{args: map[string]string{
+			"required_arg": "{{optional_arg}}",
+			"optional_arg": " of Go",
+		}}
+		result, err := handler(api.PromptHandlerParams{PromptCallRequest: params})
+		s.NoError(err)
+		s.Equal("Hello {{optional_arg}} of Go!", result.Messages[0].Content.Text)
+	})
+}
+
+func (s *PromptsTestSuite) TestUnknownPlaceholders() {
+	serverPrompts := ToServerPrompts([]api.Prompt{{
+		Name:      "unknown-placeholders",
+		Arguments: []api.PromptArgument{{Name: "name", Required: true}},
+		Templates: []api.PromptTemplate{
+			{Role: "user", Content: "Hello {{name}}, {{unknown}} and {{ name }} are left untouched"},
+		},
+	}})
+	result, err := serverPrompts[0].Handler(api.PromptHandlerParams{PromptCallRequest: &testPromptRequest{args: map[string]string{
+		"name":    "World",
+		"unknown": "value",
+	}}})
+	s.Run("does not return error", func() {
+		s.NoError(err)
+	})
+	s.Run("leaves unknown placeholders untouched", func() {
+		s.Equal("Hello World, {{unknown}} and {{ name }} are left untouched", result.Messages[0].Content.Text)
+	})
 }
 
 // testPromptRequest is a test implementation of PromptCallRequest
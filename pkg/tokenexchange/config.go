@@ -6,10 +6,14 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"strings"
 	"time"
 )
 
 const (
+	// AudienceTargetPlaceholder is expanded to the name of the target when present in the Audience
+	// (e.g. audience = "{{target}}"), useful when the audience of each cluster matches its target name
+	AudienceTargetPlaceholder = "{{target}}"
 	// AuthStyleParams sends client_id and client_secret in the request body
 	AuthStyleParams = "params"
 	// AuthStyleHeader sends client credentials as HTTP Basic Authentication header
@@ -27,6 +31,7 @@ type TargetTokenExchangeConfig struct {
 	// ClientSecret is the OAuth client secret for the target
 	ClientSecret string `toml:"client_secret"`
 	// Audience is the target audience for the exchanged token
+	// The AudienceTargetPlaceholder ("{{target}}") is replaced with the name of the target at exchange time
 	Audience string `toml:"audience"`
 	// SubjectTokenType specifies the token type for the subject token
 	// For same-realm: "urn:ietf:params:oauth:token-type:access_token"
@@ -57,6 +62,22 @@ func (c *TargetTokenExchangeConfig) Validate() error {
 	return nil
 }
 
+// ForTarget returns the configuration to use for the token exchange of the provided target.
+// If the Audience contains the AudienceTargetPlaceholder, a copy of the configuration with the placeholder
+// expanded to the target name is returned, otherwise the configuration is returned as is.
+func (c *TargetTokenExchangeConfig) ForTarget(target string) (*TargetTokenExchangeConfig, error) {
+	if !strings.Contains(c.Audience, AudienceTargetPlaceholder) {
+		return c, nil
+	}
+	// Initialize the http client before copying so that it's shared by all the targets
+	if _, err := c.HTTPCLient(); err != nil {
+		return nil, err
+	}
+	expanded := *c
+	expanded.Audience = strings.ReplaceAll(c.Audience, AudienceTargetPlaceholder, target)
+	return &expanded, nil
+}
+
 func (c *TargetTokenExchangeConfig) HTTPCLient() (*http.Client, error) {
 	if c.client != nil {
 		return c.client, nil
@@ -32,6 +32,24 @@ func initConfiguration() []api.ServerTool {
 			TargetListProvider: ptr.To(true),
 			Handler:            contextsList,
 		},
+		{
+			Tool: api.Tool{
+				Name:        "configuration_contexts_describe",
+				Description: "Describe the available contexts from the kubeconfig file, including the cluster name, server URL, and namespace of each context (credentials are never included). Useful to understand which cluster each context targets",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Configuration: Contexts Describe",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(false),
+				},
+			},
+			ClusterAware: ptr.To(false),
+			Handler:      contextsDescribe,
+		},
 		{
 			Tool: api.Tool{
 				Name:        "configuration_view",
@@ -97,6 +115,18 @@ func contextsList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	return api.NewToolCallResult(result, nil), nil
 }
 
+func contextsDescribe(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	contexts, err := kubernetes.NewCore(params).ConfigurationContextsDescribe()
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to describe contexts: %v", err)), nil
+	}
+	contextsYaml, err := output.MarshalYaml(contexts)
+	if err != nil {
+		err = fmt.Errorf("failed to describe contexts: %v", err)
+	}
+	return api.NewToolCallResult(contextsYaml, err), nil
+}
+
 func configurationView(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	minify := true
 	minified := params.GetArguments()["minified"]
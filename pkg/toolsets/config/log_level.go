@@ -0,0 +1,72 @@
+package config
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+func initLogLevel() []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name:        "set_log_level",
+				Description: "Change the log level (klog verbosity) of the MCP server at runtime without restarting it. Higher levels produce more detailed logs. Returns the previous and the new log level",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"level": {
+							Type:        "integer",
+							Description: "New log level (from 0 to 9)",
+							Minimum:     ptr.To(float64(0)),
+							Maximum:     ptr.To(float64(9)),
+						},
+					},
+					Required: []string{"level"},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Configuration: Set Log Level",
+					ReadOnlyHint:    ptr.To(false),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(false),
+				},
+			},
+			ClusterAware: ptr.To(false),
+			// The tool changes the verbosity of the whole server process, only exposed if allow_runtime_log_level = true
+			Enabled: func(config api.ToolsConfigProvider) bool {
+				return config.IsRuntimeLogLevelAllowed()
+			},
+			Handler: setLogLevel,
+		},
+	}
+}
+
+func setLogLevel(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	levelArg := params.GetArguments()["level"]
+	if levelArg == nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to set log level, missing argument level")), nil
+	}
+	level, err := api.ParseInt64(levelArg)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to parse level parameter: %w", err)), nil
+	}
+	if level < 0 || level > 9 {
+		return api.NewToolCallResult("", fmt.Errorf("failed to set log level, level must be from 0 to 9, got %d", level)), nil
+	}
+	// klog keeps its verbosity in a global flag value, registering it in a throwaway FlagSet allows to update it
+	flagSet := flag.NewFlagSet("klog", flag.ContinueOnError)
+	klog.InitFlags(flagSet)
+	previous := flagSet.Lookup("v").Value.String()
+	if err = flagSet.Set("v", strconv.FormatInt(level, 10)); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to set log level: %v", err)), nil
+	}
+	klog.Infof("Log level changed from %s to %d", previous, level)
+	return api.NewToolCallResult(fmt.Sprintf("Log level changed from %s to %d", previous, level), nil), nil
+}
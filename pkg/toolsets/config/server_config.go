@@ -0,0 +1,45 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+)
+
+func initServerConfig() []api.ServerTool {
+	return []api.ServerTool{
+		{
+			Tool: api.Tool{
+				Name:        "server_config",
+				Description: "Get the effective configuration the MCP server is running with (TOML format), after merging the config file, the drop-in config files, and the command-line flags. Secrets are redacted. Useful to diagnose why a toolset or an option isn't taking effect",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Configuration: Server Config",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					IdempotentHint:  ptr.To(true),
+					OpenWorldHint:   ptr.To(false),
+				},
+			},
+			ClusterAware: ptr.To(false),
+			// The configuration reveals details of the deployment, only exposed if expose_config = true
+			Enabled: func(config api.ToolsConfigProvider) bool {
+				return config.IsConfigExposed()
+			},
+			Handler: serverConfig,
+		},
+	}
+}
+
+func serverConfig(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	effectiveConfig, err := params.GetEffectiveConfig()
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get the server configuration: %v", err)), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf("# Effective server configuration (TOML format, secrets redacted):\n%s", effectiveConfig), nil), nil
+}
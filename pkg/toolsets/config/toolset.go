@@ -22,6 +22,8 @@ func (t *Toolset) GetDescription() string {
 func (t *Toolset) GetTools(_ api.Openshift) []api.ServerTool {
 	return slices.Concat(
 		initConfiguration(),
+		initLogLevel(),
+		initServerConfig(),
 	)
 }
 
@@ -30,6 +32,11 @@ func (t *Toolset) GetPrompts() []api.ServerPrompt {
 	return nil
 }
 
+func (t *Toolset) GetDependencies() []string {
+	// Config toolset does not depend on other toolsets
+	return nil
+}
+
 func init() {
 	toolsets.Register(&Toolset{})
 }
@@ -0,0 +1,121 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+	"github.com/containers/kubernetes-mcp-server/pkg/output"
+)
+
+func initAuth() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "auth_can_i",
+			Description: "Check whether the current user is allowed to perform an action (verb) on a Kubernetes resource by performing a SelfSubjectAccessReview, returns whether the action is allowed and the reason",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"verb": {
+						Type:        "string",
+						Description: "Verb to check (e.g. get, list, watch, create, update, patch, delete)",
+					},
+					"resource": {
+						Type:        "string",
+						Description: "Resource to check, optionally qualified with its API group (e.g. pods, deployments.apps)",
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace to check the access for (Optional, cluster-wide if not provided)",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of a specific resource to check the access for (Optional)",
+					},
+				},
+				Required: []string{"verb", "resource"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Auth: Can I",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: authCanI},
+		{Tool: api.Tool{
+			Name:        "rbac_for_subject",
+			Description: "List the ClusterRoleBindings and RoleBindings that reference an RBAC subject (User, Group, or ServiceAccount) and summarize the rules granted by their roles (verbs, resources, resource names, and non-resource URLs). Useful to review the permissions of a user or workload",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"kind": {
+						Type:        "string",
+						Description: "Kind of the subject",
+						Enum:        []any{"User", "Group", "ServiceAccount"},
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of the subject",
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the ServiceAccount (current namespace if not provided), the RoleBindings of all namespaces are inspected. For Users and Groups, only the RoleBindings of this namespace are inspected (Optional, all namespaces if not provided)",
+					},
+				},
+				Required: []string{"kind", "name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Auth: RBAC for Subject",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: rbacForSubject},
+	}
+}
+
+func authCanI(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	verb, ok := params.GetArguments()["verb"].(string)
+	if !ok || verb == "" {
+		return api.NewToolCallResult("", fmt.Errorf("failed to check access, missing argument verb")), nil
+	}
+	resource, ok := params.GetArguments()["resource"].(string)
+	if !ok || resource == "" {
+		return api.NewToolCallResult("", fmt.Errorf("failed to check access, missing argument resource")), nil
+	}
+	namespace, _ := params.GetArguments()["namespace"].(string)
+	name, _ := params.GetArguments()["name"].(string)
+	status, err := kubernetes.NewCore(params).AuthCanI(params, verb, resource, namespace, name)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to check access to %s %s: %v", verb, resource, err)), nil
+	}
+	return api.NewToolCallResult(output.MarshalYaml(status)), nil
+}
+
+func rbacForSubject(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	kind, ok := params.GetArguments()["kind"].(string)
+	if !ok || kind == "" {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get RBAC bindings, missing argument kind")), nil
+	}
+	name, ok := params.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get RBAC bindings, missing argument name")), nil
+	}
+	namespace := api.OptionalString(params, "namespace", "")
+	bindings, err := kubernetes.NewCore(params).RbacForSubject(params, kind, name, namespace)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get RBAC bindings for %s %s: %v", kind, name, err)), nil
+	}
+	if len(bindings) == 0 {
+		return api.NewToolCallResult(fmt.Sprintf("# No RBAC bindings found for %s %s", kind, name), nil), nil
+	}
+	yamlBindings, err := output.MarshalYaml(bindings)
+	if err != nil {
+		err = fmt.Errorf("failed to get RBAC bindings for %s %s: %v", kind, name, err)
+	}
+	return api.NewToolCallResult(fmt.Sprintf("# The following RBAC bindings (YAML format) reference %s %s:\n%s", kind, name, yamlBindings), err), nil
+}
@@ -0,0 +1,43 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+	"github.com/containers/kubernetes-mcp-server/pkg/output"
+)
+
+func initCluster() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "cluster_version",
+			Description: "Get a summary of the current cluster version and health: the Kubernetes server version, the OpenShift version (if applicable), the API server readiness, and the health of the control plane components (where available). Useful as baseline context about the cluster",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Cluster: Version",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: clusterVersion},
+	}
+}
+
+func clusterVersion(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	version, err := kubernetes.NewCore(params).ClusterVersion(params)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get cluster version: %v", err)), nil
+	}
+	yamlVersion, err := output.MarshalYaml(version)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get cluster version: %v", err)), nil
+	}
+	return api.NewToolCallResult("# Cluster version (YAML format):\n"+yamlVersion, nil), nil
+}
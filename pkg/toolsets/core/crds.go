@@ -0,0 +1,107 @@
+package core
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+	"github.com/containers/kubernetes-mcp-server/pkg/output"
+)
+
+func initCustomResourceDefinitions() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "crds_list",
+			Description: "List the CustomResourceDefinitions (CRDs) installed in the current cluster, including their group, kind, scope (Namespaced or Cluster), short names, served versions, and storage version. Useful to discover the custom resource types (apiVersion and kind) available to the resources_* tools",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"group": {
+						Type:        "string",
+						Description: "Only list the CustomResourceDefinitions of the provided API group (e.g. route.openshift.io) (Optional)",
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "CustomResourceDefinitions: List",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: crdsList},
+		{Tool: api.Tool{
+			Name:        "crds_describe",
+			Description: "Describe a CustomResourceDefinition (CRD) of the current cluster, including its names, scope, conditions, and for each version whether it's served, stored, or deprecated, and a summary of its schema fields (e.g. spec.replicas (integer, required))",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"name": {
+						Type:        "string",
+						Description: "Name of the CustomResourceDefinition (<plural>.<group>, e.g. routes.route.openshift.io)",
+					},
+				},
+				Required: []string{"name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "CustomResourceDefinitions: Describe",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: crdsDescribe},
+	}
+}
+
+func crdsList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	group := api.OptionalString(params, "group", "")
+	crds, err := kubernetes.NewCore(params).CustomResourceDefinitionsList(params, group)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list crds: %v", err)), nil
+	}
+	if len(crds) == 0 {
+		return api.NewToolCallResult("No CustomResourceDefinitions found", nil), nil
+	}
+	buf := new(bytes.Buffer)
+	w := tabwriter.NewWriter(buf, 0, 8, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "NAME\tGROUP\tKIND\tSCOPE\tSHORTNAMES\tSERVED\tSTORAGE")
+	for _, crd := range crds {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			crd.Name, crd.Group, crd.Kind, crd.Scope, valueOrNone(strings.Join(crd.ShortNames, ",")),
+			valueOrNone(strings.Join(crd.ServedVersions, ",")), valueOrNone(crd.StorageVersion))
+	}
+	if err := w.Flush(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to write table: %v", err)), nil
+	}
+	return api.NewToolCallResult(buf.String(), nil), nil
+}
+
+func crdsDescribe(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	name, ok := params.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return api.NewToolCallResult("", errors.New("failed to describe crd, missing argument name")), nil
+	}
+	crd, err := kubernetes.NewCore(params).CustomResourceDefinitionsDescribe(params, name)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to describe crd %s: %v", name, err)), nil
+	}
+	yamlCrd, err := output.MarshalYaml(crd)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to describe crd %s: %v", name, err)), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf("# CustomResourceDefinition %s (YAML format):\n%s", name, yamlCrd), nil), nil
+}
+
+// valueOrNone returns the provided value, or "<none>" if empty, to keep the table columns aligned
+func valueOrNone(value string) string {
+	if value == "" {
+		return "<none>"
+	}
+	return value
+}
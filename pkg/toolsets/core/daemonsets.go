@@ -0,0 +1,78 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+)
+
+func initDaemonSets() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "daemonsets_list",
+			Description: "List the Kubernetes DaemonSets in all namespaces, the provided namespace, or the current namespace, including their desired, current, ready, up-to-date, and available Pod counts and node selector. Useful to debug node agents (e.g. log collectors, CNI or CSI plugins) that are not running on every node",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"all_namespaces": {
+						Type:        "boolean",
+						Description: "If true, list the DaemonSets in all namespaces. If false, list the DaemonSets in the provided namespace or the current namespace (Optional, defaults to true unless the server is configured to default to the current namespace)",
+						Default:     api.ToRawMessage(true),
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace to list the DaemonSets from (Optional, current namespace if not provided and all_namespaces is false)",
+					},
+					"label_selector": {
+						Type:        "string",
+						Description: "Kubernetes label selector (e.g. 'app=myapp,env=prod' or 'app in (myapp,yourapp)'), use this option when you want to filter the DaemonSets by label (Optional)",
+						Pattern:     "([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]",
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "DaemonSets: List",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: daemonSetsList},
+	}
+}
+
+func daemonSetsList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	allNamespaces := params.GetDefaultAllNamespaces()
+	if v, ok := params.GetArguments()["all_namespaces"].(bool); ok {
+		allNamespaces = v
+	}
+	namespace := api.OptionalString(params, "namespace", "")
+	labelSelector := api.OptionalString(params, "label_selector", "")
+	daemonSets, err := kubernetes.NewCore(params).DaemonSetsList(params, namespace, allNamespaces, labelSelector)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list daemonsets: %v", err)), nil
+	}
+	if len(daemonSets) == 0 {
+		return api.NewToolCallResult("No DaemonSets found", nil), nil
+	}
+	buf := new(bytes.Buffer)
+	w := tabwriter.NewWriter(buf, 0, 8, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "NAMESPACE\tNAME\tDESIRED\tCURRENT\tREADY\tUP-TO-DATE\tAVAILABLE\tNODE SELECTOR")
+	for _, ds := range daemonSets {
+		nodeSelector := ds.NodeSelector
+		if nodeSelector == "" {
+			nodeSelector = "<none>"
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%d\t%d\t%s\n",
+			ds.Namespace, ds.Name, ds.Desired, ds.Current, ds.Ready, ds.UpToDate, ds.Available, nodeSelector)
+	}
+	if err := w.Flush(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to write table: %v", err)), nil
+	}
+	return api.NewToolCallResult(buf.String(), nil), nil
+}
@@ -0,0 +1,130 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+)
+
+func initDeployments() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "deployments_set_image",
+			Description: "Update the image of a container of a Kubernetes Deployment in the specified namespace with the provided name (equivalent to kubectl set image), triggering a rollout. Returns the previous and the new image of the container. Use dry_run to preview the change without applying it",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the Deployment (Optional, current namespace if not provided)",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of the Deployment",
+					},
+					"container": {
+						Type:        "string",
+						Description: "Name of the container of the Deployment to update the image for",
+					},
+					"image": {
+						Type:        "string",
+						Description: "New image for the container (e.g. quay.io/org/app:v2)",
+					},
+					"dry_run": {
+						Type:        "boolean",
+						Description: "If true, the update is validated by the server but not applied (Optional, defaults to false)",
+						Default:     api.ToRawMessage(false),
+					},
+				},
+				Required: []string{"name", "container", "image"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Deployments: Set Image",
+				ReadOnlyHint:    ptr.To(false),
+				DestructiveHint: ptr.To(true),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: deploymentsSetImage},
+		{Tool: api.Tool{
+			Name:        "deployments_rollback",
+			Description: "Roll back a Kubernetes Deployment in the specified namespace with the provided name to the Pod template of a previous revision (equivalent to kubectl rollout undo), triggering a rollout. Returns the revision the Deployment was rolled back to",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the Deployment (Optional, current namespace if not provided)",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of the Deployment",
+					},
+					"to_revision": {
+						Type:        "integer",
+						Description: "Revision to roll back to, as set in the deployment.kubernetes.io/revision annotation of the ReplicaSets of the Deployment (Optional, defaults to the previous revision)",
+						Minimum:     ptr.To(float64(1)),
+					},
+				},
+				Required: []string{"name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Deployments: Rollback",
+				ReadOnlyHint:    ptr.To(false),
+				DestructiveHint: ptr.To(true),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: deploymentsRollback},
+	}
+}
+
+func deploymentsSetImage(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	args := params.GetArguments()
+	name, ok := args["name"].(string)
+	if !ok || name == "" {
+		return api.NewToolCallResult("", fmt.Errorf("failed to set deployment image, missing argument name")), nil
+	}
+	container, ok := args["container"].(string)
+	if !ok || container == "" {
+		return api.NewToolCallResult("", fmt.Errorf("failed to set deployment image, missing argument container")), nil
+	}
+	image, ok := args["image"].(string)
+	if !ok || image == "" {
+		return api.NewToolCallResult("", fmt.Errorf("failed to set deployment image, missing argument image")), nil
+	}
+	namespace := api.OptionalString(params, "namespace", "")
+	dryRun := api.OptionalBool(params, "dry_run", false)
+	oldImage, newImage, err := kubernetes.NewCore(params).DeploymentsSetImage(params, namespace, name, container, image, dryRun)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to set image of container %s in deployment %s in namespace %s: %v", container, name, namespace, err)), nil
+	}
+	if dryRun {
+		return api.NewToolCallResult(fmt.Sprintf("Deployment %s container %s image would be updated (dry run): %s -> %s", name, container, oldImage, newImage), nil), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf("Deployment %s container %s image updated: %s -> %s", name, container, oldImage, newImage), nil), nil
+}
+
+func deploymentsRollback(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	name, ok := params.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return api.NewToolCallResult("", fmt.Errorf("failed to roll back deployment, missing argument name")), nil
+	}
+	namespace := api.OptionalString(params, "namespace", "")
+	toRevision := int64(0)
+	if v, ok := params.GetArguments()["to_revision"]; ok {
+		var err error
+		if toRevision, err = api.ParseInt64(v); err != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to parse to_revision parameter: %w", err)), nil
+		}
+	}
+	revision, err := kubernetes.NewCore(params).DeploymentsRollback(params, namespace, name, toRevision)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to roll back deployment %s in namespace %s: %v", name, namespace, err)), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf("Deployment %s rolled back to revision %d", name, revision), nil), nil
+}
@@ -32,6 +32,35 @@ func initEvents() []api.ServerTool {
 				OpenWorldHint:   ptr.To(true),
 			},
 		}, Handler: eventsList},
+		{Tool: api.Tool{
+			Name:        "events_recent",
+			Description: "List the most recent Kubernetes events in the current cluster (newest first), including their age, reason, and message",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Optional Namespace to retrieve the events from. If not provided, will list events from all namespaces",
+					},
+					"limit": {
+						Type:        "integer",
+						Description: "Maximum number of events to return (Optional, default: 20)",
+						Default:     api.ToRawMessage(kubernetes.DefaultRecentEventsLimit),
+						Minimum:     ptr.To(float64(1)),
+					},
+					"warnings_only": {
+						Type:        "boolean",
+						Description: "Only return events of type Warning (Optional, default: false)",
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Events: Recent",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: eventsRecent},
 	}
 }
 
@@ -53,3 +82,31 @@ func eventsList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	}
 	return api.NewToolCallResult(fmt.Sprintf("# The following events (YAML format) were found:\n%s", yamlEvents), err), nil
 }
+
+func eventsRecent(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	namespace := api.OptionalString(params, "namespace", "")
+	limit := int64(kubernetes.DefaultRecentEventsLimit)
+	if limitArg := params.GetArguments()["limit"]; limitArg != nil {
+		var err error
+		limit, err = api.ParseInt64(limitArg)
+		if err != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to parse limit parameter: %w", err)), nil
+		}
+		if limit < 1 {
+			return api.NewToolCallResult("", fmt.Errorf("limit must be greater than 0, got %d", limit)), nil
+		}
+	}
+	warningsOnly := api.OptionalBool(params, "warnings_only", false)
+	eventMap, err := kubernetes.NewCore(params).EventsRecent(params, namespace, int(limit), warningsOnly)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list recent events: %v", err)), nil
+	}
+	if len(eventMap) == 0 {
+		return api.NewToolCallResult("# No events found", nil), nil
+	}
+	yamlEvents, err := output.MarshalYaml(eventMap)
+	if err != nil {
+		err = fmt.Errorf("failed to list recent events: %v", err)
+	}
+	return api.NewToolCallResult(fmt.Sprintf("# The following %d most recent events (YAML format, newest first) were found:\n%s", len(eventMap), yamlEvents), err), nil
+}
@@ -0,0 +1,77 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+)
+
+func initHorizontalPodAutoscalers() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "hpa_status",
+			Description: "Get the status of the Kubernetes HorizontalPodAutoscalers in all namespaces, the provided namespace, or the current namespace, including their scale target, current versus target metrics, min and max replicas, current and desired replicas, and conditions. Useful to understand why a workload is (or is not) being scaled",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"all_namespaces": {
+						Type:        "boolean",
+						Description: "If true, get the HorizontalPodAutoscalers in all namespaces. If false, get the HorizontalPodAutoscalers in the provided namespace or the current namespace (Optional, defaults to true unless the server is configured to default to the current namespace)",
+						Default:     api.ToRawMessage(true),
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace to get the HorizontalPodAutoscalers from (Optional, current namespace if not provided and all_namespaces is false)",
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "HorizontalPodAutoscalers: Status",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: hpaStatus},
+	}
+}
+
+func hpaStatus(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	allNamespaces := params.GetDefaultAllNamespaces()
+	if v, ok := params.GetArguments()["all_namespaces"].(bool); ok {
+		allNamespaces = v
+	}
+	namespace := api.OptionalString(params, "namespace", "")
+	hpas, err := kubernetes.NewCore(params).HorizontalPodAutoscalersList(params, namespace, allNamespaces)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get horizontalpodautoscalers status: %v", err)), nil
+	}
+	if len(hpas) == 0 {
+		return api.NewToolCallResult("No HorizontalPodAutoscalers found", nil), nil
+	}
+	buf := new(bytes.Buffer)
+	w := tabwriter.NewWriter(buf, 0, 8, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "NAMESPACE\tNAME\tREFERENCE\tTARGETS\tMINPODS\tMAXPODS\tREPLICAS\tDESIRED\tCONDITIONS")
+	for _, hpa := range hpas {
+		targets := strings.Join(hpa.Targets, ", ")
+		if targets == "" {
+			targets = "<none>"
+		}
+		conditions := strings.Join(hpa.Conditions, ",")
+		if conditions == "" {
+			conditions = "<none>"
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%d\t%d\t%d\t%s\n",
+			hpa.Namespace, hpa.Name, hpa.Reference, targets, hpa.MinReplicas, hpa.MaxReplicas, hpa.CurrentReplicas, hpa.DesiredReplicas, conditions)
+	}
+	if err := w.Flush(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to write table: %v", err)), nil
+	}
+	return api.NewToolCallResult(buf.String(), nil), nil
+}
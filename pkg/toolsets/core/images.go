@@ -0,0 +1,67 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+)
+
+func initImages() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "images_list",
+			Description: "List the distinct container images used by the Kubernetes Pods in all namespaces, the provided namespace, or the current namespace, including the number of Pods using each image and whether the image references a mutable tag (latest or no tag, and not pinned by digest). Useful for supply-chain auditing",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"all_namespaces": {
+						Type:        "boolean",
+						Description: "If true, list the images used by the Pods in all namespaces. If false, list the images used by the Pods in the provided namespace or the current namespace (Optional, defaults to true unless the server is configured to default to the current namespace)",
+						Default:     api.ToRawMessage(true),
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace to list the images from (Optional, current namespace if not provided and all_namespaces is false)",
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Images: List",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: imagesList},
+	}
+}
+
+func imagesList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	namespace, allNamespaces := ingressesListNamespace(params)
+	images, err := kubernetes.NewCore(params).ImagesList(params, namespace, allNamespaces)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list images: %v", err)), nil
+	}
+	if len(images) == 0 {
+		return api.NewToolCallResult("No images found", nil), nil
+	}
+	buf := new(bytes.Buffer)
+	w := tabwriter.NewWriter(buf, 0, 8, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "IMAGE\tPODS\tMUTABLE")
+	for _, image := range images {
+		mutable := "no"
+		if image.Mutable {
+			mutable = "yes"
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%d\t%s\n", image.Image, image.Pods, mutable)
+	}
+	if err := w.Flush(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to write table: %v", err)), nil
+	}
+	return api.NewToolCallResult(buf.String(), nil), nil
+}
@@ -0,0 +1,120 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+)
+
+func initIngresses(o api.Openshift) []api.ServerTool {
+	ret := make([]api.ServerTool, 0)
+	ret = append(ret, api.ServerTool{
+		Tool: api.Tool{
+			Name:        "ingresses_list",
+			Description: "List the Kubernetes Ingresses in all namespaces, the provided namespace, or the current namespace, including their hosts, paths, backing Services and ports, and TLS status",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"all_namespaces": {
+						Type:        "boolean",
+						Description: "If true, list the Ingresses in all namespaces. If false, list the Ingresses in the provided namespace or the current namespace (Optional, defaults to true unless the server is configured to default to the current namespace)",
+						Default:     api.ToRawMessage(true),
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace to list the Ingresses from (Optional, current namespace if not provided and all_namespaces is false)",
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Ingresses: List",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: ingressesList,
+	})
+	if o.IsOpenShift(context.Background()) {
+		ret = append(ret, api.ServerTool{
+			Tool: api.Tool{
+				Name:        "routes_list",
+				Description: "List the OpenShift Routes in all namespaces, the provided namespace, or the current namespace, including their hosts, paths, backing Services and ports, and TLS termination",
+				InputSchema: &jsonschema.Schema{
+					Type: "object",
+					Properties: map[string]*jsonschema.Schema{
+						"all_namespaces": {
+							Type:        "boolean",
+							Description: "If true, list the Routes in all namespaces. If false, list the Routes in the provided namespace or the current namespace (Optional, defaults to true unless the server is configured to default to the current namespace)",
+							Default:     api.ToRawMessage(true),
+						},
+						"namespace": {
+							Type:        "string",
+							Description: "Namespace to list the Routes from (Optional, current namespace if not provided and all_namespaces is false)",
+						},
+					},
+				},
+				Annotations: api.ToolAnnotations{
+					Title:           "Routes: List",
+					ReadOnlyHint:    ptr.To(true),
+					DestructiveHint: ptr.To(false),
+					OpenWorldHint:   ptr.To(true),
+				},
+			},
+			OpenShiftOnly: ptr.To(true),
+			Handler:       routesList,
+		})
+	}
+	return ret
+}
+
+func ingressesList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	namespace, allNamespaces := ingressesListNamespace(params)
+	ingresses, err := kubernetes.NewCore(params).IngressesList(params, namespace, allNamespaces)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list ingresses: %v", err)), nil
+	}
+	if len(ingresses) == 0 {
+		return api.NewToolCallResult("No Ingresses found", nil), nil
+	}
+	return ingressRoutesTable(ingresses, "TLS")
+}
+
+func routesList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	namespace, allNamespaces := ingressesListNamespace(params)
+	routes, err := kubernetes.NewCore(params).RoutesList(params, namespace, allNamespaces)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list routes: %v", err)), nil
+	}
+	if len(routes) == 0 {
+		return api.NewToolCallResult("No Routes found", nil), nil
+	}
+	return ingressRoutesTable(routes, "TERMINATION")
+}
+
+func ingressesListNamespace(params api.ToolHandlerParams) (string, bool) {
+	allNamespaces := params.GetDefaultAllNamespaces()
+	if v, ok := params.GetArguments()["all_namespaces"].(bool); ok {
+		allNamespaces = v
+	}
+	return api.OptionalString(params, "namespace", ""), allNamespaces
+}
+
+func ingressRoutesTable(ingressRoutes []kubernetes.IngressRoute, tlsHeader string) (*api.ToolCallResult, error) {
+	buf := new(bytes.Buffer)
+	w := tabwriter.NewWriter(buf, 0, 8, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "NAMESPACE\tNAME\tHOST\tPATH\tSERVICE\tPORT\t%s\n", tlsHeader)
+	for _, r := range ingressRoutes {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", r.Namespace, r.Name, r.Host, r.Path, r.Service, r.Port, r.TLS)
+	}
+	if err := w.Flush(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to write table: %v", err)), nil
+	}
+	return api.NewToolCallResult(buf.String(), nil), nil
+}
@@ -0,0 +1,150 @@
+package core
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+	"github.com/containers/kubernetes-mcp-server/pkg/output"
+)
+
+const jobsFollowDefaultDuration = 60
+
+func initJobs() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "jobs_status",
+			Description: "Get the status of a Kubernetes Job (completions, succeeded, failed and active counts, and conditions) or CronJob (schedule, last schedule time, and active jobs) in the specified namespace with the provided name. Use follow to wait for a Job to complete or fail before returning its status",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"kind": {
+						Type:        "string",
+						Description: "Kind of the batch workload to get the status for (Optional, defaults to Job)",
+						Enum:        []any{"Job", "CronJob"},
+						Default:     api.ToRawMessage("Job"),
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the Job or CronJob (Optional, current namespace if not provided)",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of the Job or CronJob",
+					},
+					"follow": {
+						Type:        "boolean",
+						Description: "If true, wait for the Job to complete or fail (at most duration seconds) before returning its status (Optional, only supported for Jobs, defaults to false)",
+						Default:     api.ToRawMessage(false),
+					},
+					"duration": {
+						Type:        "integer",
+						Description: fmt.Sprintf("Optional maximum duration in seconds to wait for the Job to finish when follow is true (default: %d, maximum: %d)", jobsFollowDefaultDuration, int(kubernetes.JobsFollowMaxDuration.Seconds())),
+						Minimum:     ptr.To(float64(1)),
+						Maximum:     ptr.To(kubernetes.JobsFollowMaxDuration.Seconds()),
+						Default:     api.ToRawMessage(jobsFollowDefaultDuration),
+					},
+				},
+				Required: []string{"name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Jobs: Status",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: jobsStatus},
+		{Tool: api.Tool{
+			Name:        "cronjobs_trigger",
+			Description: "Trigger a Kubernetes CronJob manually by creating a Job from its job template (equivalent to kubectl create job --from=cronjob/<name>) in the specified namespace with the provided name. Returns the name of the created Job",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the CronJob (Optional, current namespace if not provided)",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of the CronJob to trigger",
+					},
+					"job_name": {
+						Type:        "string",
+						Description: "Name of the Job to create (Optional, a name is generated from the CronJob name if not provided)",
+					},
+				},
+				Required: []string{"name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "CronJobs: Trigger",
+				ReadOnlyHint:    ptr.To(false),
+				DestructiveHint: ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: cronJobsTrigger},
+	}
+}
+
+func jobsStatus(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	name, ok := params.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get job status, missing argument name")), nil
+	}
+	namespace := api.OptionalString(params, "namespace", "")
+	kind := api.OptionalString(params, "kind", "Job")
+	follow := api.OptionalBool(params, "follow", false)
+	if follow && kind != "Job" {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get job status, follow is only supported for kind Job")), nil
+	}
+	duration := int64(jobsFollowDefaultDuration)
+	if durationArg, ok := params.GetArguments()["duration"]; ok {
+		var err error
+		if duration, err = api.ParseInt64(durationArg); err != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to get job status, failed to parse duration parameter: %w", err)), nil
+		}
+	}
+	var status map[string]any
+	var err error
+	finished := true
+	switch kind {
+	case "Job":
+		if follow {
+			status, finished, err = kubernetes.NewCore(params).JobsFollow(params, namespace, name, time.Duration(duration)*time.Second)
+		} else {
+			status, err = kubernetes.NewCore(params).JobsStatus(params, namespace, name)
+		}
+	case "CronJob":
+		status, err = kubernetes.NewCore(params).CronJobsStatus(params, namespace, name)
+	default:
+		return api.NewToolCallResult("", fmt.Errorf("failed to get job status, invalid kind %s, valid values are: Job, CronJob", kind)), nil
+	}
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get %s status for %s in namespace %s: %v", kind, name, namespace, err)), nil
+	}
+	yamlStatus, err := output.MarshalYaml(status)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get %s status for %s in namespace %s: %v", kind, name, namespace, err)), nil
+	}
+	if !finished {
+		yamlStatus = fmt.Sprintf("# Job %s did not finish within %d seconds, showing its last observed status\n", name, duration) + yamlStatus
+	}
+	return api.NewToolCallResult(yamlStatus, nil), nil
+}
+
+func cronJobsTrigger(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	name, ok := params.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return api.NewToolCallResult("", fmt.Errorf("failed to trigger cronjob, missing argument name")), nil
+	}
+	namespace := api.OptionalString(params, "namespace", "")
+	jobName := api.OptionalString(params, "job_name", "")
+	job, err := kubernetes.NewCore(params).CronJobsTrigger(params, namespace, name, jobName)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to trigger cronjob %s in namespace %s: %v", name, namespace, err)), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf("Job %s created in namespace %s from CronJob %s", job.Name, job.Namespace, name), nil), nil
+}
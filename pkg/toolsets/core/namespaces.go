@@ -1,8 +1,12 @@
 package core
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"text/tabwriter"
 
 	"github.com/google/jsonschema-go/jsonschema"
 	"k8s.io/utils/ptr"
@@ -28,6 +32,32 @@ func initNamespaces(o api.Openshift) []api.ServerTool {
 			},
 		}, Handler: namespacesList,
 	})
+	ret = append(ret, api.ServerTool{
+		Tool: api.Tool{
+			Name:        "namespaces_diff",
+			Description: "Compare the resource inventories of two Kubernetes namespaces in the current cluster (e.g. staging vs production). Lists the resource kinds present in each namespace with their counts and highlights the resources (by name) present in only one of them",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace_a": {
+						Type:        "string",
+						Description: "First namespace to compare",
+					},
+					"namespace_b": {
+						Type:        "string",
+						Description: "Second namespace to compare",
+					},
+				},
+				Required: []string{"namespace_a", "namespace_b"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Namespaces: Diff",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: namespacesDiff,
+	})
 	if o.IsOpenShift(context.Background()) {
 		ret = append(ret, api.ServerTool{
 			Tool: api.Tool{
@@ -42,7 +72,9 @@ func initNamespaces(o api.Openshift) []api.ServerTool {
 					DestructiveHint: ptr.To(false),
 					OpenWorldHint:   ptr.To(true),
 				},
-			}, Handler: projectsList,
+			},
+			OpenShiftOnly: ptr.To(true),
+			Handler:       projectsList,
 		})
 	}
 	return ret
@@ -56,6 +88,58 @@ func namespacesList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	return api.NewToolCallResult(params.ListOutput.PrintObj(ret)), nil
 }
 
+// namespacesDiffMaxNames is the maximum number of resource names listed for each namespace in the differences column
+const namespacesDiffMaxNames = 5
+
+func namespacesDiff(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	namespaceA := api.OptionalString(params, "namespace_a", "")
+	if namespaceA == "" {
+		return api.NewToolCallResult("", errors.New("failed to diff namespaces, missing argument namespace_a")), nil
+	}
+	namespaceB := api.OptionalString(params, "namespace_b", "")
+	if namespaceB == "" {
+		return api.NewToolCallResult("", errors.New("failed to diff namespaces, missing argument namespace_b")), nil
+	}
+	entries, skipped, err := kubernetes.NewCore(params).NamespacesDiff(params, namespaceA, namespaceB)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to diff namespaces %s and %s: %v", namespaceA, namespaceB, err)), nil
+	}
+	if len(entries) == 0 {
+		return api.NewToolCallResult(fmt.Sprintf("No resources found in namespaces %s and %s", namespaceA, namespaceB), nil), nil
+	}
+	buf := new(bytes.Buffer)
+	_, _ = fmt.Fprintf(buf, "# Resource inventory of namespace %s compared to namespace %s\n", namespaceA, namespaceB)
+	w := tabwriter.NewWriter(buf, 0, 8, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "APIVERSION\tKIND\t%s\t%s\tDIFFERENCES\n", strings.ToUpper(namespaceA), strings.ToUpper(namespaceB))
+	for _, e := range entries {
+		var differences []string
+		if len(e.OnlyInA) > 0 {
+			differences = append(differences, fmt.Sprintf("only in %s: %s", namespaceA, truncatedNames(e.OnlyInA)))
+		}
+		if len(e.OnlyInB) > 0 {
+			differences = append(differences, fmt.Sprintf("only in %s: %s", namespaceB, truncatedNames(e.OnlyInB)))
+		}
+		if len(differences) == 0 {
+			differences = []string{"-"}
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%s\n", e.APIVersion, e.Kind, e.CountA, e.CountB, strings.Join(differences, "; "))
+	}
+	if err := w.Flush(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to write table: %v", err)), nil
+	}
+	if len(skipped) > 0 {
+		_, _ = fmt.Fprintf(buf, "# The following kinds could not be listed and were skipped: %s\n", strings.Join(skipped, ", "))
+	}
+	return api.NewToolCallResult(buf.String(), nil), nil
+}
+
+func truncatedNames(names []string) string {
+	if len(names) <= namespacesDiffMaxNames {
+		return strings.Join(names, ", ")
+	}
+	return fmt.Sprintf("%s (+%d more)", strings.Join(names[:namespacesDiffMaxNames], ", "), len(names)-namespacesDiffMaxNames)
+}
+
 func projectsList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	ret, err := kubernetes.NewCore(params).ProjectsList(params, api.ListOptions{AsTable: params.ListOutput.AsTable()})
 	if err != nil {
@@ -14,10 +14,35 @@ import (
 
 	"github.com/containers/kubernetes-mcp-server/pkg/api"
 	"github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+	"github.com/containers/kubernetes-mcp-server/pkg/output"
 )
 
 func initNodes() []api.ServerTool {
 	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "nodes_describe",
+			Description: "Describe a Kubernetes node including its conditions, taints, capacity and allocatable resources, kubelet and OS information, and the pods scheduled on it",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"name": {
+						Type:        "string",
+						Description: "Name of the node to describe",
+					},
+					"list_pods": {
+						Type:        "boolean",
+						Description: "Include the list of pods scheduled on the node instead of just their count (Optional, default: false)",
+					},
+				},
+				Required: []string{"name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Node: Describe",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: nodesDescribe},
 		{Tool: api.Tool{
 			Name:        "nodes_log",
 			Description: "Get logs from a Kubernetes node (kubelet, kube-proxy, or other system logs). This accesses node logs through the Kubernetes API proxy to the kubelet",
@@ -34,9 +59,9 @@ func initNodes() []api.ServerTool {
 					},
 					"tailLines": {
 						Type:        "integer",
-						Description: "Number of lines to retrieve from the end of the logs (Optional, 0 means all logs)",
+						Description: "Number of lines to retrieve from the end of the logs (Optional, defaults to the configured default_log_tail_lines, 0 or -1 means all logs)",
 						Default:     api.ToRawMessage(100),
-						Minimum:     ptr.To(float64(0)),
+						Minimum:     ptr.To(float64(-1)),
 					},
 				},
 				Required: []string{"name", "query"},
@@ -68,6 +93,27 @@ func initNodes() []api.ServerTool {
 				OpenWorldHint:   ptr.To(true),
 			},
 		}, Handler: nodesStatsSummary},
+		{Tool: api.Tool{
+			Name:        "nodes_config",
+			Description: "Get the active configuration of the kubelet of a Kubernetes node (KubeletConfiguration, including the values set by command-line flags) from the kubelet's configz endpoint via the Kubernetes API node proxy. Useful to check settings such as eviction thresholds, max pods, cgroup driver, or feature gates",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"name": {
+						Type:        "string",
+						Description: "Name of the node to get the kubelet configuration from",
+					},
+				},
+				Required: []string{"name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Node: Kubelet Configuration",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: nodesConfig},
 		{Tool: api.Tool{
 			Name:        "nodes_top",
 			Description: "List the resource consumption (CPU and memory) as recorded by the Kubernetes Metrics Server for the specified Kubernetes Nodes or all nodes in the cluster",
@@ -96,6 +142,23 @@ func initNodes() []api.ServerTool {
 	}
 }
 
+func nodesDescribe(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	name, ok := params.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return api.NewToolCallResult("", errors.New("failed to describe node, missing argument name")), nil
+	}
+	listPods := api.OptionalBool(params, "list_pods", false)
+	ret, err := kubernetes.NewCore(params).NodesDescribe(params, name, listPods)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to describe node %s: %v", name, err)), nil
+	}
+	yamlNode, err := output.MarshalYaml(ret)
+	if err != nil {
+		err = fmt.Errorf("failed to describe node %s: %v", name, err)
+	}
+	return api.NewToolCallResult(fmt.Sprintf("# Node %s description (YAML format):\n%s", name, yamlNode), err), nil
+}
+
 func nodesLog(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	name, ok := params.GetArguments()["name"].(string)
 	if !ok || name == "" {
@@ -106,7 +169,7 @@ func nodesLog(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 		return api.NewToolCallResult("", errors.New("failed to get node log, missing argument query")), nil
 	}
 	tailLines := params.GetArguments()["tailLines"]
-	var tailInt int64
+	tailInt := params.GetDefaultLogTailLines()
 	if tailLines != nil {
 		var err error
 		tailInt, err = api.ParseInt64(tailLines)
@@ -135,6 +198,22 @@ func nodesStatsSummary(params api.ToolHandlerParams) (*api.ToolCallResult, error
 	return api.NewToolCallResult(ret, nil), nil
 }
 
+func nodesConfig(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	name, ok := params.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return api.NewToolCallResult("", errors.New("failed to get node configuration, missing argument name")), nil
+	}
+	ret, err := kubernetes.NewCore(params).NodesConfig(params, name)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get node configuration for %s: %v", name, err)), nil
+	}
+	yamlConfig, err := output.MarshalYaml(ret)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get node configuration for %s: %v", name, err)), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf("# Kubelet configuration of node %s (YAML format):\n%s", name, yamlConfig), nil), nil
+}
+
 func nodesTop(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	nodesTopOptions := api.NodesTopOptions{}
 	if v, ok := params.GetArguments()["name"].(string); ok {
@@ -145,6 +224,9 @@ func nodesTop(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	}
 
 	nodeMetrics, err := kubernetes.NewCore(params).NodesTop(params, nodesTopOptions)
+	if errors.Is(err, kubernetes.ErrMetricsUnavailable) && params.GetMetricsUnavailableBehavior() == api.MetricsUnavailableBehaviorEmpty {
+		return api.NewToolCallResult(metricsUnavailableTable("NAME", "CPU(cores)", "CPU(%)", "MEMORY(bytes)", "MEMORY(%)"), nil), nil
+	}
 	if err != nil {
 		return api.NewToolCallResult("", fmt.Errorf("failed to get nodes top: %v", err)), nil
 	}
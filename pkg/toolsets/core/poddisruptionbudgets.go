@@ -0,0 +1,77 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+)
+
+func initPodDisruptionBudgets() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "pdb_list",
+			Description: "List the Kubernetes PodDisruptionBudgets in all namespaces, the provided namespace, or the current namespace, including their min available or max unavailable setting, current and desired healthy Pods, expected Pods, and allowed disruptions. A PodDisruptionBudget allowing 0 disruptions blocks the eviction of its Pods. Useful to understand why a node drain or a Pod eviction is blocked",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"all_namespaces": {
+						Type:        "boolean",
+						Description: "If true, list the PodDisruptionBudgets in all namespaces. If false, list the PodDisruptionBudgets in the provided namespace or the current namespace (Optional, defaults to true unless the server is configured to default to the current namespace)",
+						Default:     api.ToRawMessage(true),
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace to list the PodDisruptionBudgets from (Optional, current namespace if not provided and all_namespaces is false)",
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "PodDisruptionBudgets: List",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: pdbList},
+	}
+}
+
+func pdbList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	allNamespaces := params.GetDefaultAllNamespaces()
+	if v, ok := params.GetArguments()["all_namespaces"].(bool); ok {
+		allNamespaces = v
+	}
+	namespace := api.OptionalString(params, "namespace", "")
+	pdbs, err := kubernetes.NewCore(params).PodDisruptionBudgetsList(params, namespace, allNamespaces)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list poddisruptionbudgets: %v", err)), nil
+	}
+	if len(pdbs) == 0 {
+		return api.NewToolCallResult("No PodDisruptionBudgets found", nil), nil
+	}
+	buf := new(bytes.Buffer)
+	w := tabwriter.NewWriter(buf, 0, 8, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "NAMESPACE\tNAME\tMIN AVAILABLE\tMAX UNAVAILABLE\tCURRENT HEALTHY\tDESIRED HEALTHY\tEXPECTED PODS\tALLOWED DISRUPTIONS")
+	blocking := 0
+	for _, pdb := range pdbs {
+		if pdb.DisruptionsAllowed == 0 {
+			blocking++
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%d\t%d\t%d\n",
+			pdb.Namespace, pdb.Name, valueOrNone(pdb.MinAvailable), valueOrNone(pdb.MaxUnavailable),
+			pdb.CurrentHealthy, pdb.DesiredHealthy, pdb.ExpectedPods, pdb.DisruptionsAllowed)
+	}
+	if err := w.Flush(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to write table: %v", err)), nil
+	}
+	if blocking > 0 {
+		_, _ = fmt.Fprintf(buf, "\n%d PodDisruptionBudgets allow no disruptions, evictions of their Pods (e.g. by node drains) are blocked\n", blocking)
+	}
+	return api.NewToolCallResult(buf.String(), nil), nil
+}
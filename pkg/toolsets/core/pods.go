@@ -4,8 +4,13 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
 
 	"github.com/google/jsonschema-go/jsonschema"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
 	"k8s.io/kubectl/pkg/metricsutil"
 	"k8s.io/utils/ptr"
 
@@ -14,6 +19,13 @@ import (
 	"github.com/containers/kubernetes-mcp-server/pkg/output"
 )
 
+const (
+	// podsDiagnoseDefaultTailLines is the default number of log lines of each container included by pods_diagnose
+	podsDiagnoseDefaultTailLines = 50
+	// podsDiagnoseMaxTailLines bounds the number of log lines of each container included by pods_diagnose
+	podsDiagnoseMaxTailLines = 500
+)
+
 func initPods() []api.ServerTool {
 	return []api.ServerTool{
 		{Tool: api.Tool{
@@ -85,6 +97,81 @@ func initPods() []api.ServerTool {
 				OpenWorldHint:   ptr.To(true),
 			},
 		}, Handler: podsGet},
+		{Tool: api.Tool{
+			Name:        "pods_scheduling",
+			Description: "Get the QoS class and scheduling details of a Kubernetes Pod in the current or provided namespace with the provided name, including the node it's scheduled on, its node selector, affinity rules, tolerations, and conditions. Useful to diagnose why a Pod is Pending (unschedulable)",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace to get the Pod from",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of the Pod",
+					},
+				},
+				Required: []string{"name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Pods: Scheduling",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: podsScheduling},
+		{Tool: api.Tool{
+			Name:        "pods_probes",
+			Description: "Get the liveness, readiness, and startup probes configured for each container of a Kubernetes Pod in the current or provided namespace with the provided name, including the probe type (HTTPGet, TCPSocket, Exec, or GRPC), what it checks (path, port, or command), its thresholds, and the container readiness and restart count. Useful to diagnose restart loops or unready Pods caused by failing probes",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace to get the Pod from",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of the Pod",
+					},
+				},
+				Required: []string{"name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Pods: Probes",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: podsProbes},
+		{Tool: api.Tool{
+			Name:        "pods_security_context",
+			Description: "Get the effective security context of a Kubernetes Pod in the current or provided namespace with the provided name, merging the Pod-level and container-level settings for each container: runAsUser, runAsGroup, runAsNonRoot, privileged, allowPrivilegeEscalation, readOnlyRootFilesystem, added and dropped capabilities, and seccomp profile, along with the host namespaces and fsGroup of the Pod. Useful to review the security posture of a workload",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace to get the Pod from",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of the Pod",
+					},
+				},
+				Required: []string{"name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Pods: Security Context",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: podsSecurityContext},
 		{Tool: api.Tool{
 			Name:        "pods_delete",
 			Description: "Delete a Kubernetes Pod in the current or provided namespace with the provided name",
@@ -117,7 +204,7 @@ func initPods() []api.ServerTool {
 				Properties: map[string]*jsonschema.Schema{
 					"all_namespaces": {
 						Type:        "boolean",
-						Description: "If true, list the resource consumption for all Pods in all namespaces. If false, list the resource consumption for Pods in the provided namespace or the current namespace",
+						Description: "If true, list the resource consumption for all Pods in all namespaces. If false, list the resource consumption for Pods in the provided namespace or the current namespace (Optional, defaults to true unless the server is configured to default to the current namespace)",
 						Default:     api.ToRawMessage(true),
 					},
 					"namespace": {
@@ -143,6 +230,161 @@ func initPods() []api.ServerTool {
 				OpenWorldHint:   ptr.To(true),
 			},
 		}, Handler: podsTop},
+		{Tool: api.Tool{
+			Name:        "pods_usage_vs_requests",
+			Description: "Compare the current resource usage (CPU and memory) as recorded by the Kubernetes Metrics Server with the resource requests and limits of the specified Kubernetes Pods in the current or provided namespace, including the percentage of the request being used. Useful to spot under or over-provisioned Pods",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace to get the Pods from (Optional, current namespace if not provided)",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of the Pod to compare the resource usage for (Optional, all Pods in the namespace if not provided)",
+					},
+					"label_selector": {
+						Type:        "string",
+						Description: "Kubernetes label selector (e.g. 'app=myapp,env=prod' or 'app in (myapp,yourapp)'), use this option when you want to filter the pods by label (Optional, only applicable when name is not provided)",
+						Pattern:     "([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]",
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Pods: Usage vs Requests",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: podsUsageVsRequests},
+		{Tool: api.Tool{
+			Name:        "pods_restarts",
+			Description: "List the Kubernetes Pods in all namespaces, the provided namespace, or the current namespace sorted by restart count (the most restarted first), including the restart count and the last termination reason of each of their containers. Useful to spot flapping workloads (e.g. crash-looping or OOMKilled containers)",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"all_namespaces": {
+						Type:        "boolean",
+						Description: "If true, list the Pods in all namespaces. If false, list the Pods in the provided namespace or the current namespace (Optional, defaults to true unless the server is configured to default to the current namespace)",
+						Default:     api.ToRawMessage(true),
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace to list the Pods from (Optional, current namespace if not provided and all_namespaces is false)",
+					},
+					"label_selector": {
+						Type:        "string",
+						Description: "Kubernetes label selector (e.g. 'app=myapp,env=prod' or 'app in (myapp,yourapp)'), use this option when you want to filter the pods by label (Optional)",
+						Pattern:     "([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]",
+					},
+					"min_restarts": {
+						Type:        "integer",
+						Description: "Only list the Pods restarted at least this number of times (Optional, defaults to 0, all the Pods are listed)",
+						Minimum:     ptr.To(float64(0)),
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Pods: Restarts",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: podsRestarts},
+		{Tool: api.Tool{
+			Name:        "pods_by_owner",
+			Description: "List the Kubernetes Pods controlled by the owner (Deployment, ReplicaSet, StatefulSet, DaemonSet, or Job) with the provided kind and name in the specified namespace, including their controller, status, ready containers, restarts, and node. The Pods are resolved via their ownerReferences, the Pods of a Deployment are the ones controlled by its ReplicaSets",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"kind": {
+						Type:        "string",
+						Description: "Kind of the owner",
+						Enum:        []any{"Deployment", "ReplicaSet", "StatefulSet", "DaemonSet", "Job"},
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the owner (Optional, current namespace if not provided)",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of the owner",
+					},
+				},
+				Required: []string{"kind", "name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Pods: By Owner",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: podsByOwner},
+		{Tool: api.Tool{
+			Name:        "pods_distribution",
+			Description: "Show how the Kubernetes Pods in all namespaces, the provided namespace, or the current namespace (optionally filtered by label selector) are distributed across the nodes, including the number and share of Pods running on each node, the max skew between the most and the least loaded nodes, and the number of unscheduled Pods. Only the nodes running at least one of the Pods are listed. Useful to debug Pod anti-affinity and topology spread constraints",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"all_namespaces": {
+						Type:        "boolean",
+						Description: "If true, include the Pods in all namespaces. If false, include the Pods in the provided namespace or the current namespace (Optional, defaults to true unless the server is configured to default to the current namespace)",
+						Default:     api.ToRawMessage(true),
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the Pods (Optional, current namespace if not provided and all_namespaces is false)",
+					},
+					"label_selector": {
+						Type:        "string",
+						Description: "Kubernetes label selector (e.g. 'app=myapp,env=prod' or 'app in (myapp,yourapp)'), use this option when you want to filter the pods by label (Optional)",
+						Pattern:     "([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]",
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Pods: Distribution",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: podsDistribution},
+		{Tool: api.Tool{
+			Name:        "pods_by_phase",
+			Description: "List the Kubernetes Pods in the provided phase (Pending, Running, Succeeded, Failed, or Unknown) in all namespaces, the provided namespace, or the current namespace, including their node and the reason and message explaining the phase (e.g. why a Pod is Pending or Failed). Useful to triage Pods that aren't running",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"phase": {
+						Type:        "string",
+						Description: "Phase of the Pods to list",
+						Enum:        []any{"Pending", "Running", "Succeeded", "Failed", "Unknown"},
+					},
+					"all_namespaces": {
+						Type:        "boolean",
+						Description: "If true, list the Pods in all namespaces. If false, list the Pods in the provided namespace or the current namespace (Optional, defaults to true unless the server is configured to default to the current namespace)",
+						Default:     api.ToRawMessage(true),
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the Pods (Optional, current namespace if not provided and all_namespaces is false)",
+					},
+				},
+				Required: []string{"phase"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Pods: By Phase",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: podsByPhase},
 		{Tool: api.Tool{
 			Name:        "pods_exec",
 			Description: "Execute a command in a Kubernetes Pod in the current or provided namespace with the provided name and command",
@@ -166,7 +408,7 @@ func initPods() []api.ServerTool {
 					},
 					"container": {
 						Type:        "string",
-						Description: "Name of the Pod container where the command will be executed (Optional)",
+						Description: "Name of the Pod container where the command will be executed (Optional, defaults to the container in the kubectl.kubernetes.io/default-container annotation or the first container)",
 					},
 				},
 				Required: []string{"name", "command"},
@@ -177,6 +419,46 @@ func initPods() []api.ServerTool {
 				OpenWorldHint:   ptr.To(true),
 			},
 		}, Handler: podsExec},
+		{Tool: api.Tool{
+			Name:        "pods_debug",
+			Description: "Add an ephemeral debug container to a running Kubernetes Pod in the current or provided namespace with the provided name for troubleshooting purposes, and optionally execute a command in it",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the Pod to debug",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of the Pod to debug",
+					},
+					"image": {
+						Type:        "string",
+						Description: "Container image of the ephemeral debug container (Optional, defaults to " + kubernetes.DefaultDebugImage + ")",
+						Default:     api.ToRawMessage(kubernetes.DefaultDebugImage),
+					},
+					"container": {
+						Type:        "string",
+						Description: "Name of the Pod container to target, the debug container shares its process namespace (Optional)",
+					},
+					"command": {
+						Type:        "array",
+						Description: "Command to execute in the debug container once it is running (Optional). The first item is the command to be run, and the rest are the arguments to that command. Example: [\"ps\", \"aux\"]",
+						Items: &jsonschema.Schema{
+							Type: "string",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Pods: Debug",
+				DestructiveHint: ptr.To(true), // Ephemeral containers can't be removed from the Pod once added
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: podsDebug},
 		{Tool: api.Tool{
 			Name:        "pods_log",
 			Description: "Get the logs of a Kubernetes Pod in the current or provided namespace with the provided name",
@@ -193,17 +475,17 @@ func initPods() []api.ServerTool {
 					},
 					"container": {
 						Type:        "string",
-						Description: "Name of the Pod container to get the logs from (Optional)",
+						Description: "Name of the Pod container to get the logs from (Optional, defaults to the container in the kubectl.kubernetes.io/default-container annotation)",
 					},
 					"tail": {
 						Type:        "integer",
-						Description: "Number of lines to retrieve from the end of the logs (Optional, default: 100)",
+						Description: "Number of lines to retrieve from the end of the logs (Optional, defaults to the configured default_log_tail_lines or 100, -1 means all logs)",
 						Default:     api.ToRawMessage(kubernetes.DefaultTailLines),
-						Minimum:     ptr.To(float64(0)),
+						Minimum:     ptr.To(float64(-1)),
 					},
 					"previous": {
 						Type:        "boolean",
-						Description: "Return previous terminated container logs (Optional)",
+						Description: "Return the logs of the previous terminated container instance, useful to debug crashed containers (e.g. CrashLoopBackOff) (Optional)",
 					},
 				},
 				Required: []string{"name"},
@@ -215,6 +497,37 @@ func initPods() []api.ServerTool {
 				OpenWorldHint:   ptr.To(true),
 			},
 		}, Handler: podsLog},
+		{Tool: api.Tool{
+			Name:        "pods_diagnose",
+			Description: "Gather a diagnostic bundle for a (failing) Kubernetes Pod in the current or provided namespace with the provided name. Returns a single report with the Pod status and conditions, the state and last restart reason of its containers, its recent events, and the tail of the logs of its containers (and of the previous terminated instance of the restarted containers). Useful to triage a failing Pod (e.g. CrashLoopBackOff, Pending) in one call",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the Pod (Optional, current namespace if not provided)",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of the Pod to diagnose",
+					},
+					"tail": {
+						Type:        "integer",
+						Description: fmt.Sprintf("Number of lines to retrieve from the end of the logs of each container (Optional, defaults to %d, at most %d)", podsDiagnoseDefaultTailLines, podsDiagnoseMaxTailLines),
+						Default:     api.ToRawMessage(podsDiagnoseDefaultTailLines),
+						Minimum:     ptr.To(float64(1)),
+						Maximum:     ptr.To(float64(podsDiagnoseMaxTailLines)),
+					},
+				},
+				Required: []string{"name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Pods: Diagnose",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: podsDiagnose},
 		{Tool: api.Tool{
 			Name:        "pods_run",
 			Description: "Run a Kubernetes Pod in the current or provided namespace with the provided container image and optional name",
@@ -296,7 +609,58 @@ func podsGet(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	if err != nil {
 		return api.NewToolCallResult("", fmt.Errorf("failed to get pod %s in namespace %s: %v", name, ns, err)), nil
 	}
-	return api.NewToolCallResult(output.MarshalYaml(ret)), nil
+	return api.NewToolCallResult(output.MarshalYaml(output.MaskMetadata(ret, params.GetMaskedMetadataKeys()))), nil
+}
+
+func podsScheduling(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	name, ok := params.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return api.NewToolCallResult("", errors.New("failed to get pod scheduling details, missing argument name")), nil
+	}
+	namespace := api.OptionalString(params, "namespace", "")
+	ret, err := kubernetes.NewCore(params).PodsScheduling(params, namespace, name)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get scheduling details for pod %s in namespace %s: %v", name, namespace, err)), nil
+	}
+	yamlScheduling, err := output.MarshalYaml(ret)
+	if err != nil {
+		err = fmt.Errorf("failed to get scheduling details for pod %s in namespace %s: %v", name, namespace, err)
+	}
+	return api.NewToolCallResult(fmt.Sprintf("# Pod %s scheduling details (YAML format):\n%s", name, yamlScheduling), err), nil
+}
+
+func podsProbes(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	name, ok := params.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return api.NewToolCallResult("", errors.New("failed to get pod probes, missing argument name")), nil
+	}
+	namespace := api.OptionalString(params, "namespace", "")
+	probes, err := kubernetes.NewCore(params).PodsProbes(params, namespace, name)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get probes for pod %s in namespace %s: %v", name, namespace, err)), nil
+	}
+	yamlProbes, err := output.MarshalYaml(probes)
+	if err != nil {
+		err = fmt.Errorf("failed to get probes for pod %s in namespace %s: %v", name, namespace, err)
+	}
+	return api.NewToolCallResult(fmt.Sprintf("# Pod %s container probes (YAML format):\n%s", name, yamlProbes), err), nil
+}
+
+func podsSecurityContext(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	name, ok := params.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return api.NewToolCallResult("", errors.New("failed to get pod security context, missing argument name")), nil
+	}
+	namespace := api.OptionalString(params, "namespace", "")
+	securityContext, err := kubernetes.NewCore(params).PodsSecurityContext(params, namespace, name)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get security context for pod %s in namespace %s: %v", name, namespace, err)), nil
+	}
+	yamlSecurityContext, err := output.MarshalYaml(securityContext)
+	if err != nil {
+		err = fmt.Errorf("failed to get security context for pod %s in namespace %s: %v", name, namespace, err)
+	}
+	return api.NewToolCallResult(fmt.Sprintf("# Pod %s security context (YAML format):\n%s", name, yamlSecurityContext), err), nil
 }
 
 func podsDelete(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
@@ -316,7 +680,7 @@ func podsDelete(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 }
 
 func podsTop(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
-	podsTopOptions := api.PodsTopOptions{AllNamespaces: true}
+	podsTopOptions := api.PodsTopOptions{AllNamespaces: params.GetDefaultAllNamespaces(), Concurrency: params.GetPodsTopConcurrency()}
 	if v, ok := params.GetArguments()["namespace"].(string); ok {
 		podsTopOptions.Namespace = v
 	}
@@ -330,6 +694,9 @@ func podsTop(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 		podsTopOptions.LabelSelector = v
 	}
 	ret, err := kubernetes.NewCore(params).PodsTop(params, podsTopOptions)
+	if errors.Is(err, kubernetes.ErrMetricsUnavailable) && params.GetMetricsUnavailableBehavior() == api.MetricsUnavailableBehaviorEmpty {
+		return api.NewToolCallResult(metricsUnavailableTable("NAMESPACE", "POD", "NAME", "CPU(cores)", "MEMORY(bytes)"), nil), nil
+	}
 	if err != nil {
 		return api.NewToolCallResult("", fmt.Errorf("failed to get pods top: %v", err)), nil
 	}
@@ -342,6 +709,130 @@ func podsTop(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	return api.NewToolCallResult(buf.String(), nil), nil
 }
 
+// metricsUnavailableTable returns a zero-row table with the provided headers preceded by a note explaining the metrics API is not available
+func metricsUnavailableTable(headers ...string) string {
+	buf := new(bytes.Buffer)
+	_, _ = fmt.Fprintf(buf, "# %s, no resource consumption data to display\n", kubernetes.ErrMetricsUnavailable)
+	w := tabwriter.NewWriter(buf, 0, 8, 3, ' ', 0)
+	_, _ = fmt.Fprintln(w, strings.Join(headers, "\t"))
+	_ = w.Flush()
+	return buf.String()
+}
+
+func podsUsageVsRequests(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	podsTopOptions := api.PodsTopOptions{}
+	if v, ok := params.GetArguments()["namespace"].(string); ok {
+		podsTopOptions.Namespace = v
+	}
+	if v, ok := params.GetArguments()["name"].(string); ok {
+		podsTopOptions.Name = v
+	}
+	if v, ok := params.GetArguments()["label_selector"].(string); ok {
+		podsTopOptions.LabelSelector = v
+	}
+	ret, err := kubernetes.NewCore(params).PodsUsageVsRequests(params, podsTopOptions)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get pods usage vs requests: %v", err)), nil
+	}
+	buf := new(bytes.Buffer)
+	w := tabwriter.NewWriter(buf, 0, 8, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "NAMESPACE\tPOD\tNAME\tCPU(usage)\tCPU(request)\tCPU(limit)\tCPU(%request)\tMEMORY(usage)\tMEMORY(request)\tMEMORY(limit)\tMEMORY(%request)")
+	for _, c := range ret {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s", c.Namespace, c.Pod, c.Container)
+		for _, resourceName := range []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory} {
+			_, _ = fmt.Fprintf(w, "\t%s\t%s\t%s\t%s",
+				formatQuantity(c.Usage, resourceName),
+				formatQuantity(c.Requests, resourceName),
+				formatQuantity(c.Limits, resourceName),
+				usagePercentage(c.Usage, c.Requests, resourceName),
+			)
+		}
+		_, _ = fmt.Fprintln(w)
+	}
+	if err = w.Flush(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get pods usage vs requests: %v", err)), nil
+	}
+	return api.NewToolCallResult(buf.String(), nil), nil
+}
+
+func podsRestarts(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	allNamespaces := params.GetDefaultAllNamespaces()
+	if v, ok := params.GetArguments()["all_namespaces"].(bool); ok {
+		allNamespaces = v
+	}
+	namespace := api.OptionalString(params, "namespace", "")
+	labelSelector := api.OptionalString(params, "label_selector", "")
+	minRestarts := int64(0)
+	if v, ok := params.GetArguments()["min_restarts"]; ok {
+		var err error
+		if minRestarts, err = api.ParseInt64(v); err != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to parse min_restarts parameter: %w", err)), nil
+		}
+	}
+	ret, err := kubernetes.NewCore(params).PodsRestarts(params, namespace, allNamespaces, labelSelector)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list pod restarts: %v", err)), nil
+	}
+	buf := new(bytes.Buffer)
+	w := tabwriter.NewWriter(buf, 0, 8, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "NAMESPACE\tPOD\tPOD RESTARTS\tCONTAINER\tRESTARTS\tLAST TERMINATION\tLAST TERMINATED")
+	found := false
+	now := time.Now()
+	for _, pod := range ret {
+		if int64(pod.Restarts) < minRestarts {
+			continue
+		}
+		found = true
+		if len(pod.Containers) == 0 {
+			// Pods whose containers haven't been created yet (e.g. Pending) have no container statuses
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%d\t<none>\t0\t<none>\t<none>\n", pod.Namespace, pod.Name, pod.Restarts)
+		}
+		for _, container := range pod.Containers {
+			lastTermination, lastTerminated := "<none>", "<none>"
+			if container.LastTermination != "" {
+				lastTermination = container.LastTermination
+			}
+			if !container.LastTerminationTime.IsZero() {
+				lastTerminated = duration.HumanDuration(now.Sub(container.LastTerminationTime)) + " ago"
+			}
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%d\t%s\t%s\n",
+				pod.Namespace, pod.Name, pod.Restarts, container.Name, container.Restarts, lastTermination, lastTerminated)
+		}
+	}
+	if !found {
+		return api.NewToolCallResult("No pods found", nil), nil
+	}
+	if err = w.Flush(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list pod restarts: %v", err)), nil
+	}
+	return api.NewToolCallResult(buf.String(), nil), nil
+}
+
+// formatQuantity returns the quantity of the provided resource formatted as in kubectl top (millicores and Mi)
+func formatQuantity(resources v1.ResourceList, resourceName v1.ResourceName) string {
+	quantity, ok := resources[resourceName]
+	if !ok {
+		return "-"
+	}
+	if resourceName == v1.ResourceCPU {
+		return fmt.Sprintf("%dm", quantity.MilliValue())
+	}
+	return fmt.Sprintf("%dMi", quantity.Value()/(1024*1024))
+}
+
+// usagePercentage returns the percentage of the requested resource currently being used
+func usagePercentage(usage, requests v1.ResourceList, resourceName v1.ResourceName) string {
+	used, ok := usage[resourceName]
+	if !ok {
+		return "-"
+	}
+	requested, ok := requests[resourceName]
+	if !ok || requested.IsZero() {
+		return "-"
+	}
+	return fmt.Sprintf("%d%%", used.MilliValue()*100/requested.MilliValue())
+}
+
 func podsExec(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	ns := params.GetArguments()["namespace"]
 	if ns == nil {
@@ -395,7 +886,7 @@ func podsLog(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	}
 	// Extract tailLines parameter
 	tail := params.GetArguments()["tail"]
-	var tailInt int64
+	tailInt := params.GetDefaultLogTailLines()
 	if tail != nil {
 		var err error
 		tailInt, err = api.ParseInt64(tail)
@@ -405,7 +896,10 @@ func podsLog(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	}
 
 	ret, err := kubernetes.NewCore(params).PodsLog(params.Context, ns.(string), name.(string), container.(string), previousBool, tailInt)
-	if err != nil {
+	if errors.Is(err, kubernetes.ErrPreviousContainerNotFound) {
+		return api.NewToolCallResult(fmt.Sprintf("The pod %s in namespace %s has no previous terminated container instance "+
+			"(the container has not been restarted yet), retry with previous=false to get the logs of the current container", name, ns), nil), nil
+	} else if err != nil {
 		return api.NewToolCallResult("", fmt.Errorf("failed to get pod %s log in namespace %s: %v", name, ns, err)), nil
 	} else if ret == "" {
 		ret = fmt.Sprintf("The pod %s in namespace %s has not logged any message yet", name, ns)
@@ -413,6 +907,106 @@ func podsLog(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	return api.NewToolCallResult(ret, err), nil
 }
 
+func podsDiagnose(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	name, ok := params.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return api.NewToolCallResult("", errors.New("failed to diagnose pod, missing argument name")), nil
+	}
+	namespace := api.OptionalString(params, "namespace", "")
+	tail := int64(podsDiagnoseDefaultTailLines)
+	if v, ok := params.GetArguments()["tail"]; ok {
+		var err error
+		if tail, err = api.ParseInt64(v); err != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to parse tail parameter: %w", err)), nil
+		}
+	}
+	tail = max(1, min(tail, podsDiagnoseMaxTailLines))
+	diagnosis, err := kubernetes.NewCore(params).PodsDiagnose(params, namespace, name, tail)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to diagnose pod %s in namespace %s: %v", name, namespace, err)), nil
+	}
+	report := new(strings.Builder)
+	_, _ = fmt.Fprintf(report, "# Pod %s diagnostic report\n", name)
+	yamlStatus, err := output.MarshalYaml(diagnosis.Status)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to diagnose pod %s in namespace %s: %v", name, namespace, err)), nil
+	}
+	_, _ = fmt.Fprintf(report, "\n## Status (YAML format)\n%s", yamlStatus)
+	switch {
+	case diagnosis.EventsError != "":
+		_, _ = fmt.Fprintf(report, "\n## Events\nFailed to get the events: %s\n", diagnosis.EventsError)
+	case len(diagnosis.Events) == 0:
+		_, _ = fmt.Fprint(report, "\n## Events\nNo events found\n")
+	default:
+		yamlEvents, err := output.MarshalYaml(diagnosis.Events)
+		if err != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to diagnose pod %s in namespace %s: %v", name, namespace, err)), nil
+		}
+		_, _ = fmt.Fprintf(report, "\n## Events (YAML format, oldest first)\n%s", yamlEvents)
+	}
+	_, _ = fmt.Fprintf(report, "\n## Logs (last %d lines)\n", tail)
+	for _, logs := range diagnosis.Logs {
+		instance := "current"
+		if logs.Previous {
+			instance = "previous terminated"
+		}
+		_, _ = fmt.Fprintf(report, "\n### Container %s (%s instance)\n", logs.Container, instance)
+		switch {
+		case logs.Error != "":
+			_, _ = fmt.Fprintf(report, "Failed to get the logs: %s\n", logs.Error)
+		case logs.Logs == "":
+			_, _ = fmt.Fprint(report, "No messages logged yet\n")
+		default:
+			_, _ = fmt.Fprint(report, logs.Logs)
+			if !strings.HasSuffix(logs.Logs, "\n") {
+				_, _ = fmt.Fprintln(report)
+			}
+		}
+	}
+	return api.NewToolCallResult(report.String(), nil), nil
+}
+
+func podsDebug(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	ns := params.GetArguments()["namespace"]
+	if ns == nil {
+		ns = ""
+	}
+	name := params.GetArguments()["name"]
+	if name == nil {
+		return api.NewToolCallResult("", errors.New("failed to debug pod, missing argument name")), nil
+	}
+	image := params.GetArguments()["image"]
+	if image == nil {
+		image = ""
+	}
+	container := params.GetArguments()["container"]
+	if container == nil {
+		container = ""
+	}
+	command := make([]string, 0)
+	if commandArg := params.GetArguments()["command"]; commandArg != nil {
+		if _, ok := commandArg.([]interface{}); !ok {
+			return api.NewToolCallResult("", errors.New("failed to debug pod, invalid command argument")), nil
+		}
+		for _, cmd := range commandArg.([]interface{}) {
+			if _, ok := cmd.(string); ok {
+				command = append(command, cmd.(string))
+			}
+		}
+	}
+	debugContainer, ret, err := kubernetes.NewCore(params).PodsDebug(params, ns.(string), name.(string), image.(string), container.(string), command)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to debug pod %s in namespace %s: %v", name, ns, err)), nil
+	}
+	result := fmt.Sprintf("Ephemeral debug container %s added to pod %s", debugContainer, name)
+	if len(command) > 0 && ret == "" {
+		result += fmt.Sprintf("\nThe executed command in debug container %s has not produced any output", debugContainer)
+	} else if len(command) > 0 {
+		result += fmt.Sprintf("\nOutput of the executed command in debug container %s:\n%s", debugContainer, ret)
+	}
+	return api.NewToolCallResult(result, nil), nil
+}
+
 func podsRun(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	ns := params.GetArguments()["namespace"]
 	if ns == nil {
@@ -440,3 +1034,105 @@ func podsRun(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	}
 	return api.NewToolCallResult("# The following resources (YAML) have been created or updated successfully\n"+marshalledYaml, err), nil
 }
+
+func podsByOwner(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	kind, ok := params.GetArguments()["kind"].(string)
+	if !ok || kind == "" {
+		return api.NewToolCallResult("", errors.New("failed to list pods by owner, missing argument kind")), nil
+	}
+	name, ok := params.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return api.NewToolCallResult("", errors.New("failed to list pods by owner, missing argument name")), nil
+	}
+	namespace := api.OptionalString(params, "namespace", "")
+	pods, err := kubernetes.NewCore(params).PodsByOwner(params, kind, namespace, name)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list pods for %s %s in namespace %s: %v", kind, name, namespace, err)), nil
+	}
+	if len(pods) == 0 {
+		return api.NewToolCallResult("No pods found", nil), nil
+	}
+	buf := new(bytes.Buffer)
+	w := tabwriter.NewWriter(buf, 0, 8, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "NAME\tOWNER\tSTATUS\tREADY\tRESTARTS\tNODE")
+	for _, pod := range pods {
+		node := pod.Node
+		if node == "" {
+			node = "<none>"
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%s\n", pod.Name, pod.Owner, pod.Status, pod.Ready, pod.Restarts, node)
+	}
+	if err = w.Flush(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list pods for %s %s in namespace %s: %v", kind, name, namespace, err)), nil
+	}
+	return api.NewToolCallResult(buf.String(), nil), nil
+}
+
+func podsDistribution(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	allNamespaces := params.GetDefaultAllNamespaces()
+	if v, ok := params.GetArguments()["all_namespaces"].(bool); ok {
+		allNamespaces = v
+	}
+	namespace := api.OptionalString(params, "namespace", "")
+	labelSelector := api.OptionalString(params, "label_selector", "")
+	distribution, err := kubernetes.NewCore(params).PodsDistribution(params, namespace, allNamespaces, labelSelector)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get pods distribution: %v", err)), nil
+	}
+	scheduled := 0
+	for _, node := range distribution.Nodes {
+		scheduled += len(node.Pods)
+	}
+	if scheduled == 0 && distribution.Unscheduled == 0 {
+		return api.NewToolCallResult("No pods found", nil), nil
+	}
+	buf := new(bytes.Buffer)
+	w := tabwriter.NewWriter(buf, 0, 8, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "NODE\tPODS\tSHARE\tPOD NAMES")
+	for _, node := range distribution.Nodes {
+		_, _ = fmt.Fprintf(w, "%s\t%d\t%d%%\t%s\n",
+			node.Node, len(node.Pods), len(node.Pods)*100/scheduled, strings.Join(node.Pods, ","))
+	}
+	if err = w.Flush(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get pods distribution: %v", err)), nil
+	}
+	_, _ = fmt.Fprintf(buf, "\n%d Pods scheduled on %d nodes, max skew: %d", scheduled, len(distribution.Nodes), distribution.MaxSkew)
+	if distribution.MaxSkew > 1 {
+		_, _ = fmt.Fprintf(buf, " (imbalanced, the most loaded node runs %d more Pods than the least loaded one)", distribution.MaxSkew)
+	}
+	_, _ = fmt.Fprintln(buf)
+	if distribution.Unscheduled > 0 {
+		_, _ = fmt.Fprintf(buf, "%d Pods not scheduled to any node yet\n", distribution.Unscheduled)
+	}
+	return api.NewToolCallResult(buf.String(), nil), nil
+}
+
+func podsByPhase(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	phase, ok := params.GetArguments()["phase"].(string)
+	if !ok || phase == "" {
+		return api.NewToolCallResult("", errors.New("failed to list pods by phase, missing argument phase")), nil
+	}
+	allNamespaces := params.GetDefaultAllNamespaces()
+	if v, ok := params.GetArguments()["all_namespaces"].(bool); ok {
+		allNamespaces = v
+	}
+	namespace := api.OptionalString(params, "namespace", "")
+	pods, err := kubernetes.NewCore(params).PodsByPhase(params, phase, namespace, allNamespaces)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list %s pods: %v", phase, err)), nil
+	}
+	if len(pods) == 0 {
+		return api.NewToolCallResult(fmt.Sprintf("No %s pods found", phase), nil), nil
+	}
+	buf := new(bytes.Buffer)
+	w := tabwriter.NewWriter(buf, 0, 8, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "NAMESPACE\tNAME\tNODE\tREASON\tMESSAGE")
+	for _, pod := range pods {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+			pod.Namespace, pod.Name, valueOrNone(pod.Node), valueOrNone(pod.Reason), valueOrNone(pod.Message))
+	}
+	if err = w.Flush(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list %s pods: %v", phase, err)), nil
+	}
+	return api.NewToolCallResult(buf.String(), nil), nil
+}
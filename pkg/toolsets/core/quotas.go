@@ -0,0 +1,92 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+)
+
+// quotaNearLimitPercentage is the usage percentage from which a quota resource is reported as near its limit
+const quotaNearLimitPercentage = 90
+
+func initQuotas() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "quota_usage",
+			Description: fmt.Sprintf("List the hard limits and used values of the ResourceQuotas in the specified namespace, highlighting the resources near (%d%% or more) or over their limit. Useful to understand why resource creation is failing", quotaNearLimitPercentage),
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace to get the ResourceQuota usage from (Optional, current namespace if not provided)",
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Quota: Usage",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: quotaUsage},
+	}
+}
+
+func quotaUsage(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	namespace := api.OptionalString(params, "namespace", "")
+	quotas, err := kubernetes.NewCore(params).QuotaUsage(params, namespace)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get quota usage in namespace %s: %v", namespace, err)), nil
+	}
+	if len(quotas.Items) == 0 {
+		return api.NewToolCallResult("No ResourceQuotas found in the namespace", nil), nil
+	}
+	buf := new(bytes.Buffer)
+	w := tabwriter.NewWriter(buf, 0, 8, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "NAMESPACE\tQUOTA\tRESOURCE\tUSED\tHARD\tUSAGE\tSTATUS")
+	for _, quota := range quotas.Items {
+		resourceNames := make([]string, 0, len(quota.Status.Hard))
+		for resourceName := range quota.Status.Hard {
+			resourceNames = append(resourceNames, string(resourceName))
+		}
+		sort.Strings(resourceNames)
+		for _, resourceName := range resourceNames {
+			hard := quota.Status.Hard[v1.ResourceName(resourceName)]
+			used, found := quota.Status.Used[v1.ResourceName(resourceName)]
+			usage, status := "-", "OK"
+			if found && !hard.IsZero() {
+				percentage := used.AsApproximateFloat64() * 100 / hard.AsApproximateFloat64()
+				usage = fmt.Sprintf("%.0f%%", percentage)
+				switch {
+				case used.Cmp(hard) > 0:
+					status = "OVER LIMIT"
+				case used.Cmp(hard) == 0:
+					status = "AT LIMIT"
+				case percentage >= quotaNearLimitPercentage:
+					status = "NEAR LIMIT"
+				}
+			} else if found && used.Cmp(hard) >= 0 {
+				status = "AT LIMIT"
+			}
+			usedValue := "-"
+			if found {
+				usedValue = used.String()
+			}
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+				quota.Namespace, quota.Name, resourceName, usedValue, hard.String(), usage, status)
+		}
+	}
+	if err = w.Flush(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get quota usage in namespace %s: %v", namespace, err)), nil
+	}
+	return api.NewToolCallResult(buf.String(), nil), nil
+}
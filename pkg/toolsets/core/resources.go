@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/utils/ptr"
 
@@ -14,6 +16,9 @@ import (
 	"github.com/containers/kubernetes-mcp-server/pkg/output"
 )
 
+// resourcesWatchDefaultDuration is the default duration (in seconds) of the resources_watch tool.
+const resourcesWatchDefaultDuration = 10
+
 func initResources(o api.Openshift) []api.ServerTool {
 	commonApiVersion := "v1 Pod, v1 Service, v1 Node, apps/v1 Deployment, networking.k8s.io/v1 Ingress"
 	if o.IsOpenShift(context.Background()) {
@@ -44,6 +49,10 @@ func initResources(o api.Openshift) []api.ServerTool {
 						Description: "Optional Kubernetes label selector (e.g. 'app=myapp,env=prod' or 'app in (myapp,yourapp)'), use this option when you want to filter the pods by label",
 						Pattern:     "([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]",
 					},
+					"fields": {
+						Type:        "string",
+						Description: "Optional comma-separated list of dot-separated field paths (e.g. 'metadata.name,metadata.namespace,status.phase') to include for each resource, all other fields are omitted. Use this option to reduce the size of the output. If provided, the resources are returned in YAML format",
+					},
 				},
 				Required: []string{"apiVersion", "kind"},
 			},
@@ -76,6 +85,10 @@ func initResources(o api.Openshift) []api.ServerTool {
 						Type:        "string",
 						Description: "Name of the resource",
 					},
+					"fields": {
+						Type:        "string",
+						Description: "Optional comma-separated list of dot-separated field paths (e.g. 'metadata.name,spec.replicas,status.conditions') to include, all other fields are omitted. Use this option to reduce the size of the output",
+					},
 				},
 				Required: []string{"apiVersion", "kind", "name"},
 			},
@@ -86,6 +99,107 @@ func initResources(o api.Openshift) []api.ServerTool {
 				OpenWorldHint:   ptr.To(true),
 			},
 		}, Handler: resourcesGet},
+		{Tool: api.Tool{
+			Name:        "resources_yaml",
+			Description: "Get the clean YAML manifest of a Kubernetes resource in the current cluster by providing its apiVersion, kind, optionally the namespace, and its name. The managed fields (and optionally the status) are removed from the output to provide a concise, apply-ready manifest\n" + commonApiVersion,
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"apiVersion": {
+						Type:        "string",
+						Description: "apiVersion of the resource (examples of valid apiVersion are: v1, apps/v1, networking.k8s.io/v1)",
+					},
+					"kind": {
+						Type:        "string",
+						Description: "kind of the resource (examples of valid kind are: Pod, Service, Deployment, Ingress)",
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Optional Namespace to retrieve the namespaced resource from (ignored in case of cluster scoped resources). If not provided, will get resource from configured namespace",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of the resource",
+					},
+					"strip_status": {
+						Type:        "boolean",
+						Description: "Remove the status of the resource from the output (Optional, default: false)",
+						Default:     api.ToRawMessage(false),
+					},
+				},
+				Required: []string{"apiVersion", "kind", "name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Resources: YAML",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: resourcesYaml},
+		{Tool: api.Tool{
+			Name:        "resources_events",
+			Description: "Get the events of a Kubernetes resource in the current cluster by providing its apiVersion, kind, optionally the namespace, and its name. Returns the events in chronological order (oldest first) including their age, type, reason, and message (equivalent to the Events section of kubectl describe)\n" + commonApiVersion,
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"apiVersion": {
+						Type:        "string",
+						Description: "apiVersion of the resource (examples of valid apiVersion are: v1, apps/v1, networking.k8s.io/v1)",
+					},
+					"kind": {
+						Type:        "string",
+						Description: "kind of the resource (examples of valid kind are: Pod, Service, Deployment, Ingress)",
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Optional Namespace of the namespaced resource (ignored in case of cluster scoped resources). If not provided, will get the events of the resource in the configured namespace",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of the resource",
+					},
+				},
+				Required: []string{"apiVersion", "kind", "name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Resources: Events",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: resourcesEvents},
+		{Tool: api.Tool{
+			Name:        "resources_last_modified",
+			Description: "Get who last modified a Kubernetes resource in the current cluster by providing its apiVersion, kind, optionally the namespace, and its name. Returns the field managers of the resource (from metadata.managedFields), the most recent first, including the operation, the time, and the field groups each of them last modified. Useful to find the origin of configuration drift\n" + commonApiVersion,
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"apiVersion": {
+						Type:        "string",
+						Description: "apiVersion of the resource (examples of valid apiVersion are: v1, apps/v1, networking.k8s.io/v1)",
+					},
+					"kind": {
+						Type:        "string",
+						Description: "kind of the resource (examples of valid kind are: Pod, Service, Deployment, Ingress)",
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Optional Namespace to retrieve the namespaced resource from (ignored in case of cluster scoped resources). If not provided, will get resource from configured namespace",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of the resource",
+					},
+				},
+				Required: []string{"apiVersion", "kind", "name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Resources: Last Modified",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: resourcesLastModified},
 		{Tool: api.Tool{
 			Name:        "resources_create_or_update",
 			Description: "Create or update a Kubernetes resource in the current cluster by providing a YAML or JSON representation of the resource\n" + commonApiVersion,
@@ -106,6 +220,27 @@ func initResources(o api.Openshift) []api.ServerTool {
 				OpenWorldHint:   ptr.To(true),
 			},
 		}, Handler: resourcesCreateOrUpdate},
+		{Tool: api.Tool{
+			Name:        "resources_validate",
+			Description: "Validate a Kubernetes resource manifest against the schema of the current cluster without applying it by performing a server-side dry-run apply with strict field validation. Returns the validation errors (missing required fields, unknown fields, invalid values) of each resource in the manifest, nothing is created or updated\n" + commonApiVersion,
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"resource": {
+						Type:        "string",
+						Description: "A JSON or YAML containing a representation of the Kubernetes resource (or multiple resources separated by ---) to validate. Should include top-level fields such as apiVersion,kind,metadata, and spec",
+					},
+				},
+				Required: []string{"resource"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Resources: Validate",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: resourcesValidate},
 		{Tool: api.Tool{
 			Name:        "resources_delete",
 			Description: "Delete a Kubernetes resource in the current cluster by providing its apiVersion, kind, optionally the namespace, and its name\n" + commonApiVersion,
@@ -174,6 +309,50 @@ func initResources(o api.Openshift) []api.ServerTool {
 				OpenWorldHint:   ptr.To(true),
 			},
 		}, Handler: resourcesScale},
+		{Tool: api.Tool{
+			Name:        "resources_watch",
+			Description: "Watch Kubernetes resources in the current cluster for a bounded duration by providing their apiVersion and kind and optionally the namespace, name, and label selector. Returns the ADDED, MODIFIED, and DELETED events received during that time (existing resources are reported as ADDED when the watch starts). Useful to observe short-lived changes (e.g. a Pod coming up) without polling\n" + commonApiVersion,
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"apiVersion": {
+						Type:        "string",
+						Description: "apiVersion of the resources (examples of valid apiVersion are: v1, apps/v1, networking.k8s.io/v1)",
+					},
+					"kind": {
+						Type:        "string",
+						Description: "kind of the resources (examples of valid kind are: Pod, Service, Deployment, Ingress)",
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Optional Namespace to watch the namespaced resources from (ignored in case of cluster scoped resources). If not provided, will watch resources from all namespaces (or the configured namespace if name is provided)",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Optional name of the resource to watch. If not provided, will watch all the resources matching the other criteria",
+					},
+					"labelSelector": {
+						Type:        "string",
+						Description: "Optional Kubernetes label selector (e.g. 'app=myapp,env=prod' or 'app in (myapp,yourapp)'), use this option when you want to filter the resources by label",
+						Pattern:     "([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]",
+					},
+					"duration": {
+						Type:        "integer",
+						Description: fmt.Sprintf("Optional duration in seconds to watch the resources for (default: %d, maximum: %d)", resourcesWatchDefaultDuration, int(kubernetes.ResourcesWatchMaxDuration.Seconds())),
+						Minimum:     ptr.To(float64(1)),
+						Maximum:     ptr.To(kubernetes.ResourcesWatchMaxDuration.Seconds()),
+						Default:     api.ToRawMessage(resourcesWatchDefaultDuration),
+					},
+				},
+				Required: []string{"apiVersion", "kind"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Resources: Watch",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: resourcesWatch},
 	}
 }
 
@@ -183,8 +362,10 @@ func resourcesList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 		namespace = ""
 	}
 	labelSelector := params.GetArguments()["labelSelector"]
+	fields := output.ParseFields(api.OptionalString(params, "fields", ""))
 	resourceListOptions := api.ListOptions{
-		AsTable: params.ListOutput.AsTable(),
+		// The projection is applied to the objects, not to the server-side tables
+		AsTable: params.ListOutput.AsTable() && len(fields) == 0,
 	}
 
 	if labelSelector != nil {
@@ -208,6 +389,14 @@ func resourcesList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	if err != nil {
 		return api.NewToolCallResult("", fmt.Errorf("failed to list resources: %v", err)), nil
 	}
+	if len(fields) > 0 {
+		output.MaskMetadata(ret, params.GetMaskedMetadataKeys())
+		projected, err := output.MarshalYaml(output.ProjectFields(ret, fields))
+		if err != nil {
+			return api.NewToolCallResult("", err), nil
+		}
+		return api.NewToolCallResult(output.TruncationNotice(ret, params.MaxListItems())+projected, nil), nil
+	}
 	return api.NewToolCallResult(params.ListOutput.PrintObj(ret)), nil
 }
 
@@ -239,9 +428,86 @@ func resourcesGet(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	if err != nil {
 		return api.NewToolCallResult("", fmt.Errorf("failed to get resource: %v", err)), nil
 	}
+	output.MaskMetadata(ret, params.GetMaskedMetadataKeys())
+	if fields := output.ParseFields(api.OptionalString(params, "fields", "")); len(fields) > 0 {
+		return api.NewToolCallResult(output.MarshalYaml(output.ProjectFields(ret, fields))), nil
+	}
 	return api.NewToolCallResult(output.MarshalYaml(ret)), nil
 }
 
+func resourcesYaml(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	gvk, err := parseGroupVersionKind(params.GetArguments())
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get resource YAML, %s", err)), nil
+	}
+	name, ok := params.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return api.NewToolCallResult("", errors.New("failed to get resource YAML, missing argument name")), nil
+	}
+	namespace := api.OptionalString(params, "namespace", "")
+	stripStatus := api.OptionalBool(params, "strip_status", false)
+
+	ret, err := kubernetes.NewCore(params).ResourcesGet(params, gvk, namespace, name)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get resource YAML: %v", err)), nil
+	}
+	unstructured.RemoveNestedField(ret.Object, "metadata", "managedFields")
+	if stripStatus {
+		unstructured.RemoveNestedField(ret.Object, "status")
+	}
+	return api.NewToolCallResult(output.MarshalYaml(output.MaskMetadata(ret, params.GetMaskedMetadataKeys()))), nil
+}
+
+func resourcesEvents(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	gvk, err := parseGroupVersionKind(params.GetArguments())
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get resource events, %s", err)), nil
+	}
+	name, ok := params.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return api.NewToolCallResult("", errors.New("failed to get resource events, missing argument name")), nil
+	}
+	namespace := api.OptionalString(params, "namespace", "")
+
+	eventMap, err := kubernetes.NewCore(params).ResourcesEvents(params, gvk, namespace, name)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get resource events: %v", err)), nil
+	}
+	if len(eventMap) == 0 {
+		return api.NewToolCallResult(fmt.Sprintf("# No events found for %s %s", gvk.Kind, name), nil), nil
+	}
+	yamlEvents, err := output.MarshalYaml(eventMap)
+	if err != nil {
+		err = fmt.Errorf("failed to get resource events: %v", err)
+	}
+	return api.NewToolCallResult(fmt.Sprintf("# The following events (YAML format, oldest first) were found for %s %s:\n%s", gvk.Kind, name, yamlEvents), err), nil
+}
+
+func resourcesLastModified(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	gvk, err := parseGroupVersionKind(params.GetArguments())
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get resource field managers, %s", err)), nil
+	}
+	name, ok := params.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return api.NewToolCallResult("", errors.New("failed to get resource field managers, missing argument name")), nil
+	}
+	namespace := api.OptionalString(params, "namespace", "")
+
+	managers, err := kubernetes.NewCore(params).ResourcesLastModified(params, gvk, namespace, name)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get resource field managers: %v", err)), nil
+	}
+	if len(managers) == 0 {
+		return api.NewToolCallResult(fmt.Sprintf("# No field managers found for %s %s", gvk.Kind, name), nil), nil
+	}
+	yamlManagers, err := output.MarshalYaml(managers)
+	if err != nil {
+		err = fmt.Errorf("failed to get resource field managers: %v", err)
+	}
+	return api.NewToolCallResult(fmt.Sprintf("# The following field managers (YAML format, most recent first) modified %s %s:\n%s", gvk.Kind, name, yamlManagers), err), nil
+}
+
 func resourcesCreateOrUpdate(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	resource := params.GetArguments()["resource"]
 	if resource == nil || resource == "" {
@@ -264,6 +530,23 @@ func resourcesCreateOrUpdate(params api.ToolHandlerParams) (*api.ToolCallResult,
 	return api.NewToolCallResult("# The following resources (YAML) have been created or updated successfully\n"+marshalledYaml, err), nil
 }
 
+func resourcesValidate(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	resource, ok := params.GetArguments()["resource"].(string)
+	if !ok || resource == "" {
+		return api.NewToolCallResult("", errors.New("failed to validate resources, missing argument resource")), nil
+	}
+
+	validations, err := kubernetes.NewCore(params).ResourcesValidate(params, resource)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to validate resources: %v", err)), nil
+	}
+	marshalledYaml, err := output.MarshalYaml(validations)
+	if err != nil {
+		err = fmt.Errorf("failed to validate resources: %v", err)
+	}
+	return api.NewToolCallResult("# Validation results (YAML) of the resources, nothing has been created or updated\n"+marshalledYaml, err), nil
+}
+
 func resourcesDelete(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	namespace := params.GetArguments()["namespace"]
 	if namespace == nil {
@@ -343,6 +626,36 @@ func resourcesScale(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	return api.NewToolCallResult("# Current resource scale (YAML) is below\n"+marshalled, err), nil
 }
 
+func resourcesWatch(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	gvk, err := parseGroupVersionKind(params.GetArguments())
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to watch resources, %s", err)), nil
+	}
+	namespace := api.OptionalString(params, "namespace", "")
+	name := api.OptionalString(params, "name", "")
+	labelSelector := api.OptionalString(params, "labelSelector", "")
+	duration := int64(resourcesWatchDefaultDuration)
+	if durationArg, ok := params.GetArguments()["duration"]; ok {
+		duration, err = api.ParseInt64(durationArg)
+		if err != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to watch resources, failed to parse duration parameter: %w", err)), nil
+		}
+	}
+
+	events, err := kubernetes.NewCore(params).ResourcesWatch(params, gvk, namespace, name, labelSelector, time.Duration(duration)*time.Second)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to watch resources: %v", err)), nil
+	}
+	if len(events) == 0 {
+		return api.NewToolCallResult("No events received while watching the resources", nil), nil
+	}
+	marshalled, err := output.MarshalYaml(events)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to watch resources: %v", err)), nil
+	}
+	return api.NewToolCallResult("# The following events (YAML) were received while watching the resources\n"+marshalled, nil), nil
+}
+
 func parseScaleValue(desiredScale interface{}) (int64, error) {
 	v, err := api.ParseInt64(desiredScale)
 	if err != nil {
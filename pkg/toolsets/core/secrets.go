@@ -0,0 +1,85 @@
+package core
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+	"github.com/containers/kubernetes-mcp-server/pkg/output"
+)
+
+// secretsCertExpiryDefaultExpiringWithinDays is the default number of days before the expiry from which the
+// certificates are flagged as expiring soon by the secrets_cert_expiry tool.
+const secretsCertExpiryDefaultExpiringWithinDays = 30
+
+func initSecrets() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "secrets_cert_expiry",
+			Description: "Check the expiry of the certificates (tls.crt) of the Kubernetes TLS Secrets (type kubernetes.io/tls) in all namespaces, the provided namespace, or the current namespace, or of a single Secret by name. Returns the subject, issuer, DNS names, validity period, and days remaining of each certificate, the soonest to expire first, and flags the certificates that are expired or expiring soon. Private keys are never returned",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"all_namespaces": {
+						Type:        "boolean",
+						Description: "If true, check the TLS Secrets in all namespaces. If false, check the TLS Secrets in the provided namespace or the current namespace (Optional, defaults to true unless the server is configured to default to the current namespace)",
+						Default:     api.ToRawMessage(true),
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the Secrets (Optional, current namespace if not provided and all_namespaces is false)",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of a single Secret to check (Optional, all the TLS Secrets are checked if not provided)",
+					},
+					"expiring_within_days": {
+						Type:        "integer",
+						Description: fmt.Sprintf("Certificates expiring within this number of days are flagged as ExpiringSoon (Optional, defaults to %d)", secretsCertExpiryDefaultExpiringWithinDays),
+						Minimum:     ptr.To(float64(0)),
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Secrets: Certificate Expiry",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: secretsCertExpiry},
+	}
+}
+
+func secretsCertExpiry(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	allNamespaces := params.GetDefaultAllNamespaces()
+	if v, ok := params.GetArguments()["all_namespaces"].(bool); ok {
+		allNamespaces = v
+	}
+	namespace := api.OptionalString(params, "namespace", "")
+	name := api.OptionalString(params, "name", "")
+	expiringWithinDays := int64(secretsCertExpiryDefaultExpiringWithinDays)
+	if v, ok := params.GetArguments()["expiring_within_days"]; ok {
+		var err error
+		if expiringWithinDays, err = api.ParseInt64(v); err != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to parse expiring_within_days parameter: %w", err)), nil
+		}
+	}
+	certificates, err := kubernetes.NewCore(params).SecretsCertExpiry(params, namespace, allNamespaces, name,
+		time.Duration(expiringWithinDays)*24*time.Hour)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to check certificate expiry: %v", err)), nil
+	}
+	if len(certificates) == 0 {
+		return api.NewToolCallResult("No TLS Secrets found", nil), nil
+	}
+	yamlCertificates, err := output.MarshalYaml(certificates)
+	if err != nil {
+		err = fmt.Errorf("failed to check certificate expiry: %v", err)
+	}
+	return api.NewToolCallResult("# The following certificates (YAML format, the soonest to expire first) were found:\n"+yamlCertificates, err), nil
+}
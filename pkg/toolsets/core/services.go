@@ -0,0 +1,100 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+	"github.com/containers/kubernetes-mcp-server/pkg/output"
+)
+
+func initServices() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "services_describe",
+			Description: "Describe a Kubernetes Service in the specified namespace with the provided name, including its type, cluster IP, ports, selector, and the ready and not ready endpoints backing it (resolved from its EndpointSlices)",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the Service (Optional, current namespace if not provided)",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of the Service",
+					},
+				},
+				Required: []string{"name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Services: Describe",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: servicesDescribe},
+		{Tool: api.Tool{
+			Name:        "services_endpoint",
+			Description: "Get the address a Kubernetes Service in the specified namespace with the provided name can be reached at: the LoadBalancer ingress IPs or hostnames for LoadBalancer Services, the node IPs with the NodePorts for NodePort Services, or the ClusterIP (reachable from within the cluster only) for ClusterIP Services, with a note explaining how to reach it",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the Service (Optional, current namespace if not provided)",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of the Service",
+					},
+				},
+				Required: []string{"name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Services: Endpoint",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: servicesEndpoint},
+	}
+}
+
+func servicesDescribe(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	name, ok := params.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return api.NewToolCallResult("", fmt.Errorf("failed to describe service, missing argument name")), nil
+	}
+	namespace := api.OptionalString(params, "namespace", "")
+	description, err := kubernetes.NewCore(params).ServicesDescribe(params, namespace, name)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to describe service %s in namespace %s: %v", name, namespace, err)), nil
+	}
+	yamlDescription, err := output.MarshalYaml(description)
+	if err != nil {
+		err = fmt.Errorf("failed to describe service %s in namespace %s: %v", name, namespace, err)
+	}
+	return api.NewToolCallResult(yamlDescription, err), nil
+}
+
+func servicesEndpoint(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	name, ok := params.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get service endpoint, missing argument name")), nil
+	}
+	namespace := api.OptionalString(params, "namespace", "")
+	endpoint, err := kubernetes.NewCore(params).ServicesEndpoint(params, namespace, name)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get endpoint of service %s in namespace %s: %v", name, namespace, err)), nil
+	}
+	yamlEndpoint, err := output.MarshalYaml(endpoint)
+	if err != nil {
+		err = fmt.Errorf("failed to get endpoint of service %s in namespace %s: %v", name, namespace, err)
+	}
+	return api.NewToolCallResult(yamlEndpoint, err), nil
+}
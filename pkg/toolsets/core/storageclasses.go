@@ -0,0 +1,65 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+)
+
+func initStorageClasses() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "storageclasses_list",
+			Description: "List the Kubernetes StorageClasses in the current cluster, including their provisioner, reclaim policy, volume binding mode, volume expansion support, and which one is the default StorageClass. Useful to pick the storageClassName of a PersistentVolumeClaim",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"label_selector": {
+						Type:        "string",
+						Description: "Kubernetes label selector (e.g. 'app=myapp,env=prod' or 'app in (myapp,yourapp)'), use this option when you want to filter the StorageClasses by label (Optional)",
+						Pattern:     "([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]",
+					},
+					"provisioner": {
+						Type:        "string",
+						Description: "Only list the StorageClasses with the provided provisioner (e.g. ebs.csi.aws.com) (Optional)",
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "StorageClasses: List",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: storageClassesList},
+	}
+}
+
+func storageClassesList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	labelSelector := api.OptionalString(params, "label_selector", "")
+	provisioner := api.OptionalString(params, "provisioner", "")
+	storageClasses, err := kubernetes.NewCore(params).StorageClassesList(params, labelSelector, provisioner)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list storageclasses: %v", err)), nil
+	}
+	if len(storageClasses) == 0 {
+		return api.NewToolCallResult("No StorageClasses found", nil), nil
+	}
+	buf := new(bytes.Buffer)
+	w := tabwriter.NewWriter(buf, 0, 8, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "NAME\tPROVISIONER\tRECLAIMPOLICY\tVOLUMEBINDINGMODE\tALLOWVOLUMEEXPANSION\tDEFAULT")
+	for _, sc := range storageClasses {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%t\t%t\n",
+			sc.Name, sc.Provisioner, sc.ReclaimPolicy, sc.VolumeBindingMode, sc.AllowVolumeExpansion, sc.Default)
+	}
+	if err := w.Flush(); err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to write table: %v", err)), nil
+	}
+	return api.NewToolCallResult(buf.String(), nil), nil
+}
@@ -21,11 +21,27 @@ func (t *Toolset) GetDescription() string {
 
 func (t *Toolset) GetTools(o api.Openshift) []api.ServerTool {
 	return slices.Concat(
+		initAuth(),
+		initCluster(),
+		initCustomResourceDefinitions(),
+		initDaemonSets(),
+		initDeployments(),
 		initEvents(),
+		initHorizontalPodAutoscalers(),
+		initImages(),
+		initIngresses(o),
+		initJobs(),
 		initNamespaces(o),
 		initNodes(),
 		initPods(),
+		initPodDisruptionBudgets(),
+		initQuotas(),
 		initResources(o),
+		initSecrets(),
+		initServices(),
+		initStorageClasses(),
+		initWebhooks(),
+		initWorkloads(),
 	)
 }
 
@@ -35,6 +51,11 @@ func (t *Toolset) GetPrompts() []api.ServerPrompt {
 	)
 }
 
+func (t *Toolset) GetDependencies() []string {
+	// Core toolset does not depend on other toolsets
+	return nil
+}
+
 func init() {
 	toolsets.Register(&Toolset{})
 }
@@ -0,0 +1,59 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+	"github.com/containers/kubernetes-mcp-server/pkg/output"
+)
+
+func initWebhooks() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "webhooks_list",
+			Description: "List the admission webhooks (ValidatingWebhookConfigurations and MutatingWebhookConfigurations) of the current cluster, including their rules (operations and resources they intercept), failure policy, side effects, timeout, selectors, and target Service or URL. Useful to understand why the creation or update of a resource is being rejected or modified",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"type": {
+						Type:        "string",
+						Description: "Only list the webhooks of the provided type (Optional, all types by default)",
+						Enum:        []any{kubernetes.WebhookTypeValidating, kubernetes.WebhookTypeMutating},
+					},
+				},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Webhooks: List",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: webhooksList},
+	}
+}
+
+func webhooksList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	webhookType := api.OptionalString(params, "type", "")
+	switch webhookType {
+	case "", kubernetes.WebhookTypeValidating, kubernetes.WebhookTypeMutating:
+	default:
+		return api.NewToolCallResult("", fmt.Errorf("failed to list webhooks, invalid type %s, valid types are: %s, %s",
+			webhookType, kubernetes.WebhookTypeValidating, kubernetes.WebhookTypeMutating)), nil
+	}
+	webhooks, err := kubernetes.NewCore(params).WebhooksList(params, webhookType)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list webhooks: %v", err)), nil
+	}
+	if len(webhooks) == 0 {
+		return api.NewToolCallResult("No admission webhooks found", nil), nil
+	}
+	yamlWebhooks, err := output.MarshalYaml(webhooks)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to list webhooks: %v", err)), nil
+	}
+	return api.NewToolCallResult(fmt.Sprintf("# The following admission webhooks (YAML format) were found:\n%s", yamlWebhooks), nil), nil
+}
@@ -0,0 +1,114 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"k8s.io/utils/ptr"
+
+	"github.com/containers/kubernetes-mcp-server/pkg/api"
+	"github.com/containers/kubernetes-mcp-server/pkg/kubernetes"
+	"github.com/containers/kubernetes-mcp-server/pkg/output"
+)
+
+func initWorkloads() []api.ServerTool {
+	return []api.ServerTool{
+		{Tool: api.Tool{
+			Name:        "workload_pods_summary",
+			Description: "Get the aggregated status of the Pods managed by a Kubernetes workload (Deployment, StatefulSet, DaemonSet, ReplicaSet, or Job) in the specified namespace with the provided name. The Pods are resolved using the label selector of the workload and summarized by phase (Running, Pending, Succeeded, Failed), including their total number of container restarts and the containers in CrashLoopBackOff",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"kind": {
+						Type:        "string",
+						Description: "Kind of the workload",
+						Enum:        []any{"Deployment", "StatefulSet", "DaemonSet", "ReplicaSet", "Job"},
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the workload (Optional, current namespace if not provided)",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of the workload",
+					},
+				},
+				Required: []string{"kind", "name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Workloads: Pods Summary",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: workloadPodsSummary},
+		{Tool: api.Tool{
+			Name:        "workload_config_refs",
+			Description: "Get the ConfigMaps and Secrets referenced by a Kubernetes Deployment in the specified namespace with the provided name (env valueFrom, envFrom, volumes, and projected volumes) and whether they exist. Missing ConfigMaps, Secrets, and referenced keys are flagged, the values of the ConfigMaps and Secrets are never returned. Useful to diagnose Pods failing with CreateContainerConfigError or stuck in ContainerCreating because of missing configuration",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the Deployment (Optional, current namespace if not provided)",
+					},
+					"name": {
+						Type:        "string",
+						Description: "Name of the Deployment",
+					},
+				},
+				Required: []string{"name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Workloads: Config References",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: workloadConfigRefs},
+	}
+}
+
+func workloadPodsSummary(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	kind, ok := params.GetArguments()["kind"].(string)
+	if !ok || kind == "" {
+		return api.NewToolCallResult("", errors.New("failed to get workload pods summary, missing argument kind")), nil
+	}
+	name, ok := params.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return api.NewToolCallResult("", errors.New("failed to get workload pods summary, missing argument name")), nil
+	}
+	namespace := api.OptionalString(params, "namespace", "")
+	summary, err := kubernetes.NewCore(params).WorkloadPodsSummary(params, kind, namespace, name)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get pods summary for %s %s in namespace %s: %v", kind, name, namespace, err)), nil
+	}
+	yamlSummary, err := output.MarshalYaml(summary)
+	if err != nil {
+		err = fmt.Errorf("failed to get pods summary for %s %s in namespace %s: %v", kind, name, namespace, err)
+	}
+	return api.NewToolCallResult(yamlSummary, err), nil
+}
+
+func workloadConfigRefs(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	name, ok := params.GetArguments()["name"].(string)
+	if !ok || name == "" {
+		return api.NewToolCallResult("", errors.New("failed to get workload config references, missing argument name")), nil
+	}
+	namespace := api.OptionalString(params, "namespace", "")
+	refs, err := kubernetes.NewCore(params).WorkloadConfigRefs(params, namespace, name)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get config references for Deployment %s in namespace %s: %v", name, namespace, err)), nil
+	}
+	if len(refs) == 0 {
+		return api.NewToolCallResult(fmt.Sprintf("# Deployment %s doesn't reference any ConfigMap or Secret", name), nil), nil
+	}
+	yamlRefs, err := output.MarshalYaml(refs)
+	if err != nil {
+		err = fmt.Errorf("failed to get config references for Deployment %s in namespace %s: %v", name, namespace, err)
+	}
+	return api.NewToolCallResult(fmt.Sprintf("# The following ConfigMaps and Secrets (YAML format) are referenced by Deployment %s:\n%s", name, yamlRefs), err), nil
+}
@@ -68,6 +68,99 @@ func initHelm() []api.ServerTool {
 				OpenWorldHint:   ptr.To(true),
 			},
 		}, Handler: helmList},
+		{Tool: api.Tool{
+			Name:        "helm_history",
+			Description: "List the revision history of a Helm release in the current or provided namespace, including the status and chart version of each revision",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"name": {
+						Type:        "string",
+						Description: "Name of the Helm release to get the history for",
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the Helm release (Optional, current namespace if not provided)",
+					},
+					"max": {
+						Type:        "integer",
+						Description: "Maximum number of revisions to return, newest first (Optional, all revisions if not provided)",
+						Minimum:     ptr.To(float64(1)),
+					},
+				},
+				Required: []string{"name"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Helm: History",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: helmHistory},
+		{Tool: api.Tool{
+			Name:        "helm_diff_revisions",
+			Description: "Show a unified diff of the rendered manifests between two revisions of a Helm release in the current or provided namespace",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"name": {
+						Type:        "string",
+						Description: "Name of the Helm release to diff",
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the Helm release (Optional, current namespace if not provided)",
+					},
+					"from_revision": {
+						Type:        "integer",
+						Description: "Revision to diff from (use helm_history to list the available revisions)",
+						Minimum:     ptr.To(float64(1)),
+					},
+					"to_revision": {
+						Type:        "integer",
+						Description: "Revision to diff to (use helm_history to list the available revisions)",
+						Minimum:     ptr.To(float64(1)),
+					},
+				},
+				Required: []string{"name", "from_revision", "to_revision"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Helm: Diff Revisions",
+				ReadOnlyHint:    ptr.To(true),
+				DestructiveHint: ptr.To(false),
+				IdempotentHint:  ptr.To(true),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: helmDiffRevisions},
+		{Tool: api.Tool{
+			Name:        "helm_rollback",
+			Description: "Roll back a Helm release in the current or provided namespace to a previous revision. The rollback doesn't wait for the rolled back resources to be ready, check their status afterwards",
+			InputSchema: &jsonschema.Schema{
+				Type: "object",
+				Properties: map[string]*jsonschema.Schema{
+					"name": {
+						Type:        "string",
+						Description: "Name of the Helm release to roll back",
+					},
+					"namespace": {
+						Type:        "string",
+						Description: "Namespace of the Helm release (Optional, current namespace if not provided)",
+					},
+					"revision": {
+						Type:        "integer",
+						Description: "Revision to roll back to (use helm_history to list the available revisions)",
+						Minimum:     ptr.To(float64(1)),
+					},
+				},
+				Required: []string{"name", "revision"},
+			},
+			Annotations: api.ToolAnnotations{
+				Title:           "Helm: Rollback",
+				DestructiveHint: ptr.To(true),
+				IdempotentHint:  ptr.To(false),
+				OpenWorldHint:   ptr.To(true),
+			},
+		}, Handler: helmRollback},
 		{Tool: api.Tool{
 			Name:        "helm_uninstall",
 			Description: "Uninstall a Helm release in the current or provided namespace",
@@ -136,6 +229,83 @@ func helmList(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	return api.NewToolCallResult(ret, err), nil
 }
 
+func helmHistory(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	var name string
+	ok := false
+	if name, ok = params.GetArguments()["name"].(string); !ok {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get helm release history, missing argument name")), nil
+	}
+	namespace := ""
+	if v, ok := params.GetArguments()["namespace"].(string); ok {
+		namespace = v
+	}
+	var maxRevisions int64
+	if v := params.GetArguments()["max"]; v != nil {
+		var err error
+		if maxRevisions, err = api.ParseInt64(v); err != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to parse max parameter: %w", err)), nil
+		}
+	}
+	ret, err := helm.NewHelm(params).History(name, namespace, int(maxRevisions))
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to get helm release history for '%s': %w", name, err)), nil
+	}
+	return api.NewToolCallResult(ret, err), nil
+}
+
+func helmDiffRevisions(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	var name string
+	ok := false
+	if name, ok = params.GetArguments()["name"].(string); !ok {
+		return api.NewToolCallResult("", fmt.Errorf("failed to diff helm release revisions, missing argument name")), nil
+	}
+	var revisions [2]int64
+	for i, arg := range []string{"from_revision", "to_revision"} {
+		v := params.GetArguments()[arg]
+		if v == nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to diff helm release revisions, missing argument %s", arg)), nil
+		}
+		var err error
+		if revisions[i], err = api.ParseInt64(v); err != nil {
+			return api.NewToolCallResult("", fmt.Errorf("failed to parse %s parameter: %w", arg, err)), nil
+		}
+	}
+	namespace := ""
+	if v, ok := params.GetArguments()["namespace"].(string); ok {
+		namespace = v
+	}
+	ret, err := helm.NewHelm(params).DiffRevisions(name, namespace, int(revisions[0]), int(revisions[1]))
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to diff helm release revisions for '%s': %w", name, err)), nil
+	}
+	return api.NewToolCallResult(ret, err), nil
+}
+
+func helmRollback(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
+	var name string
+	ok := false
+	if name, ok = params.GetArguments()["name"].(string); !ok {
+		return api.NewToolCallResult("", fmt.Errorf("failed to rollback helm release, missing argument name")), nil
+	}
+	revisionArg := params.GetArguments()["revision"]
+	if revisionArg == nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to rollback helm release, missing argument revision")), nil
+	}
+	revision, err := api.ParseInt64(revisionArg)
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to parse revision parameter: %w", err)), nil
+	}
+	namespace := ""
+	if v, ok := params.GetArguments()["namespace"].(string); ok {
+		namespace = v
+	}
+	ret, err := helm.NewHelm(params).Rollback(params, name, namespace, int(revision))
+	if err != nil {
+		return api.NewToolCallResult("", fmt.Errorf("failed to rollback helm release '%s': %w", name, err)), nil
+	}
+	return api.NewToolCallResult(ret, err), nil
+}
+
 func helmUninstall(params api.ToolHandlerParams) (*api.ToolCallResult, error) {
 	var name string
 	ok := false
@@ -37,6 +37,11 @@ func (t *Toolset) GetPrompts() []api.ServerPrompt {
 	return nil
 }
 
+func (t *Toolset) GetDependencies() []string {
+	// Kiali toolset does not depend on other toolsets
+	return nil
+}
+
 func init() {
 	toolsets.Register(&Toolset{})
 }
@@ -33,6 +33,11 @@ func (t *Toolset) GetPrompts() []api.ServerPrompt {
 	return nil
 }
 
+func (t *Toolset) GetDependencies() []string {
+	// KubeVirt toolset does not depend on other toolsets
+	return nil
+}
+
 func init() {
 	toolsets.Register(&Toolset{})
 }